@@ -26,6 +26,7 @@ func setupCommands() {
 	rootCommand.AddCommand(deleteCluster())
 	rootCommand.AddCommand(nodepool())
 	rootCommand.AddCommand(kubeConfig())
+	rootCommand.AddCommand(getToken())
 }
 
 //Execute sets up a command execute command handlers
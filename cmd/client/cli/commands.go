@@ -3,13 +3,17 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"time"
 
 	"github.com/imdario/mergo"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1beta1 "k8s.io/client-go/pkg/apis/clientauthentication/v1beta1"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
@@ -69,18 +73,21 @@ func createCluster() *cobra.Command {
 
 			log.Printf("creating cluster '%s'\n", name)
 
-			_, err = client.CreateCluster(cmd.Context(), req)
+			resp, err := client.CreateCluster(cmd.Context(), req)
 
 			if err != nil {
 				log.Fatal("create cluster failed: ", err.Error())
 			}
+			for _, w := range resp.Warnings {
+				log.Printf("warning: %s\n", w)
+			}
 
-			if req.Provider == "aws" {
-				stat := waitUntilClusterReady(cmd.Context(), client, req)
-				if stat != "ACTIVE" {
-					log.Fatal("failed to wait on cluster activation, please check provider portal")
-				}
+			stat := waitUntilClusterReady(cmd.Context(), client, req)
+			if stat != proto.ClusterState_RUNNING {
+				log.Fatalf("cluster '%s' is %s, please check provider portal\n", name, stat)
+			}
 
+			if req.Provider == "aws" {
 				//add default node
 				nsr := &proto.NodeSpawnRequest{}
 				nsr.Provider = req.Provider
@@ -167,6 +174,7 @@ func deleteCluster() *cobra.Command {
 	region := ""
 	addr := ""
 	force := false
+	drain := false
 
 	c := &cobra.Command{
 		Use:     "delete-cluster",
@@ -191,6 +199,7 @@ func deleteCluster() *cobra.Command {
 			req.Provider = provider
 			req.Region = region
 			req.ForceDelete = force
+			req.Drain = drain
 
 			conn, err := getSpawnerConn(addr)
 			if err != nil {
@@ -213,6 +222,7 @@ func deleteCluster() *cobra.Command {
 	c.Flags().StringVarP(&region, "region", "r", "", "cluster hosted region")
 	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
 	c.Flags().BoolVarP(&force, "force", "f", false, "force delete all nodes in the cluster")
+	c.Flags().BoolVar(&drain, "drain", false, "cordon and drain nodes before deleting them")
 
 	c.MarkFlagRequired("region")
 	c.MarkFlagRequired("provider")
@@ -278,6 +288,7 @@ func deleteNodePool() *cobra.Command {
 	provider := ""
 	region := ""
 	nodeName := ""
+	drain := false
 
 	c := &cobra.Command{
 		Use:     "delete",
@@ -302,6 +313,7 @@ func deleteNodePool() *cobra.Command {
 			req.NodeGroupName = nodeName
 			req.Provider = provider
 			req.Region = region
+			req.Drain = drain
 
 			conn, err := getSpawnerConn(addr)
 			if err != nil {
@@ -325,6 +337,7 @@ func deleteNodePool() *cobra.Command {
 	c.Flags().StringVarP(&provider, "provider", "p", "", "cloud provider, one of ['aws', 'azure']")
 	c.Flags().StringVarP(&region, "region", "r", "", "cluster hosted region")
 	c.Flags().StringVar(&nodeName, "nodepool", "", "nodepool to be deleted")
+	c.Flags().BoolVar(&drain, "drain", false, "cordon and drain nodes before deleting the nodepool")
 
 	c.MarkFlagRequired("nodepool")
 	c.MarkFlagRequired("region")
@@ -350,6 +363,8 @@ func kubeConfig() *cobra.Command {
 	addr := ""
 	provider := ""
 	region := ""
+	account := ""
+	exec := false
 
 	c := &cobra.Command{
 
@@ -374,6 +389,11 @@ func kubeConfig() *cobra.Command {
 			req.ClusterName = name
 			req.Provider = provider
 			req.Region = region
+			req.AccountName = account
+			if exec {
+				req.SpawnerExec = true
+				req.SpawnerAddr = addr
+			}
 
 			conn, err := getSpawnerConn(addr)
 			if err != nil {
@@ -432,6 +452,83 @@ func kubeConfig() *cobra.Command {
 
 	c.Flags().StringVarP(&provider, "provider", "p", "", "cloud provider, one of ['aws', 'azure']")
 	c.Flags().StringVarP(&region, "region", "r", "", "cluster hosted region")
+	c.Flags().StringVar(&account, "account", "", "account name of the cluster")
+	c.Flags().BoolVar(&exec, "exec", false, "authenticate with 'spawner get-token', cloud CLI is not needed")
+
+	return c
+}
+
+//getToken kubectl credential plugin, prints ExecCredential with the token spawner issued for the cluster
+func getToken() *cobra.Command {
+	name := ""
+	addr := ""
+	provider := ""
+	region := ""
+	account := ""
+
+	c := &cobra.Command{
+
+		Use:     "get-token",
+		Short:   "get token for the cluster as kubectl ExecCredential",
+		Long:    "kubectl credential plugin, used by kubeconfig from 'kubeconfig --exec'. Prints client.authentication.k8s.io/v1beta1 ExecCredential",
+		Example: "get-token --provider aws --region us-west-2 --cluster clustername",
+		Version: "0.0.1",
+		Run: func(cmd *cobra.Command, args []string) {
+			//stdout is read by kubectl and stderr is shown to the user on every call, only errors are written
+			log.SetOutput(io.Discard)
+			fail := func(format string, a ...interface{}) {
+				fmt.Fprintf(os.Stderr, format, a...)
+				os.Exit(1)
+			}
+
+			if name == "" {
+				fail("cluster name must be provided\n")
+			}
+
+			conn, err := getSpawnerConn(addr)
+			if err != nil {
+				fail("failed to connect to spawner %s: %s\n", addr, err.Error())
+			}
+			defer conn.Close()
+			client := proto.NewSpawnerServiceClient(conn)
+
+			res, err := client.GetToken(cmd.Context(), &proto.GetTokenRequest{
+				Provider:    provider,
+				Region:      region,
+				AccountName: account,
+				ClusterName: name,
+			})
+			if err != nil {
+				fail("failed to get token: %s\n", err.Error())
+			}
+
+			cred := &clientauthv1beta1.ExecCredential{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: clientauthv1beta1.SchemeGroupVersion.String(),
+					Kind:       "ExecCredential",
+				},
+				Status: &clientauthv1beta1.ExecCredentialStatus{Token: res.Token},
+			}
+			if res.ExpirationTimestamp != "" {
+				expiry, err := time.Parse(time.RFC3339, res.ExpirationTimestamp)
+				if err != nil {
+					fail("invalid token expiry '%s'\n", res.ExpirationTimestamp)
+				}
+				ts := metav1.NewTime(expiry)
+				cred.Status.ExpirationTimestamp = &ts
+			}
+
+			if err = json.NewEncoder(os.Stdout).Encode(cred); err != nil {
+				fail("failed to write credential: %s\n", err.Error())
+			}
+		},
+	}
+
+	c.Flags().StringVarP(&name, "cluster", "n", "", "cluster name")
+	c.Flags().StringVarP(&addr, "addr", "a", "localhost:8083", "spanwner service hoost address 'ip:port'")
+	c.Flags().StringVarP(&provider, "provider", "p", "", "cloud provider, one of ['aws', 'azure']")
+	c.Flags().StringVarP(&region, "region", "r", "", "cluster hosted region")
+	c.Flags().StringVar(&account, "account", "", "account name of the cluster")
 
 	return c
 }
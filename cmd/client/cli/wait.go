@@ -8,39 +8,48 @@ import (
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
-//waitUntilClusterReady Wait until the give cluster return "ACTIVE" status
+const (
+	clusterReadyTimeout = 15 * time.Minute
+	clusterPollInterval = 30 * time.Second
+)
+
+//waitUntilClusterReady Wait until the given cluster is RUNNING
 //
-// This will run for about 12min, with multiuple iteration of sleeps.
-// Starting with 6min, then 4min, 2min.
-// Cluster can become fully active in any one of sleep cycle, as soon as we see status is ACTIVE, we return it.
-// When sleep counter reaches 0, we return FAILED status, 15min is too long for cluster to become active
-func waitUntilClusterReady(ctx context.Context, c proto.SpawnerServiceClient, req *proto.ClusterRequest) string {
+// Cluster state is checked right away and then every 30s for up to 15min.
+// Returns as soon as the cluster is RUNNING or FAILED, last seen state is returned on timeout.
+func waitUntilClusterReady(ctx context.Context, c proto.SpawnerServiceClient, req *proto.ClusterRequest) proto.ClusterState {
 	clusterStatus := &proto.ClusterStatusRequest{
 		Provider:    req.Provider,
 		Region:      req.Region,
+		AccountName: req.AccountName,
 		ClusterName: req.ClusterName,
 	}
 
-	waitfor := 6 //minute
+	ctx, cancel := context.WithTimeout(ctx, clusterReadyTimeout)
+	defer cancel()
 
-	for {
-		log.Println("wait for cluster to be active, duration ", waitfor, "min")
-		time.Sleep(time.Duration(waitfor) * time.Minute)
+	ticker := time.NewTicker(clusterPollInterval)
+	defer ticker.Stop()
 
-		//check cluster status and if active return
+	state := proto.ClusterState_STATE_UNKNOWN
+	for {
 		stat, err := c.ClusterStatus(ctx, clusterStatus)
 		if err != nil {
-			log.Println("failed to fetch cluster stat")
+			log.Println("failed to fetch cluster status ", err)
+		} else {
+			state = stat.State
+			log.Printf("cluster '%s' is %s (%s)\n", req.ClusterName, state, stat.ProviderStatus)
 		}
 
-		if stat.Status == "ACTIVE" {
-			return stat.Status
+		if state == proto.ClusterState_RUNNING || state == proto.ClusterState_FAILED {
+			return state
 		}
-		waitfor = waitfor - 2
 
-		if waitfor == 0 {
-			//already waited for 12+min
-			return "FAILED"
+		select {
+		case <-ctx.Done():
+			log.Println("timed out waiting for cluster to be running")
+			return state
+		case <-ticker.C:
 		}
 	}
 }
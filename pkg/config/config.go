@@ -35,6 +35,17 @@ type Config struct {
 	//make sure this is set sufficiently for the nodes to be deleted, otherwise cluster deletion will fail
 	NodeDeletionTimeout int32 `mapstructure:"NODE_DELETION_TIME_IN_SECONDS"`
 
+	//ClusterUpgradeTimeout upgrades run in the background, control plane is upgraded first followed by node pools one after the other.
+	//spawner stops tracking and upgrading the remaining node pools after ClusterUpgradeTimeout
+	ClusterUpgradeTimeout int32 `mapstructure:"CLUSTER_UPGRADE_TIME_IN_MINUTES"`
+
+	//DrainTimeout time given to evict pods from node pool nodes when deletion is requested with drain,
+	//node pool is deleted once the timeout expires even if some pods could not be evicted.
+	DrainTimeout int32 `mapstructure:"DRAIN_TIME_IN_SECONDS"`
+
+	//MachineCatalogPath optional yaml/json machine type catalog, overrides the catalog embedded in the service
+	MachineCatalogPath string `mapstructure:"MACHINE_CATALOG_PATH"`
+
 	//Azure config
 
 	//AzureCloudProvider could be one of the following
@@ -140,3 +140,118 @@ func (g *gateway) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanc
 func (g *gateway) GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error) {
 	return g.service.GetCostByTime(ctx, req)
 }
+
+//ListKubernetesVersions list kubernetes versions available in the provider region
+func (g *gateway) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	return g.service.ListKubernetesVersions(ctx, req)
+}
+
+//UpgradeCluster upgrade cluster control plane followed by node pools
+func (g *gateway) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	return g.service.UpgradeCluster(ctx, req)
+}
+
+//UpgradeNodePool upgrade given node pool
+func (g *gateway) UpgradeNodePool(ctx context.Context, req *proto.UpgradeNodePoolRequest) (*proto.UpgradeNodePoolResponse, error) {
+	return g.service.UpgradeNodePool(ctx, req)
+}
+
+//GetUpgradeStatus upgrade progress of cluster and its node pools
+func (g *gateway) GetUpgradeStatus(ctx context.Context, req *proto.GetUpgradeStatusRequest) (*proto.GetUpgradeStatusResponse, error) {
+	return g.service.GetUpgradeStatus(ctx, req)
+}
+
+//ListMachineTypes machine type catalog filtered by provider and region
+func (g *gateway) ListMachineTypes(ctx context.Context, req *proto.ListMachineTypesRequest) (*proto.ListMachineTypesResponse, error) {
+	return g.service.ListMachineTypes(ctx, req)
+}
+
+//CheckCapacity preflight capacity and quota checks before provisioning
+func (g *gateway) CheckCapacity(ctx context.Context, req *proto.CheckCapacityRequest) (*proto.CheckCapacityResponse, error) {
+	return g.service.CheckCapacity(ctx, req)
+}
+
+//GetNetworkStack network stack of the region and clusters using it
+func (g *gateway) GetNetworkStack(ctx context.Context, req *proto.GetNetworkStackRequest) (*proto.GetNetworkStackResponse, error) {
+	return g.service.GetNetworkStack(ctx, req)
+}
+
+//ListNetworkStacks network stacks across the regions
+func (g *gateway) ListNetworkStacks(ctx context.Context, req *proto.ListNetworkStacksRequest) (*proto.ListNetworkStacksResponse, error) {
+	return g.service.ListNetworkStacks(ctx, req)
+}
+
+//DeleteNetworkStack delete network stack which is no longer used by any cluster
+func (g *gateway) DeleteNetworkStack(ctx context.Context, req *proto.DeleteNetworkStackRequest) (*proto.DeleteNetworkStackResponse, error) {
+	return g.service.DeleteNetworkStack(ctx, req)
+}
+
+//ListAddons installed and available add-ons of the cluster
+func (g *gateway) ListAddons(ctx context.Context, req *proto.ListAddonsRequest) (*proto.ListAddonsResponse, error) {
+	return g.service.ListAddons(ctx, req)
+}
+
+//InstallAddon install add-on on the cluster
+func (g *gateway) InstallAddon(ctx context.Context, req *proto.InstallAddonRequest) (*proto.InstallAddonResponse, error) {
+	return g.service.InstallAddon(ctx, req)
+}
+
+//UpdateAddon update version or configuration of the installed add-on
+func (g *gateway) UpdateAddon(ctx context.Context, req *proto.UpdateAddonRequest) (*proto.UpdateAddonResponse, error) {
+	return g.service.UpdateAddon(ctx, req)
+}
+
+//RemoveAddon remove add-on from the cluster
+func (g *gateway) RemoveAddon(ctx context.Context, req *proto.RemoveAddonRequest) (*proto.RemoveAddonResponse, error) {
+	return g.service.RemoveAddon(ctx, req)
+}
+
+//BindServiceAccountRole cloud role for the kubernetes service account, workloads using it get cloud access
+func (g *gateway) BindServiceAccountRole(ctx context.Context, req *proto.BindServiceAccountRoleRequest) (*proto.BindServiceAccountRoleResponse, error) {
+	return g.service.BindServiceAccountRole(ctx, req)
+}
+
+//UpdateClusterConfig change control plane logging and secrets encryption of the cluster
+func (g *gateway) UpdateClusterConfig(ctx context.Context, req *proto.UpdateClusterConfigRequest) (*proto.UpdateClusterConfigResponse, error) {
+	return g.service.UpdateClusterConfig(ctx, req)
+}
+
+//StopCluster stop the cluster, nodes are removed until it is started again
+func (g *gateway) StopCluster(ctx context.Context, req *proto.StopClusterRequest) (*proto.StopClusterResponse, error) {
+	return g.service.StopCluster(ctx, req)
+}
+
+//StartCluster start the stopped cluster, nodes are restored to their size before stop
+func (g *gateway) StartCluster(ctx context.Context, req *proto.StartClusterRequest) (*proto.StartClusterResponse, error) {
+	return g.service.StartCluster(ctx, req)
+}
+
+//GetBootstrapStatus result of each step of the bootstrap requested with the cluster
+func (g *gateway) GetBootstrapStatus(ctx context.Context, req *proto.GetBootstrapStatusRequest) (*proto.GetBootstrapStatusResponse, error) {
+	return g.service.GetBootstrapStatus(ctx, req)
+}
+
+//CreateTenant namespace of the workspace with its quota, returns a kubeconfig limited to it
+func (g *gateway) CreateTenant(ctx context.Context, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error) {
+	return g.service.CreateTenant(ctx, req)
+}
+
+//DeleteTenant delete the namespaces of the workspace
+func (g *gateway) DeleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error) {
+	return g.service.DeleteTenant(ctx, req)
+}
+
+//ListTenants tenant namespaces of the cluster
+func (g *gateway) ListTenants(ctx context.Context, req *proto.ListTenantsRequest) (*proto.ListTenantsResponse, error) {
+	return g.service.ListTenants(ctx, req)
+}
+
+//AttachVolume attach the volume to the instance or cluster node of the same availability zone
+func (g *gateway) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	return g.service.AttachVolume(ctx, req)
+}
+
+//DetachVolume detach the volume from the instance or cluster node
+func (g *gateway) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	return g.service.DetachVolume(ctx, req)
+}
@@ -0,0 +1,388 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//addonTimeout cluster has to become active before it is set up, it takes around 15 minutes
+const addonTimeout = time.Minute * 45
+
+//addonNamespace EKS add-ons run in kube-system
+const addonNamespace = "kube-system"
+
+type addonIdentity struct {
+	serviceAccount string
+	policy         string
+}
+
+//addonIdentities service account and managed policy of the add-ons which call AWS APIs
+var addonIdentities = map[string]addonIdentity{
+	"aws-ebs-csi-driver": {serviceAccount: "ebs-csi-controller-sa", policy: EBS_CSI_DRIVER_POLICY_ARN},
+	"aws-efs-csi-driver": {serviceAccount: "efs-csi-controller-sa", policy: EFS_CSI_DRIVER_POLICY_ARN},
+	"vpc-cni":            {serviceAccount: "aws-node", policy: EKS_CNI_POLICY_ARN},
+}
+
+func toAddon(a *eks.Addon) *proto.Addon {
+	return &proto.Addon{
+		Name:                  aws.StringValue(a.AddonName),
+		Version:               aws.StringValue(a.AddonVersion),
+		Status:                aws.StringValue(a.Status),
+		ServiceAccountRoleArn: aws.StringValue(a.ServiceAccountRoleArn),
+	}
+}
+
+//addonVersions versions of the add-on, latest first
+func addonVersions(info *eks.AddonInfo) []string {
+	versions := []string{}
+	for _, v := range info.AddonVersions {
+		versions = append(versions, aws.StringValue(v.AddonVersion))
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return common.CompareVersion(versions[i], versions[j]) > 0
+	})
+	return versions
+}
+
+//availableAddons add-ons compatible with the kubernetes version, all the add-ons when version is empty
+func availableAddons(ctx context.Context, client *eks.EKS, version string) (map[string]*eks.AddonInfo, error) {
+	input := &eks.DescribeAddonVersionsInput{}
+	if version != "" {
+		input.KubernetesVersion = &version
+	}
+
+	addons := map[string]*eks.AddonInfo{}
+	err := client.DescribeAddonVersionsPagesWithContext(ctx, input, func(out *eks.DescribeAddonVersionsOutput, last bool) bool {
+		for _, a := range out.Addons {
+			addons[aws.StringValue(a.AddonName)] = a
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe add-on versions")
+	}
+	return addons, nil
+}
+
+//validateAddons add-ons must be available for the cluster kubernetes version
+func validateAddons(ctx context.Context, client *eks.EKS, version string, addons []*proto.Addon) error {
+	if len(addons) == 0 {
+		return nil
+	}
+
+	available, err := availableAddons(ctx, client, version)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range addons {
+		if a.GetName() == "" {
+			return errors.New("add-on name must be provided")
+		}
+		if _, ok := available[a.Name]; !ok {
+			return fmt.Errorf("add-on '%s' is not available for kubernetes version '%s'", a.Name, version)
+		}
+	}
+	return nil
+}
+
+//addonRole service account role of the add-on, spawner creates one when add-on needs AWS access and none is given
+func (ctrl AWSController) addonRole(ctx context.Context, session *Session, cluster *eks.Cluster, addon *proto.Addon) (string, error) {
+	identity, ok := addonIdentities[addon.Name]
+	if !ok || addon.ServiceAccountRoleArn != "" {
+		return addon.ServiceAccountRoleArn, nil
+	}
+
+	clusterName := aws.StringValue(cluster.Name)
+	roleName := serviceAccountRoleName(clusterName, addonNamespace, identity.serviceAccount)
+	role, err := ctrl.serviceAccountRole(ctx, session, cluster, addonNamespace, identity.serviceAccount, roleName, []string{identity.policy})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to set up role for add-on '%s'", addon.Name)
+	}
+	return aws.StringValue(role.Arn), nil
+}
+
+//deleteAddonRoles delete the add-on service account roles spawner created for the cluster
+func (ctrl AWSController) deleteAddonRoles(ctx context.Context, session *Session, clusterName string) error {
+	client := session.getIAMClient()
+	for _, identity := range addonIdentities {
+		roleName := serviceAccountRoleName(clusterName, addonNamespace, identity.serviceAccount)
+		if err := ctrl.deleteServiceAccountRole(ctx, client, clusterName, roleName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//installAddon set up the add-on role and create the add-on, self managed components like vpc-cni are taken over
+func (ctrl AWSController) installAddon(ctx context.Context, session *Session, cluster *eks.Cluster, addon *proto.Addon) (*eks.Addon, error) {
+	roleArn, err := ctrl.addonRole(ctx, session, cluster, addon)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &eks.CreateAddonInput{
+		AddonName:        &addon.Name,
+		ClusterName:      cluster.Name,
+		ResolveConflicts: aws.String(eks.ResolveConflictsOverwrite),
+	}
+	if addon.Version != "" {
+		input.AddonVersion = &addon.Version
+	}
+	if roleArn != "" {
+		input.ServiceAccountRoleArn = &roleArn
+	}
+
+	out, err := session.getEksClient().CreateAddonWithContext(ctx, input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to install add-on '%s'", addon.Name)
+	}
+	return out.Addon, nil
+}
+
+//setupCluster wait for the cluster to become active, create its OIDC provider and install the add-ons requested with the cluster
+func (ctrl AWSController) setupCluster(session *Session, clusterName string, addons []*proto.Addon) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), addonTimeout)
+	defer cancel()
+
+	client := session.getEksClient()
+	err := client.WaitUntilClusterActiveWithContext(ctx, &eks.DescribeClusterInput{
+		Name: &clusterName,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("cluster did not become active, OIDC provider and add-ons not set up", "cluster", clusterName, "error", err)
+		return
+	}
+
+	cluster, err := getClusterSpec(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", clusterName)
+		return
+	}
+
+	//service account roles of the workloads need the provider, it is created even without add-ons
+	if _, err = ctrl.ensureOIDCProvider(ctx, session, cluster); err != nil {
+		ctrl.logger.Errorw("failed to create OIDC provider", "cluster", clusterName, "error", err)
+	}
+
+	for _, addon := range addons {
+		if _, err := ctrl.installAddon(ctx, session, cluster, addon); err != nil {
+			//rest of the add-ons can still go in, failed one can be retried with InstallAddon
+			ctrl.logger.Errorw("failed to install add-on", "cluster", clusterName, "addon", addon.Name, "error", err)
+			continue
+		}
+		ctrl.logger.Infow("add-on installed", "cluster", clusterName, "addon", addon.Name)
+	}
+}
+
+//ListAddons add-ons installed on the cluster and the ones available for its kubernetes version
+func (ctrl AWSController) ListAddons(ctx context.Context, req *proto.ListAddonsRequest) (*proto.ListAddonsResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+
+	available, err := availableAddons(ctx, client, aws.StringValue(cluster.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	names := []*string{}
+	err = client.ListAddonsPagesWithContext(ctx, &eks.ListAddonsInput{ClusterName: &req.ClusterName}, func(out *eks.ListAddonsOutput, last bool) bool {
+		names = append(names, out.Addons...)
+		return true
+	})
+	if err != nil {
+		ctrl.logger.Errorw("failed to list add-ons", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+
+	resp := &proto.ListAddonsResponse{
+		Addons:    []*proto.Addon{},
+		Available: []*proto.Addon{},
+	}
+
+	installed := map[string]struct{}{}
+	for _, name := range names {
+		out, err := client.DescribeAddonWithContext(ctx, &eks.DescribeAddonInput{
+			AddonName:   name,
+			ClusterName: &req.ClusterName,
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to describe add-on", "cluster", req.ClusterName, "addon", *name, "error", err)
+			return nil, err
+		}
+
+		addon := toAddon(out.Addon)
+		if info, ok := available[addon.Name]; ok {
+			addon.AvailableVersions = addonVersions(info)
+		}
+		installed[addon.Name] = struct{}{}
+		resp.Addons = append(resp.Addons, addon)
+	}
+
+	for name, info := range available {
+		if _, ok := installed[name]; ok {
+			continue
+		}
+		resp.Available = append(resp.Available, &proto.Addon{
+			Name:              name,
+			AvailableVersions: addonVersions(info),
+		})
+	}
+	sort.Slice(resp.Available, func(i, j int) bool {
+		return resp.Available[i].Name < resp.Available[j].Name
+	})
+	return resp, nil
+}
+
+//InstallAddon install EKS add-on on the cluster
+func (ctrl AWSController) InstallAddon(ctx context.Context, req *proto.InstallAddonRequest) (*proto.InstallAddonResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+	if err = checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+
+	if err = validateAddons(ctx, client, aws.StringValue(cluster.Version), []*proto.Addon{req.Addon}); err != nil {
+		return nil, err
+	}
+
+	addon, err := ctrl.installAddon(ctx, session, cluster, req.Addon)
+	if err != nil {
+		ctrl.logger.Errorw("failed to install add-on", "cluster", req.ClusterName, "addon", req.Addon.Name, "error", err)
+		return nil, err
+	}
+
+	ctrl.logger.Infow("installing add-on", "cluster", req.ClusterName, "addon", req.Addon.Name)
+	return &proto.InstallAddonResponse{Addon: toAddon(addon)}, nil
+}
+
+//UpdateAddon change the version or service account role of the installed add-on
+func (ctrl AWSController) UpdateAddon(ctx context.Context, req *proto.UpdateAddonRequest) (*proto.UpdateAddonResponse, error) {
+	if req.Addon.GetName() == "" {
+		return nil, errors.New("add-on name must be provided")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+	if err = checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+
+	//configuration changed in the cluster is not overwritten unless the caller asks for it, update fails instead
+	resolve := eks.ResolveConflictsNone
+	if req.ResolveConflicts != "" {
+		resolve = strings.ToUpper(req.ResolveConflicts)
+	}
+	valid := false
+	for _, v := range eks.ResolveConflicts_Values() {
+		valid = valid || v == resolve
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid resolveConflicts '%s', must be one of %s", req.ResolveConflicts, strings.Join(eks.ResolveConflicts_Values(), ", "))
+	}
+
+	roleArn, err := ctrl.addonRole(ctx, session, cluster, req.Addon)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &eks.UpdateAddonInput{
+		AddonName:        &req.Addon.Name,
+		ClusterName:      &req.ClusterName,
+		ResolveConflicts: &resolve,
+	}
+	if req.Addon.Version != "" {
+		input.AddonVersion = &req.Addon.Version
+	}
+	if roleArn != "" {
+		input.ServiceAccountRoleArn = &roleArn
+	}
+
+	if _, err = client.UpdateAddonWithContext(ctx, input); err != nil {
+		ctrl.logger.Errorw("failed to update add-on", "cluster", req.ClusterName, "addon", req.Addon.Name, "error", err)
+		return nil, err
+	}
+
+	out, err := client.DescribeAddonWithContext(ctx, &eks.DescribeAddonInput{
+		AddonName:   &req.Addon.Name,
+		ClusterName: &req.ClusterName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.logger.Infow("updating add-on", "cluster", req.ClusterName, "addon", req.Addon.Name, "version", req.Addon.Version)
+	return &proto.UpdateAddonResponse{Addon: toAddon(out.Addon)}, nil
+}
+
+//RemoveAddon remove the add-on from the cluster, preserve leaves the add-on resources running in the cluster
+func (ctrl AWSController) RemoveAddon(ctx context.Context, req *proto.RemoveAddonRequest) (*proto.RemoveAddonResponse, error) {
+	if req.Name == "" {
+		return nil, errors.New("add-on name must be provided")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+	if err = checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+
+	_, err = client.DeleteAddonWithContext(ctx, &eks.DeleteAddonInput{
+		AddonName:   &req.Name,
+		ClusterName: &req.ClusterName,
+		Preserve:    &req.Preserve,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("failed to remove add-on", "cluster", req.ClusterName, "addon", req.Name, "error", err)
+		return nil, err
+	}
+
+	ctrl.logger.Infow("removing add-on", "cluster", req.ClusterName, "addon", req.Name, "preserve", req.Preserve)
+	return &proto.RemoveAddonResponse{}, nil
+}
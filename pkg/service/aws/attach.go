@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	volumeAttachTimeout    = time.Minute * 5
+	volumeAttachPollPeriod = time.Second * 5
+)
+
+//attachDeviceNames device names AWS recommends for EBS volumes of linux instances
+var attachDeviceNames = func() []string {
+	names := []string{}
+	for c := 'f'; c <= 'p'; c++ {
+		names = append(names, fmt.Sprintf("/dev/sd%c", c))
+	}
+	return names
+}()
+
+//nodeInstance instance of the cluster node, kubernetes node name of EKS nodes is the private dns name of the instance
+func nodeInstance(ctx context.Context, client *ec2.EC2, node *proto.NodeReference) (string, error) {
+	if node.GetClusterName() == "" || node.GetNodeName() == "" {
+		return "", errors.New("instanceId or node cluster and name are required")
+	}
+
+	filters := []*ec2.Filter{
+		{
+			Name:   aws.String("tag:eks:cluster-name"),
+			Values: []*string{&node.ClusterName},
+		},
+		{
+			Name:   aws.String("private-dns-name"),
+			Values: []*string{&node.NodeName},
+		},
+		{
+			Name:   aws.String("instance-state-name"),
+			Values: aws.StringSlice([]string{ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning, ec2.InstanceStateNameStopping, ec2.InstanceStateNameStopped}),
+		},
+	}
+	if node.NodeGroup != "" {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("tag:eks:nodegroup-name"),
+			Values: []*string{&node.NodeGroup},
+		})
+	}
+
+	out, err := client.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{Filters: filters})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find instance of node '%s'", node.NodeName)
+	}
+	for _, r := range out.Reservations {
+		for _, i := range r.Instances {
+			return aws.StringValue(i.InstanceId), nil
+		}
+	}
+	return "", fmt.Errorf("node '%s' not found in cluster '%s'", node.NodeName, node.ClusterName)
+}
+
+//volumeAndInstance volume and the instance it is attached to or detached from
+func volumeAndInstance(ctx context.Context, client *ec2.EC2, volumeID, instanceID string, node *proto.NodeReference) (*ec2.Volume, *ec2.Instance, error) {
+	if instanceID == "" {
+		id, err := nodeInstance(ctx, client, node)
+		if err != nil {
+			return nil, nil, err
+		}
+		instanceID = id
+	}
+
+	vols, err := client.DescribeVolumesWithContext(ctx, &ec2.DescribeVolumesInput{VolumeIds: []*string{&volumeID}})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get volume '%s'", volumeID)
+	}
+	if len(vols.Volumes) == 0 {
+		return nil, nil, fmt.Errorf("volume '%s' not found", volumeID)
+	}
+
+	out, err := client.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{InstanceIds: []*string{&instanceID}})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get instance '%s'", instanceID)
+	}
+	if len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return nil, nil, fmt.Errorf("instance '%s' not found", instanceID)
+	}
+	return vols.Volumes[0], out.Reservations[0].Instances[0], nil
+}
+
+func volumeAttachment(vol *ec2.Volume, instanceID string) *ec2.VolumeAttachment {
+	for _, a := range vol.Attachments {
+		if aws.StringValue(a.InstanceId) == instanceID {
+			return a
+		}
+	}
+	return nil
+}
+
+//freeDeviceName first recommended device name not used by the instance
+func freeDeviceName(instance *ec2.Instance) (string, error) {
+	used := map[string]bool{}
+	for _, m := range instance.BlockDeviceMappings {
+		//xen instances report the device as /dev/xvdX, it is the same name as /dev/sdX
+		name := aws.StringValue(m.DeviceName)
+		if strings.HasPrefix(name, "/dev/xvd") {
+			name = "/dev/sd" + strings.TrimPrefix(name, "/dev/xvd")
+		}
+		used[name] = true
+	}
+	for _, name := range attachDeviceNames {
+		if !used[name] {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("instance '%s' has no free device name for the volume", aws.StringValue(instance.InstanceId))
+}
+
+//waitForAttachment wait until the attachment of the volume to the instance is in the state, detached when attachment is gone
+func waitForAttachment(ctx context.Context, client *ec2.EC2, volumeID, instanceID, state string) error {
+	ctx, cancel := context.WithTimeout(ctx, volumeAttachTimeout)
+	defer cancel()
+
+	for {
+		out, err := client.DescribeVolumesWithContext(ctx, &ec2.DescribeVolumesInput{VolumeIds: []*string{&volumeID}})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get volume '%s'", volumeID)
+		}
+		if len(out.Volumes) == 0 {
+			return fmt.Errorf("volume '%s' not found", volumeID)
+		}
+
+		a := volumeAttachment(out.Volumes[0], instanceID)
+		current := ec2.VolumeAttachmentStateDetached
+		if a != nil {
+			current = aws.StringValue(a.State)
+		}
+		if current == state {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "volume '%s' is %s on instance '%s', expected %s", volumeID, current, instanceID, state)
+		case <-time.After(volumeAttachPollPeriod):
+		}
+	}
+}
+
+//AttachVolume attach the EBS volume to the instance with the first free device name, volume already attached to the instance is returned as is
+func (svc AWSController) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	logger := svc.logger
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	client := session.getEC2Client()
+
+	vol, instance, err := volumeAndInstance(ctx, client, req.Volumeid, req.InstanceId, req.Node)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := aws.StringValue(instance.InstanceId)
+
+	if a := volumeAttachment(vol, instanceID); a != nil {
+		if err = waitForAttachment(ctx, client, req.Volumeid, instanceID, ec2.VolumeAttachmentStateAttached); err != nil {
+			return nil, err
+		}
+		return &proto.AttachVolumeResponse{InstanceId: instanceID, Device: aws.StringValue(a.Device)}, nil
+	}
+	if aws.StringValue(vol.State) != ec2.VolumeStateAvailable {
+		return nil, fmt.Errorf("volume '%s' is %s, only available volume can be attached", req.Volumeid, aws.StringValue(vol.State))
+	}
+
+	volumeZone := aws.StringValue(vol.AvailabilityZone)
+	instanceZone := ""
+	if instance.Placement != nil {
+		instanceZone = aws.StringValue(instance.Placement.AvailabilityZone)
+	}
+	if volumeZone != instanceZone {
+		return nil, fmt.Errorf("volume '%s' is in %s and instance '%s' in %s, volume can be attached only to instances of its availability zone",
+			req.Volumeid, volumeZone, instanceID, instanceZone)
+	}
+
+	device, err := freeDeviceName(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.AttachVolumeWithContext(ctx, &ec2.AttachVolumeInput{
+		VolumeId:   &req.Volumeid,
+		InstanceId: &instanceID,
+		Device:     &device,
+	})
+	if err != nil {
+		logError("AttachVolume", logger, err)
+		return nil, err
+	}
+
+	if err = waitForAttachment(ctx, client, req.Volumeid, instanceID, ec2.VolumeAttachmentStateAttached); err != nil {
+		logger.Errorw("volume did not get attached", "volume", req.Volumeid, "instance", instanceID, "error", err)
+		return nil, err
+	}
+	logger.Infow("volume attached", "volume", req.Volumeid, "instance", instanceID, "device", device)
+	return &proto.AttachVolumeResponse{InstanceId: instanceID, Device: device}, nil
+}
+
+//DetachVolume detach the EBS volume from the instance, volume not attached to it is not an error
+func (svc AWSController) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	logger := svc.logger
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		logger.Errorw("Can't start AWS session", "error", err)
+		return nil, err
+	}
+	client := session.getEC2Client()
+
+	vol, instance, err := volumeAndInstance(ctx, client, req.Volumeid, req.InstanceId, req.Node)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := aws.StringValue(instance.InstanceId)
+
+	if volumeAttachment(vol, instanceID) == nil {
+		logger.Infow("volume is not attached to the instance", "volume", req.Volumeid, "instance", instanceID)
+		return &proto.DetachVolumeResponse{InstanceId: instanceID}, nil
+	}
+
+	_, err = client.DetachVolumeWithContext(ctx, &ec2.DetachVolumeInput{
+		VolumeId:   &req.Volumeid,
+		InstanceId: &instanceID,
+		Force:      aws.Bool(req.Force),
+	})
+	if err != nil {
+		logError("DetachVolume", logger, err)
+		return nil, err
+	}
+
+	if err = waitForAttachment(ctx, client, req.Volumeid, instanceID, ec2.VolumeAttachmentStateDetached); err != nil {
+		logger.Errorw("volume did not get detached", "volume", req.Volumeid, "instance", instanceID, "error", err)
+		return nil, err
+	}
+	logger.Infow("volume detached", "volume", req.Volumeid, "instance", instanceID)
+	return &proto.DetachVolumeResponse{InstanceId: instanceID}, nil
+}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+)
+
+func instanceWithDevices(names ...string) *ec2.Instance {
+	instance := &ec2.Instance{InstanceId: aws.String("i-0123")}
+	for _, n := range names {
+		instance.BlockDeviceMappings = append(instance.BlockDeviceMappings, &ec2.InstanceBlockDeviceMapping{DeviceName: aws.String(n)})
+	}
+	return instance
+}
+
+func Test_freeDeviceName(t *testing.T) {
+	tests := []struct {
+		name     string
+		instance *ec2.Instance
+		want     string
+		wantErr  bool
+	}{
+		{"root volume only", instanceWithDevices("/dev/xvda"), "/dev/sdf", false},
+		{"first name used", instanceWithDevices("/dev/xvda", "/dev/sdf"), "/dev/sdg", false},
+		{"xvd name is the same device", instanceWithDevices("/dev/xvda", "/dev/xvdf", "/dev/xvdg"), "/dev/sdh", false},
+		{"gap is reused", instanceWithDevices("/dev/sdf", "/dev/xvdh"), "/dev/sdg", false},
+		{"all names used", instanceWithDevices(attachDeviceNames...), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := freeDeviceName(tt.instance)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
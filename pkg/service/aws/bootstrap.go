@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	//bootstrapTimeout covers cluster creation, the default node group being added and the charts installed
+	bootstrapTimeout    = time.Minute * 90
+	bootstrapPollPeriod = time.Second * 30
+)
+
+//waitForActiveNodegroup nodes are added to the cluster after it is active, bootstrap waits for the first node group.
+//kubernetes token is valid only for a few minutes, so the wait happens on EKS and not on the cluster
+func waitForActiveNodegroup(ctx context.Context, client *eks.EKS, clusterName string) error {
+	for {
+		nodegroups, err := describeNodegroups(ctx, client, clusterName)
+		if err != nil {
+			return err
+		}
+		for _, ng := range nodegroups {
+			if aws.StringValue(ng.Status) == eks.NodegroupStatusActive {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "no nodegroup of the cluster became active")
+		case <-time.After(bootstrapPollPeriod):
+		}
+	}
+}
+
+//bootstrapCluster apply the bootstrap bundle requested with the cluster once it is active and has nodes
+func (ctrl AWSController) bootstrapCluster(session *Session, clusterName string, bundle *proto.Bootstrap) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), bootstrapTimeout)
+	defer cancel()
+
+	client := session.getEksClient()
+	err := client.WaitUntilClusterActiveWithContext(ctx, &eks.DescribeClusterInput{
+		Name: &clusterName,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("cluster did not become active, bootstrap not applied", "cluster", clusterName, "error", err)
+		return
+	}
+
+	if err = waitForActiveNodegroup(ctx, client, clusterName); err != nil {
+		ctrl.logger.Errorw("cluster has no active nodegroup, bootstrap not applied", "cluster", clusterName, "error", err)
+		return
+	}
+
+	cluster, err := getClusterSpec(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", clusterName)
+		return
+	}
+
+	k8s, err := session.getK8sClient(cluster)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get kubernetes client, bootstrap not applied", "cluster", clusterName, "error", err)
+		return
+	}
+	dynamicClient, err := session.getK8sDynamicClient(cluster)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get kubernetes dynamic client, bootstrap not applied", "cluster", clusterName, "error", err)
+		return
+	}
+
+	if err = kube.Bootstrap(ctx, k8s, dynamicClient, bundle, ctrl.logger.With("cluster", clusterName)); err != nil {
+		ctrl.logger.Errorw("failed to bootstrap cluster", "cluster", clusterName, "error", err)
+		return
+	}
+	ctrl.logger.Infow("cluster bootstrap completed, check GetBootstrapStatus for result of each step", "cluster", clusterName)
+}
+
+//GetBootstrapStatus result of each step of the bootstrap, read from the cluster
+func (ctrl AWSController) GetBootstrapStatus(ctx context.Context, req *proto.GetBootstrapStatusRequest) (*proto.GetBootstrapStatusResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := getClusterSpec(ctx, session.getEksClient(), req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+
+	k8s, err := session.getK8sClient(cluster)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get kubernetes client", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+
+	steps, err := kube.BootstrapStatus(ctx, k8s)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get bootstrap status", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+	return &proto.GetBootstrapStatusResponse{Steps: steps}, nil
+}
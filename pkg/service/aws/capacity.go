@@ -0,0 +1,341 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const ec2ServiceCode = "ec2"
+
+//instance classes sharing the running instances vCPU quota
+const (
+	classStandard = "standard"
+	classG        = "g"
+	classP        = "p"
+	classF        = "f"
+	classInf      = "inf"
+	classX        = "x"
+	classDL       = "dl"
+)
+
+//onDemandQuotaCodes service quota codes of running on-demand instances vCPU limit
+var onDemandQuotaCodes = map[string]string{
+	classStandard: "L-1216C47A",
+	classG:        "L-DB2E81BA",
+	classP:        "L-417A185B",
+	classF:        "L-74FC7D96",
+	classInf:      "L-1945791B",
+	classX:        "L-7295265B",
+	classDL:       "L-6E869C2A",
+}
+
+//spotQuotaCodes service quota codes of all spot instance requests vCPU limit
+var spotQuotaCodes = map[string]string{
+	classStandard: "L-34B43A08",
+	classG:        "L-3819A6DF",
+	classP:        "L-7212CCBC",
+	classF:        "L-88CF9481",
+	classInf:      "L-B5D1601B",
+	classX:        "L-E3A00192",
+}
+
+//instanceClass vCPU quota class of the instance type, empty if the class is not known
+func instanceClass(instanceType string) string {
+	prefix := instanceType
+	for i, r := range instanceType {
+		if unicode.IsDigit(r) {
+			prefix = instanceType[:i]
+			break
+		}
+	}
+
+	switch prefix {
+	case "a", "c", "d", "h", "i", "m", "r", "t", "z":
+		return classStandard
+	case "g", "vt":
+		return classG
+	case "p":
+		return classP
+	case "f":
+		return classF
+	case "inf":
+		return classInf
+	case "x":
+		return classX
+	case "dl":
+		return classDL
+	}
+	return ""
+}
+
+//clusterZones availability zones of the cluster subnets
+func clusterZones(ctx context.Context, client *ec2.EC2, cluster *eks.Cluster) ([]string, error) {
+	if cluster.ResourcesVpcConfig == nil || len(cluster.ResourcesVpcConfig.SubnetIds) == 0 {
+		return nil, nil
+	}
+
+	res, err := client.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: cluster.ResourcesVpcConfig.SubnetIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]struct{}{}
+	for _, s := range res.Subnets {
+		found[*s.AvailabilityZone] = struct{}{}
+	}
+
+	zones := make([]string, 0, len(found))
+	for z := range found {
+		zones = append(zones, z)
+	}
+	sort.Strings(zones)
+	return zones, nil
+}
+
+//checkOffering instance types must be offered in the region, and in the cluster zones when cluster is known
+func checkOffering(ctx context.Context, client *ec2.EC2, region string, zones []string, instanceTypes []*string) *proto.CapacityCheck {
+
+	locationType := ec2.LocationTypeRegion
+	locations := []string{region}
+	if len(zones) > 0 {
+		locationType = ec2.LocationTypeAvailabilityZone
+		locations = zones
+	}
+
+	offered := map[string]map[string]struct{}{}
+	err := client.DescribeInstanceTypeOfferingsPagesWithContext(ctx, &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: &locationType,
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: instanceTypes,
+			},
+		},
+	}, func(page *ec2.DescribeInstanceTypeOfferingsOutput, lastPage bool) bool {
+		for _, o := range page.InstanceTypeOfferings {
+			it := *o.InstanceType
+			if offered[it] == nil {
+				offered[it] = map[string]struct{}{}
+			}
+			offered[it][*o.Location] = struct{}{}
+		}
+		return true
+	})
+
+	if err != nil {
+		return common.WarningCheck(constants.CheckInstanceOffering, fmt.Sprintf("could not verify instance offerings: %s", err))
+	}
+
+	missing := []string{}
+	available := 0
+	for _, it := range instanceTypes {
+		notIn := []string{}
+		for _, l := range locations {
+			if _, ok := offered[*it][l]; !ok {
+				notIn = append(notIn, l)
+			}
+		}
+		if len(notIn) < len(locations) {
+			available++
+		}
+		if len(notIn) > 0 {
+			missing = append(missing, fmt.Sprintf("'%s' not offered in %s", *it, strings.Join(notIn, ", ")))
+		}
+	}
+
+	switch {
+	case available == 0:
+		return common.FailedCheck(constants.CheckInstanceOffering, strings.Join(missing, "; "))
+	case len(missing) > 0:
+		return common.WarningCheck(constants.CheckInstanceOffering, strings.Join(missing, "; "))
+	}
+	return common.PassedCheck(constants.CheckInstanceOffering, fmt.Sprintf("offered in %s", strings.Join(locations, ", ")))
+}
+
+//instanceVCPUs default vCPUs of the instance types
+func instanceVCPUs(ctx context.Context, client *ec2.EC2, instanceTypes []*string) (map[string]int64, error) {
+	res, err := client.DescribeInstanceTypesWithContext(ctx, &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: instanceTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vcpus := map[string]int64{}
+	for _, it := range res.InstanceTypes {
+		vcpus[*it.InstanceType] = aws.Int64Value(it.VCpuInfo.DefaultVCpus)
+	}
+	return vcpus, nil
+}
+
+//usedVCPUs vCPUs of running and pending instances of the class in the region
+func usedVCPUs(ctx context.Context, client *ec2.EC2, class string, spot bool) (int64, error) {
+	used := int64(0)
+	err := client.DescribeInstancesPagesWithContext(ctx, &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: aws.StringSlice([]string{ec2.InstanceStateNameRunning, ec2.InstanceStateNamePending}),
+			},
+		},
+	}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, r := range page.Reservations {
+			for _, i := range r.Instances {
+				isSpot := aws.StringValue(i.InstanceLifecycle) == ec2.InstanceLifecycleTypeSpot
+				if isSpot != spot || instanceClass(aws.StringValue(i.InstanceType)) != class || i.CpuOptions == nil {
+					continue
+				}
+				used += aws.Int64Value(i.CpuOptions.CoreCount) * aws.Int64Value(i.CpuOptions.ThreadsPerCore)
+			}
+		}
+		return true
+	})
+	return used, err
+}
+
+//vcpuQuota applied quota value, falls back to AWS default when quota was never changed for the account
+func vcpuQuota(ctx context.Context, client *servicequotas.ServiceQuotas, code string) (int64, error) {
+	input := &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(ec2ServiceCode),
+		QuotaCode:   &code,
+	}
+
+	res, err := client.GetServiceQuotaWithContext(ctx, input)
+	if err == nil {
+		return int64(aws.Float64Value(res.Quota.Value)), nil
+	}
+
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != servicequotas.ErrCodeNoSuchResourceException {
+		return 0, err
+	}
+
+	def, err := client.GetAWSDefaultServiceQuotaWithContext(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(ec2ServiceCode),
+		QuotaCode:   &code,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(aws.Float64Value(def.Quota.Value)), nil
+}
+
+//checkQuota vCPUs required for the node group must fit in the remaining vCPU quota of the instance class
+func checkQuota(ctx context.Context, session *Session, nodeSpec *proto.NodeSpec, capacityType string, instanceTypes []*string) *proto.CapacityCheck {
+
+	client := session.getEC2Client()
+	spot := capacityType == eks.CapacityTypesSpot
+
+	codes := onDemandQuotaCodes
+	if spot {
+		codes = spotQuotaCodes
+	}
+
+	class := instanceClass(aws.StringValue(instanceTypes[0]))
+	code, ok := codes[class]
+	if !ok {
+		return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("vCPU quota of instance '%s' is not checked", aws.StringValue(instanceTypes[0])))
+	}
+
+	vcpus, err := instanceVCPUs(ctx, client, instanceTypes)
+	if err != nil {
+		return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("could not get instance vCPUs: %s", err))
+	}
+
+	//spot node group could launch any of the instance types, consider the largest one
+	perNode := int64(0)
+	for _, v := range vcpus {
+		if v > perNode {
+			perNode = v
+		}
+	}
+
+	count := int64(1)
+	if nodeSpec.Count != 0 {
+		count = nodeSpec.Count
+	}
+	required := perNode * count
+
+	quota, err := vcpuQuota(ctx, session.getServiceQuotasClient(), code)
+	if err != nil {
+		return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("could not get vCPU quota '%s': %s", code, err))
+	}
+
+	used, err := usedVCPUs(ctx, client, class, spot)
+	if err != nil {
+		return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("could not get vCPU usage: %s", err))
+	}
+
+	if used+required > quota {
+		return common.FailedCheck(constants.CheckVCPUQuota, fmt.Sprintf("requires %d vCPUs, %d of %d vCPU quota '%s' is in use", required, used, quota, code))
+	}
+	return common.PassedCheck(constants.CheckVCPUQuota, fmt.Sprintf("requires %d vCPUs, %d of %d vCPU quota '%s' is in use", required, used, quota, code))
+}
+
+//preflight check instance availability and quota for the node spec, cluster is optional
+func (ctrl AWSController) preflight(ctx context.Context, session *Session, cluster *eks.Cluster, nodeSpec *proto.NodeSpec) (*proto.CheckCapacityResponse, error) {
+
+	capacityType, instanceTypes, err := getInstance(nodeSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(instanceTypes) == 0 {
+		return nil, fmt.Errorf("no instance types provided for nodegroup '%s'", nodeSpec.Name)
+	}
+
+	if err = validateZones(ctx, session, nodeSpec.AvailabilityZones); err != nil {
+		return nil, err
+	}
+
+	zones := nodeSpec.AvailabilityZones
+	if len(zones) == 0 && cluster != nil {
+		zones, err = clusterZones(ctx, session.getEC2Client(), cluster)
+		if err != nil {
+			ctrl.logger.Warnw("failed to get cluster zones, checking instance offering in region", "error", err)
+		}
+	}
+
+	checks := []*proto.CapacityCheck{
+		checkOffering(ctx, session.getEC2Client(), session.Region, zones, instanceTypes),
+		checkQuota(ctx, session, nodeSpec, capacityType, instanceTypes),
+	}
+	return common.CapacityResponse(checks), nil
+}
+
+//CheckCapacity preflight instance availability and quota checks for the node group
+func (ctrl AWSController) CheckCapacity(ctx context.Context, req *proto.CheckCapacityRequest) (*proto.CheckCapacityResponse, error) {
+
+	if req.NodeSpec == nil {
+		return nil, fmt.Errorf("nodeSpec must be provided")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	var cluster *eks.Cluster
+	if req.ClusterName != "" {
+		cluster, err = getClusterSpec(ctx, session.getEksClient(), req.ClusterName)
+		if err != nil {
+			ctrl.logger.Errorw("failed to get cluster", "cluster", req.ClusterName, "error", err)
+			return nil, err
+		}
+	}
+
+	return ctrl.preflight(ctx, session, cluster, req.NodeSpec)
+}
@@ -3,17 +3,22 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+//clusterCleanupTimeout time given to EKS to delete the cluster before spawner stops waiting to clean up after it
+const clusterCleanupTimeout = time.Minute * 30
+
 //getClusterSpec Get the cluster spec of given name
 func getClusterSpec(ctx context.Context, client *eks.EKS, name string) (*eks.Cluster, error) {
 	input := eks.DescribeClusterInput{
@@ -26,35 +31,56 @@ func getClusterSpec(ctx context.Context, client *eks.EKS, name string) (*eks.Clu
 func (svc AWSController) createClusterInternal(ctx context.Context, session *Session, clusterName string, req *proto.ClusterRequest) (*eks.Cluster, error) {
 
 	var subnetIds []*string
+	var vpcId string
 	region := session.Region
 
-	awsRegionNetworkStack, err := GetRegionWkspNetworkStack(session)
-	if err != nil {
-		svc.logger.Errorw("error getting network stack for region", "region", region, "error", err)
-		return nil, err
-	}
-
-	if awsRegionNetworkStack.Vpc != nil && len(awsRegionNetworkStack.Subnets) > 0 {
-		for _, subn := range awsRegionNetworkStack.Subnets {
-			subnetIds = append(subnetIds, subn.SubnetId)
-		}
-		svc.logger.Infow("got network stack for region", "vpc", awsRegionNetworkStack.Vpc.VpcId, "subnets", subnetIds)
+	if isCustomNetwork(req) {
+		svc.logger.Infow("using provided network for cluster", "vpc", req.VpcId, "subnets", req.SubnetIds)
 	} else {
-		awsRegionNetworkStack, err = CreateRegionWkspNetworkStack(session)
+		awsRegionNetworkStack, err := GetRegionWkspNetworkStack(session)
 		if err != nil {
-			svc.logger.Errorw("error creating network stack for region with no clusters", "region", region, "error", err)
-			svc.logger.Warnw("rolling back network stack changes as creation failed", "region", region)
-			delErr := DeleteRegionWkspNetworkStack(session, *awsRegionNetworkStack)
-			if delErr != nil {
-				svc.logger.Errorw("error deleting network stack for region", "region", region, "error", delErr)
-			}
-
+			svc.logger.Errorw("error getting network stack for region", "region", region, "error", err)
 			return nil, err
 		}
-		for _, subn := range awsRegionNetworkStack.Subnets {
-			subnetIds = append(subnetIds, subn.SubnetId)
+
+		if awsRegionNetworkStack.Vpc != nil && len(awsRegionNetworkStack.Subnets) > 0 {
+			for _, subn := range awsRegionNetworkStack.Subnets {
+				subnetIds = append(subnetIds, subn.SubnetId)
+			}
+			svc.logger.Infow("got network stack for region", "vpc", awsRegionNetworkStack.Vpc.VpcId, "subnets", subnetIds)
+			if req.NetworkStack != nil {
+				svc.logger.Warnw("network stack already exists in region, networkStack options are ignored", "region", region)
+			}
+		} else {
+			awsRegionNetworkStack, err = CreateRegionWkspNetworkStack(ctx, session, req.NetworkStack)
+			if err != nil {
+				svc.logger.Errorw("error creating network stack for region with no clusters", "region", region, "error", err)
+				svc.logger.Warnw("rolling back network stack changes as creation failed", "region", region)
+				delErr := DeleteRegionWkspNetworkStack(ctx, session, *awsRegionNetworkStack)
+				if delErr != nil {
+					svc.logger.Errorw("error deleting network stack for region", "region", region, "error", delErr)
+				}
+
+				return nil, err
+			}
+			for _, subn := range awsRegionNetworkStack.Subnets {
+				subnetIds = append(subnetIds, subn.SubnetId)
+			}
+			svc.logger.Infow("created network stack for region", "vpc", awsRegionNetworkStack.Vpc.VpcId, "subnets", subnetIds)
 		}
-		svc.logger.Infow("created network stack for region", "vpc", awsRegionNetworkStack.Vpc.VpcId, "subnets", subnetIds)
+		vpcId = aws.StringValue(awsRegionNetworkStack.Vpc.VpcId)
+	}
+
+	vpcConfig, err := vpcConfig(ctx, session, req, vpcId, subnetIds)
+	if err != nil {
+		svc.logger.Errorw("invalid cluster network", "region", region, "error", err)
+		return nil, err
+	}
+
+	//node pools are created later, make sure network covers the requested zones before creating the cluster
+	if _, err = nodeSubnets(ctx, session, vpcConfig.SubnetIds, req.Node.GetAvailabilityZones()); err != nil {
+		svc.logger.Errorw("requested availability zones not covered by cluster network", "region", region, "error", err)
+		return nil, err
 	}
 
 	tags := labels.DefaultTags()
@@ -90,21 +116,41 @@ func (svc AWSController) createClusterInternal(ctx context.Context, session *Ses
 	}
 
 	clusterInput := &eks.CreateClusterInput{
-		Name: &clusterName,
-		ResourcesVpcConfig: &eks.VpcConfigRequest{
-			SubnetIds:             subnetIds,
-			EndpointPublicAccess:  aws.Bool(true),
-			EndpointPrivateAccess: aws.Bool(false),
-		},
-		Tags: tags,
-		//		Version: &constants.KubeVersion,
-		RoleArn: eksRole.Arn,
+		Name:               &clusterName,
+		ResourcesVpcConfig: vpcConfig,
+		Tags:               tags,
+		RoleArn:            eksRole.Arn,
+	}
+
+	if req.Logging != nil {
+		clusterInput.Logging, err = clusterLogging(req.Logging)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Encryption.GetEnabled() {
+		keyArn, err := svc.encryptionKey(ctx, session, clusterName, aws.StringValue(eksRole.Arn), req.Encryption)
+		if err != nil {
+			svc.logger.Errorw("failed to get secrets encryption key", "cluster", clusterName, "error", err)
+			return nil, err
+		}
+		clusterInput.EncryptionConfig = encryptionConfig(keyArn)
+	}
+
+	//provider default version is used when not specified
+	if req.KubernetesVersion != "" {
+		clusterInput.Version = &req.KubernetesVersion
 	}
 
 	client := session.getEksClient()
 	createClusterOutput, err := client.CreateClusterWithContext(ctx, clusterInput)
 	if err != nil {
 		svc.logger.Errorw("failed to create cluster", "error", err)
+		//key of a cluster which exists already must stay
+		if aerr, ok := err.(awserr.Error); req.Encryption.GetEnabled() && (!ok || aerr.Code() != eks.ErrCodeResourceInUseException) {
+			svc.releaseEncryptionKey(ctx, session, clusterName, req.Encryption)
+		}
 		return nil, err
 	}
 
@@ -130,6 +176,21 @@ func isExist(ctx context.Context, client *eks.EKS, name string) (bool, error) {
 	return false, nil
 }
 
+//ignoredOptions Azure only options of the request, they are not applied to EKS cluster
+func ignoredOptions(req *proto.ClusterRequest) []string {
+	warnings := []string{}
+	if req.AzureNetwork != nil {
+		warnings = append(warnings, "azureNetwork is ignored on aws, use vpcId, subnetIds, endpointAccess and publicAccessCidrs")
+	}
+	if req.Identity != nil {
+		warnings = append(warnings, "identity is ignored on aws, cluster uses spawner created IAM role")
+	}
+	if req.ResourceGroup != "" {
+		warnings = append(warnings, "resourceGroup is ignored on aws")
+	}
+	return warnings
+}
+
 //CreateCluster Create new cluster with given specification, no op if cluster already exist
 func (ctrl AWSController) CreateCluster(ctx context.Context, req *proto.ClusterRequest) (*proto.ClusterResponse, error) {
 
@@ -147,6 +208,23 @@ func (ctrl AWSController) CreateCluster(ctx context.Context, req *proto.ClusterR
 	}
 	eksClient := session.getEksClient()
 
+	if err = validateZones(ctx, session, req.Node.GetAvailabilityZones()); err != nil {
+		return nil, err
+	}
+
+	if err = validateAddons(ctx, eksClient, req.KubernetesVersion, req.Addons); err != nil {
+		return nil, err
+	}
+
+	if err = kube.ValidateBootstrap(req.Bootstrap); err != nil {
+		return nil, err
+	}
+
+	warnings := ignoredOptions(req)
+	for _, w := range warnings {
+		ctrl.logger.Warnw("ignoring cluster option", "cluster", clusterName, "warning", w)
+	}
+
 	ctrl.logger.Debugf("checking cluster status for '%s', region '%s'", clusterName, region)
 	exist, err := isExist(ctx, eksClient, clusterName)
 
@@ -168,8 +246,14 @@ func (ctrl AWSController) CreateCluster(ctx context.Context, req *proto.ClusterR
 
 	ctrl.logger.Infow("cluster is in creating state, it might take some time, please check AWS console for status", "cluster", clusterName)
 
+	go ctrl.setupCluster(session, clusterName, req.Addons)
+	if req.Bootstrap != nil {
+		go ctrl.bootstrapCluster(session, clusterName, req.Bootstrap)
+	}
+
 	return &proto.ClusterResponse{
 		ClusterName: *cluster.Name,
+		Warnings:    warnings,
 	}, nil
 }
 
@@ -226,40 +310,39 @@ func (ctrl AWSController) GetClusters(ctx context.Context, req *proto.GetCluster
 			continue
 		}
 
-		input := &eks.ListNodegroupsInput{ClusterName: cluster}
-		nodeGroupList, err := client.ListNodegroupsWithContext(ctx, input)
+		var state proto.ClusterState
+		nodegroups, err := describeNodegroups(ctx, client, *cluster)
 		if err != nil {
 			ctrl.logger.Errorf("failed to fetch nodegroups %s", err.Error())
+			state = clusterStateWithoutNodegroups(clusterSpec)
+		} else {
+			state = clusterState(clusterSpec, nodegroups)
 		}
 
 		nodes := []*proto.NodeSpec{}
-		for _, cNodeGroup := range nodeGroupList.Nodegroups {
-			input := &eks.DescribeNodegroupInput{
-				NodegroupName: cNodeGroup,
-				ClusterName:   cluster}
-			nodeGroupDetails, err := client.DescribeNodegroupWithContext(ctx, input)
+		for name, ng := range nodegroups {
+			node := &proto.NodeSpec{Name: name}
 
-			if err != nil {
-				ctrl.logger.Error("failed to fetch nodegroups details ", *cNodeGroup)
-				continue
-			}
-
-			node := &proto.NodeSpec{Name: *cNodeGroup}
-
-			if nodeGroupDetails.Nodegroup.InstanceTypes != nil {
-				node.Instance = *nodeGroupDetails.Nodegroup.InstanceTypes[0]
+			if ng.InstanceTypes != nil {
+				node.Instance = *ng.InstanceTypes[0]
 			}
-			if nodeGroupDetails.Nodegroup.DiskSize != nil {
-				node.DiskSize = int32(*nodeGroupDetails.Nodegroup.DiskSize)
+			if ng.DiskSize != nil {
+				node.DiskSize = int32(*ng.DiskSize)
 			}
+			node.KubernetesVersion = aws.StringValue(ng.Version)
 
-			node.Health = healthProto(nodeGroupDetails.Nodegroup.Health)
+			node.Health = healthProto(ng.Health)
+			node.PoolState = nodegroupState(ng)
+			node.ProviderStatus = aws.StringValue(ng.Status)
 			nodes = append(nodes, node)
 		}
 
 		resp.Clusters = append(resp.Clusters, &proto.ClusterSpec{
-			Name:     *cluster,
-			NodeSpec: nodes,
+			Name:              *cluster,
+			NodeSpec:          nodes,
+			KubernetesVersion: aws.StringValue(clusterSpec.Version),
+			State:             state,
+			ProviderStatus:    aws.StringValue(clusterSpec.Status),
 		})
 	}
 	return &resp, nil
@@ -294,12 +377,23 @@ func (ctrl AWSController) GetCluster(ctx context.Context, req *proto.GetClusterR
 	}
 	nodeList, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	response.Name = clusterName
+	response.KubernetesVersion = aws.StringValue(cluster.Version)
 
 	if err != nil {
 		ctrl.logger.Error(" Failed to query node list ", err)
 		return nil, err
 	}
 
+	//cluster is returned without node group state when they cannot be read, node pool state is unknown then
+	nodegroups, err := describeNodegroups(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("failed to fetch nodegroups", "error", err, "cluster", clusterName)
+		response.State = clusterStateWithoutNodegroups(cluster)
+	} else {
+		response.State = clusterState(cluster, nodegroups)
+	}
+	response.ProviderStatus = aws.StringValue(cluster.Status)
+
 	var nodeSpecList []*proto.NodeSpec
 	for _, node := range nodeList.Items {
 		nodeGroupName := node.Labels[constants.NodeNameLabel]
@@ -327,11 +421,12 @@ func (ctrl AWSController) GetCluster(ctx context.Context, req *proto.GetClusterR
 
 		//get node health
 		var nodeHealth *proto.Health
-		health, err := ctrl.getNodeHealth(ctx, client, clusterName, nodeGroupName)
-		if err != nil {
-			ctrl.logger.Errorw("failed to get the health check", "error", err)
-		} else {
-			nodeHealth = healthProto(health)
+		poolState := proto.ClusterState_STATE_UNKNOWN
+		poolStatus := ""
+		if ng, ok := nodegroups[nodeGroupName]; ok {
+			nodeHealth = healthProto(ng.Health)
+			poolState = nodegroupState(ng)
+			poolStatus = aws.StringValue(ng.Status)
 		}
 
 		//we will use MB for the disk size, int32 is too small for bytes
@@ -348,6 +443,10 @@ func (ctrl AWSController) GetCluster(ctx context.Context, req *proto.GetClusterR
 			Labels:           node.Labels,
 			Availabilityzone: node.Labels["topology.kubernetes.io/zone"],
 			Health:           nodeHealth,
+			PoolState:        poolState,
+			ProviderStatus:   poolStatus,
+			//kubelet version is reported as v1.21.5-eks-9017834, keep it as is
+			KubernetesVersion: node.Status.NodeInfo.KubeletVersion,
 		})
 	}
 	response.NodeSpec = nodeSpecList
@@ -375,9 +474,55 @@ func (ctrl AWSController) ClusterStatus(ctx context.Context, req *proto.ClusterS
 		}, err
 	}
 
+	var state proto.ClusterState
+	nodegroups, err := describeNodegroups(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("failed to fetch nodegroups", "error", err, "cluster", clusterName, "region", region)
+		state = clusterStateWithoutNodegroups(cluster)
+	} else {
+		state = clusterState(cluster, nodegroups)
+	}
+
 	return &proto.ClusterStatusResponse{
-		Status: *cluster.Status,
-	}, err
+		Status:         *cluster.Status,
+		State:          state,
+		ProviderStatus: *cluster.Status,
+	}, nil
+}
+
+//cleanupDeletedCluster wait for the cluster deletion and remove the IAM resources and the secrets encryption key created
+//for it, they are left in place when the deletion does not complete so the cluster keeps working
+func (ctrl AWSController) cleanupDeletedCluster(session *Session, cluster *eks.Cluster) {
+	ctx, cancel := context.WithTimeout(context.Background(), clusterCleanupTimeout)
+	defer cancel()
+
+	clusterName := aws.StringValue(cluster.Name)
+	err := session.getEksClient().WaitUntilClusterDeletedWithContext(ctx, &eks.DescribeClusterInput{Name: cluster.Name})
+	if err != nil {
+		ctrl.logger.Errorw("failed waiting for cluster deletion, IAM resources of the cluster are not deleted", "cluster", clusterName, "error", err)
+		return
+	}
+
+	//provider is looked up by cluster issuer, which is taken from the cluster spec read before deletion
+	if err = ctrl.deleteOIDCProvider(ctx, session, cluster); err != nil {
+		ctrl.logger.Warnw("failed to delete OIDC provider of cluster", "cluster", clusterName, "error", err)
+	}
+
+	if err = ctrl.deleteAddonRoles(ctx, session, clusterName); err != nil {
+		ctrl.logger.Warnw("failed to delete add-on roles of cluster", "cluster", clusterName, "error", err)
+	}
+
+	if err = ctrl.scheduleKeyDeletion(ctx, session, clusterName); err != nil {
+		ctrl.logger.Warnw("failed to schedule deletion of secrets encryption key", "cluster", clusterName, "error", err)
+	}
+}
+
+//checkClusterScope cluster is created by spawner of this env scope, clusters of other scopes are not touched
+func checkClusterScope(cluster *eks.Cluster) error {
+	if scope, ok := cluster.Tags[constants.Scope]; !ok || aws.StringValue(scope) != labels.ScopeTag() {
+		return fmt.Errorf("cluster '%s' not available in scope '%s'", aws.StringValue(cluster.Name), labels.ScopeTag())
+	}
+	return nil
 }
 
 //DeleteCluster delete empty cluster, cluster should not have any nodegroup attached.
@@ -407,8 +552,13 @@ func (ctrl AWSController) DeleteCluster(ctx context.Context, req *proto.ClusterD
 	//get node groups attached to clients when force delete is enabled.
 	//if available delete all attached node groups and proceed to deleting cluster
 	if forceDelete {
+		if req.Drain {
+			//nodes of every spawner node pool carry the node-name label
+			ctrl.drainNodes(ctx, session, client, clusterName, constants.NodeNameLabel, req.DrainTimeoutSeconds)
+		}
+
 		ctrl.logger.Infow("force deleting all nodegroups of cluster", "cluster", clusterName)
-		err = ctrl.deleteAllNodegroups(ctx, client, clusterName)
+		err = ctrl.deleteAllNodegroups(ctx, session, clusterName)
 		if err != nil {
 			ctrl.logger.Errorw("failed to delete attached nodegroups", "error", err)
 			return nil, err
@@ -436,5 +586,7 @@ func (ctrl AWSController) DeleteCluster(ctx context.Context, req *proto.ClusterD
 
 	ctrl.logger.Infof("requested cluster '%s' to be deleted, Status :%s. It might take some time, check AWS console for more.", clusterName, *deleteOut.Cluster.Status)
 
+	go ctrl.cleanupDeletedCluster(session, cluster)
+
 	return &proto.ClusterDeleteResponse{}, nil
 }
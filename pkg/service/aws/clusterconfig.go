@@ -0,0 +1,298 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	//encryptedResources EKS supports envelope encryption only for secrets
+	encryptedResources = "secrets"
+	keyAliasFmt        = "alias/spawner/%s"
+	//keyDeletionWindow days before the key of deleted cluster is gone, data encrypted with it is not recoverable after that
+	keyDeletionWindow = 30
+
+	keyPolicyRetries    = 5
+	keyPolicyRetryDelay = time.Second * 10
+	configUpdateTimeout = time.Minute * 30
+)
+
+//clusterLogging log setup enabling the requested types and disabling rest, so update turns off types not asked for
+func clusterLogging(logging *proto.ClusterLogging) (*eks.Logging, error) {
+	requested := map[string]bool{}
+	for _, t := range logging.GetTypes() {
+		requested[t] = true
+	}
+
+	enabled := []*string{}
+	disabled := []*string{}
+	for _, t := range eks.LogType_Values() {
+		if requested[t] {
+			enabled = append(enabled, aws.String(t))
+			delete(requested, t)
+		} else {
+			disabled = append(disabled, aws.String(t))
+		}
+	}
+
+	if len(requested) > 0 {
+		invalid := []string{}
+		for t := range requested {
+			invalid = append(invalid, t)
+		}
+		return nil, fmt.Errorf("invalid log types %s, supported types are %s", strings.Join(invalid, ", "), strings.Join(eks.LogType_Values(), ", "))
+	}
+
+	setup := []*eks.LogSetup{}
+	if len(enabled) > 0 {
+		setup = append(setup, &eks.LogSetup{Enabled: aws.Bool(true), Types: enabled})
+	}
+	if len(disabled) > 0 {
+		setup = append(setup, &eks.LogSetup{Enabled: aws.Bool(false), Types: disabled})
+	}
+	return &eks.Logging{ClusterLogging: setup}, nil
+}
+
+func keyAlias(clusterName string) string {
+	return fmt.Sprintf(keyAliasFmt, clusterName)
+}
+
+//keyPolicy account keeps the administration of the key, cluster role gets to use it for the secrets
+func keyPolicy(accountId, clusterRoleArn string) (string, error) {
+	doc := map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Sid":       "AccountAdministration",
+				"Effect":    "Allow",
+				"Principal": map[string]string{"AWS": fmt.Sprintf("arn:aws:iam::%s:root", accountId)},
+				"Action":    "kms:*",
+				"Resource":  "*",
+			},
+			{
+				"Sid":       "ClusterSecretsEncryption",
+				"Effect":    "Allow",
+				"Principal": map[string]string{"AWS": clusterRoleArn},
+				"Action":    []string{"kms:Encrypt", "kms:Decrypt", "kms:DescribeKey", "kms:ListGrants", "kms:CreateGrant"},
+				"Resource":  "*",
+			},
+		},
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+//encryptionKey key for the cluster secrets, a key is created and aliased by cluster name when not given
+func (ctrl AWSController) encryptionKey(ctx context.Context, session *Session, clusterName, clusterRoleArn string, encryption *proto.SecretsEncryption) (string, error) {
+	if encryption.KeyId != "" {
+		return encryption.KeyId, nil
+	}
+
+	client := session.getKMSClient()
+
+	//key of an earlier attempt to create the cluster
+	existing, err := client.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyAlias(clusterName))})
+	if err == nil && aws.StringValue(existing.KeyMetadata.KeyState) == kms.KeyStateEnabled {
+		return aws.StringValue(existing.KeyMetadata.Arn), nil
+	}
+
+	identity, err := session.getSTSClient().GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get account id")
+	}
+
+	policy, err := keyPolicy(aws.StringValue(identity.Account), clusterRoleArn)
+	if err != nil {
+		return "", err
+	}
+
+	input := &kms.CreateKeyInput{
+		Description: aws.String(fmt.Sprintf("secrets encryption of cluster %s", clusterName)),
+		Policy:      &policy,
+		Tags: []*kms.Tag{
+			{
+				TagKey:   aws.String(constants.CreatorLabel),
+				TagValue: aws.String(constants.SpawnerServiceLabel),
+			},
+			{
+				TagKey:   aws.String(constants.ClusterNameLabel),
+				TagValue: &clusterName,
+			},
+			{
+				TagKey:   aws.String(constants.Scope),
+				TagValue: aws.String(labels.ScopeTag()),
+			},
+		},
+	}
+
+	var out *kms.CreateKeyOutput
+	for i := 0; i < keyPolicyRetries; i++ {
+		out, err = client.CreateKeyWithContext(ctx, input)
+		//newly created cluster role takes a while to be visible to KMS
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != kms.ErrCodeMalformedPolicyDocumentException {
+			break
+		}
+		time.Sleep(keyPolicyRetryDelay)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create secrets encryption key")
+	}
+
+	_, err = client.CreateAliasWithContext(ctx, &kms.CreateAliasInput{
+		AliasName:   aws.String(keyAlias(clusterName)),
+		TargetKeyId: out.KeyMetadata.KeyId,
+	})
+	if err != nil {
+		ctrl.logger.Warnw("failed to create alias for secrets encryption key", "cluster", clusterName, "key", aws.StringValue(out.KeyMetadata.Arn), "error", err)
+	}
+
+	ctrl.logger.Infow("created secrets encryption key", "cluster", clusterName, "key", aws.StringValue(out.KeyMetadata.Arn))
+	return aws.StringValue(out.KeyMetadata.Arn), nil
+}
+
+func encryptionConfig(keyArn string) []*eks.EncryptionConfig {
+	return []*eks.EncryptionConfig{
+		{
+			Provider:  &eks.Provider{KeyArn: &keyArn},
+			Resources: aws.StringSlice([]string{encryptedResources}),
+		},
+	}
+}
+
+//scheduleKeyDeletion schedule deletion of the key spawner created for the cluster, keys given by user are left alone
+func (ctrl AWSController) scheduleKeyDeletion(ctx context.Context, session *Session, clusterName string) error {
+	client := session.getKMSClient()
+	alias := keyAlias(clusterName)
+
+	key, err := client.DescribeKeyWithContext(ctx, &kms.DescribeKeyInput{KeyId: &alias})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == kms.ErrCodeNotFoundException {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err = client.DeleteAliasWithContext(ctx, &kms.DeleteAliasInput{AliasName: &alias}); err != nil {
+		return err
+	}
+
+	_, err = client.ScheduleKeyDeletionWithContext(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               key.KeyMetadata.KeyId,
+		PendingWindowInDays: aws.Int64(keyDeletionWindow),
+	})
+	return err
+}
+
+//releaseEncryptionKey schedule deletion of the key created for the cluster when it could not be used
+func (ctrl AWSController) releaseEncryptionKey(ctx context.Context, session *Session, clusterName string, encryption *proto.SecretsEncryption) {
+	if encryption.GetKeyId() != "" {
+		return
+	}
+	if err := ctrl.scheduleKeyDeletion(ctx, session, clusterName); err != nil {
+		ctrl.logger.Warnw("failed to schedule deletion of unused secrets encryption key", "cluster", clusterName, "error", err)
+	}
+}
+
+func clusterKeyArn(cluster *eks.Cluster) string {
+	for _, e := range cluster.EncryptionConfig {
+		if e.Provider != nil {
+			return aws.StringValue(e.Provider.KeyArn)
+		}
+	}
+	return ""
+}
+
+//UpdateClusterConfig change control plane logging and enable secrets encryption on the cluster,
+//EKS runs one update at a time so encryption waits for the logging update
+func (ctrl AWSController) UpdateClusterConfig(ctx context.Context, req *proto.UpdateClusterConfigRequest) (*proto.UpdateClusterConfigResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+	if err = checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+
+	currentKey := clusterKeyArn(cluster)
+	encrypt := req.Encryption != nil && req.Encryption.Enabled && currentKey == ""
+	if req.Encryption != nil && !req.Encryption.Enabled && currentKey != "" {
+		return nil, fmt.Errorf("secrets encryption of cluster '%s' cannot be turned off", req.ClusterName)
+	}
+	if req.Encryption.GetEnabled() && currentKey != "" && req.Encryption.KeyId != "" && req.Encryption.KeyId != currentKey {
+		return nil, fmt.Errorf("secrets of cluster '%s' are already encrypted with key '%s'", req.ClusterName, currentKey)
+	}
+
+	resp := &proto.UpdateClusterConfigResponse{UpdateIds: []string{}}
+
+	if req.Logging != nil {
+		logging, err := clusterLogging(req.Logging)
+		if err != nil {
+			return nil, err
+		}
+
+		out, err := client.UpdateClusterConfigWithContext(ctx, &eks.UpdateClusterConfigInput{
+			Name:    &req.ClusterName,
+			Logging: logging,
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to update cluster logging", "cluster", req.ClusterName, "error", err)
+			return nil, err
+		}
+		resp.UpdateIds = append(resp.UpdateIds, aws.StringValue(out.Update.Id))
+		ctrl.logger.Infow("updating cluster logging", "cluster", req.ClusterName, "types", req.Logging.Types)
+
+		if encrypt {
+			waitCtx, cancel := context.WithTimeout(ctx, configUpdateTimeout)
+			err = waitForUpdate(waitCtx, client, req.ClusterName, nil, out.Update.Id)
+			cancel()
+			if err != nil {
+				ctrl.logger.Errorw("logging update did not complete, secrets encryption not enabled", "cluster", req.ClusterName, "error", err)
+				return nil, err
+			}
+		}
+	}
+
+	if encrypt {
+		keyArn, err := ctrl.encryptionKey(ctx, session, req.ClusterName, aws.StringValue(cluster.RoleArn), req.Encryption)
+		if err != nil {
+			return nil, err
+		}
+
+		out, err := client.AssociateEncryptionConfigWithContext(ctx, &eks.AssociateEncryptionConfigInput{
+			ClusterName:      &req.ClusterName,
+			EncryptionConfig: encryptionConfig(keyArn),
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to enable secrets encryption", "cluster", req.ClusterName, "error", err)
+			ctrl.releaseEncryptionKey(ctx, session, req.ClusterName, req.Encryption)
+			return nil, err
+		}
+		resp.UpdateIds = append(resp.UpdateIds, aws.StringValue(out.Update.Id))
+		ctrl.logger.Infow("enabling secrets encryption", "cluster", req.ClusterName, "key", keyArn)
+	}
+	return resp, nil
+}
@@ -0,0 +1,200 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+)
+
+const (
+	endpointDeletePollInterval = time.Second * 10
+	endpointDeleteTimeout      = time.Minute * 10
+)
+
+//interfaceEndpointServices services reached through interface endpoints, nodes pull images from ECR without NAT
+var interfaceEndpointServices = []string{"ecr.api", "ecr.dkr"}
+
+func endpointServiceName(region, service string) string {
+	return fmt.Sprintf("com.amazonaws.%s.%s", region, service)
+}
+
+//CreateEndpointSecurityGroup security group allowing https from within the vpc to interface endpoints
+func CreateEndpointSecurityGroup(client *ec2.EC2, vpc *ec2.Vpc, vpcName string, name string) (*ec2.SecurityGroup, error) {
+	sgOut, err := client.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
+		GroupName:   aws.String(name),
+		Description: aws.String("spawner network stack vpc endpoints"),
+		VpcId:       vpc.VpcId,
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeSecurityGroup),
+				Tags:         networkStackTags(name, vpcName),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating endpoint security group")
+	}
+
+	_, err = client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: sgOut.GroupId,
+		IpPermissions: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpRanges:   []*ec2.IpRange{{CidrIp: vpc.CidrBlock}},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error authorizing https ingress on security group %s", *sgOut.GroupId)
+	}
+	return &ec2.SecurityGroup{GroupId: sgOut.GroupId, GroupName: aws.String(name), VpcId: vpc.VpcId}, nil
+}
+
+//CreateVpcEndpoints S3 gateway endpoint on the route tables and ECR interface endpoints in the subnets
+func CreateVpcEndpoints(client *ec2.EC2, region string, vpc *ec2.Vpc, vpcName string, routeTables []*ec2.RouteTable, subnets []*ec2.Subnet, sg *ec2.SecurityGroup) ([]*ec2.VpcEndpoint, error) {
+	endpoints := []*ec2.VpcEndpoint{}
+
+	routeTableIds := []*string{}
+	for _, rt := range routeTables {
+		routeTableIds = append(routeTableIds, rt.RouteTableId)
+	}
+
+	s3Name := endpointServiceName(region, "s3")
+	out, err := client.CreateVpcEndpoint(&ec2.CreateVpcEndpointInput{
+		VpcId:           vpc.VpcId,
+		ServiceName:     aws.String(s3Name),
+		VpcEndpointType: aws.String(ec2.VpcEndpointTypeGateway),
+		RouteTableIds:   routeTableIds,
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeVpcEndpoint),
+				Tags:         networkStackTags(s3Name, vpcName),
+			},
+		},
+	})
+	if err != nil {
+		return endpoints, errors.Wrapf(err, "error creating vpc endpoint %s", s3Name)
+	}
+	endpoints = append(endpoints, out.VpcEndpoint)
+
+	subnetIds := []*string{}
+	for _, s := range subnets {
+		subnetIds = append(subnetIds, s.SubnetId)
+	}
+
+	for _, service := range interfaceEndpointServices {
+		name := endpointServiceName(region, service)
+		out, err := client.CreateVpcEndpoint(&ec2.CreateVpcEndpointInput{
+			VpcId:             vpc.VpcId,
+			ServiceName:       aws.String(name),
+			VpcEndpointType:   aws.String(ec2.VpcEndpointTypeInterface),
+			SubnetIds:         subnetIds,
+			SecurityGroupIds:  []*string{sg.GroupId},
+			PrivateDnsEnabled: aws.Bool(true),
+			TagSpecifications: []*ec2.TagSpecification{
+				{
+					ResourceType: aws.String(ec2.ResourceTypeVpcEndpoint),
+					Tags:         networkStackTags(name, vpcName),
+				},
+			},
+		})
+		if err != nil {
+			return endpoints, errors.Wrapf(err, "error creating vpc endpoint %s", name)
+		}
+		endpoints = append(endpoints, out.VpcEndpoint)
+	}
+	return endpoints, nil
+}
+
+func vpcEndpointFilter(vpc *ec2.Vpc) []*ec2.Filter {
+	return []*ec2.Filter{
+		{
+			Name:   aws.String("vpc-id"),
+			Values: tagValue(*vpc.VpcId),
+		},
+		{
+			Name:   aws.String("vpc-endpoint-state"),
+			Values: aws.StringSlice([]string{"pendingAcceptance", "pending", "available", "deleting", "rejected", "failed"}),
+		},
+	}
+}
+
+//GetVpcEndpoints endpoints of the vpc which are not deleted yet
+func GetVpcEndpoints(client *ec2.EC2, vpc *ec2.Vpc) ([]*ec2.VpcEndpoint, error) {
+	out, err := client.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		Filters: vpcEndpointFilter(vpc),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting vpc endpoints of vpc %s", *vpc.VpcId)
+	}
+	return out.VpcEndpoints, nil
+}
+
+//GetEndpointSecurityGroup security group of the interface endpoints, nil if stack has no endpoints
+func GetEndpointSecurityGroup(client *ec2.EC2, vpc *ec2.Vpc, name string) (*ec2.SecurityGroup, error) {
+	out, err := client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: tagValue(*vpc.VpcId),
+			},
+			{
+				Name:   aws.String("group-name"),
+				Values: tagValue(name),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting endpoint security group of vpc %s", *vpc.VpcId)
+	}
+	if len(out.SecurityGroups) == 0 {
+		return nil, nil
+	}
+	return out.SecurityGroups[0], nil
+}
+
+//DeleteVpcEndpoints delete the endpoints and wait till their network interfaces are released
+func DeleteVpcEndpoints(ctx context.Context, client *ec2.EC2, vpc *ec2.Vpc, endpoints []*ec2.VpcEndpoint) error {
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	ids := []*string{}
+	for _, e := range endpoints {
+		ids = append(ids, e.VpcEndpointId)
+	}
+
+	_, err := client.DeleteVpcEndpointsWithContext(ctx, &ec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: ids,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error deleting vpc endpoints of vpc %s", *vpc.VpcId)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, endpointDeleteTimeout)
+	defer cancel()
+
+	for {
+		out, err := client.DescribeVpcEndpointsWithContext(ctx, &ec2.DescribeVpcEndpointsInput{
+			Filters: vpcEndpointFilter(vpc),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error getting vpc endpoints of vpc %s", *vpc.VpcId)
+		}
+		if len(out.VpcEndpoints) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "timed out waiting for vpc endpoints of vpc %s to be deleted", *vpc.VpcId)
+		case <-time.After(endpointDeletePollInterval):
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const gpuPluginTimeout = time.Minute * 45
+
+//migInstanceMemory memory in GB of each GPU on MIG capable instances, A100 40GB, A100 80GB and H100 80GB
+var migInstanceMemory = map[string]int{
+	"p4d.24xlarge":  40,
+	"p4de.24xlarge": 80,
+	"p5.48xlarge":   80,
+}
+
+type migProfile struct {
+	//id GPU instance profile id used by nvidia-smi, same across A100 and H100
+	id int
+	//slices compute slices of the profile
+	slices int
+	//eighths share of the GPU memory in eighths
+	eighths int
+	//count instances of the profile which fits in a GPU
+	count int
+}
+
+var migProfiles = map[proto.MIGProfile]migProfile{
+	proto.MIGProfile_MIG1g: {id: 19, slices: 1, eighths: 1, count: 7},
+	proto.MIGProfile_MIG2g: {id: 14, slices: 2, eighths: 2, count: 3},
+	proto.MIGProfile_MIG3g: {id: 9, slices: 3, eighths: 4, count: 2},
+	proto.MIGProfile_MIG4g: {id: 5, slices: 4, eighths: 4, count: 1},
+	proto.MIGProfile_MIG7g: {id: 0, slices: 7, eighths: 8, count: 1},
+}
+
+//migMemory GPU memory of the instances, all the instances of the node group has to be MIG capable and of same GPU memory
+func migMemory(instanceTypes []*string) (int, error) {
+	mem := 0
+	for _, i := range instanceTypes {
+		m, ok := migInstanceMemory[aws.StringValue(i)]
+		if !ok {
+			return 0, fmt.Errorf("instance '%s' does not support MIG profiles", aws.StringValue(i))
+		}
+		if mem != 0 && mem != m {
+			return 0, fmt.Errorf("instances with different GPU memory cannot share MIG profile")
+		}
+		mem = m
+	}
+	return mem, nil
+}
+
+//migProfileName profile name as known to nvidia tools, i.e 1g.5gb
+func migProfileName(profile proto.MIGProfile, memory int) string {
+	p := migProfiles[profile]
+	return fmt.Sprintf("%dg.%dgb", p.slices, memory*p.eighths/8)
+}
+
+//migScript user data partitioning all GPUs on the node with the profile, runs before the node joins the cluster
+func migScript(profile proto.MIGProfile) string {
+	p, ok := migProfiles[profile]
+	if !ok {
+		return ""
+	}
+
+	ids := make([]string, 0, p.count)
+	for i := 0; i < p.count; i++ {
+		ids = append(ids, fmt.Sprint(p.id))
+	}
+
+	return fmt.Sprintf("#!/bin/bash\nset -ex\nnvidia-smi -mig 1\nnvidia-smi mig -cgi %s -C\n", strings.Join(ids, ","))
+}
+
+//migByUserData MIG partitioning is done in launch template user data unless GPU operator is managing it
+func migByUserData(spec *proto.NodeSpec) bool {
+	return spec.MigProfile != proto.MIGProfile_UNKNOWN && spec.GpuPlugin != proto.GPUPlugin_GPU_OPERATOR
+}
+
+func gpuPluginOptions(spec *proto.NodeSpec) kube.GPUPluginOptions {
+	opts := kube.GPUPluginOptions{MIGStrategy: kube.MIGStrategyNone}
+	if spec.MigProfile != proto.MIGProfile_UNKNOWN {
+		opts.MIGStrategy = kube.MIGStrategySingle
+		opts.MIGManager = spec.GpuPlugin == proto.GPUPlugin_GPU_OPERATOR
+	}
+	return opts
+}
+
+//installGPUPlugin wait for the node group to become active and install the GPU plugin into the cluster
+func (ctrl AWSController) installGPUPlugin(session *Session, cluster *eks.Cluster, nodeSpec *proto.NodeSpec) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), gpuPluginTimeout)
+	defer cancel()
+
+	clusterName := *cluster.Name
+	err := session.getEksClient().WaitUntilNodegroupActiveWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodeSpec.Name,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("nodegroup did not become active, gpu plugin not installed", "cluster", clusterName, "nodegroup", nodeSpec.Name, "error", err)
+		return
+	}
+
+	k8s, err := session.getK8sClient(cluster)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get kubernetes client, gpu plugin not installed", "cluster", clusterName, "error", err)
+		return
+	}
+
+	if err = kube.InstallGPUPlugin(ctx, k8s, nodeSpec.GpuPlugin, gpuPluginOptions(nodeSpec)); err != nil {
+		ctrl.logger.Errorw("failed to install gpu plugin", "cluster", clusterName, "plugin", nodeSpec.GpuPlugin.String(), "error", err)
+		return
+	}
+	ctrl.logger.Infow("gpu plugin installed", "cluster", clusterName, "nodegroup", nodeSpec.Name, "plugin", nodeSpec.GpuPlugin.String())
+}
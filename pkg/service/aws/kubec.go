@@ -2,7 +2,11 @@ package aws
 
 import (
 	"context"
+	"encoding/base64"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -48,17 +52,22 @@ func (ctrl AWSController) GetKubeConfig(ctx context.Context, req *proto.GetKubeC
 		AuthInfo: defaultCluster,
 	}
 
+	exec := &clientcmdapi.ExecConfig{
+		Command: "aws",
+		Args: []string{
+			"--region", region,
+			"eks", "get-token",
+			"--cluster-name", clusterName,
+		},
+		APIVersion: "client.authentication.k8s.io/v1alpha1",
+	}
+	if req.SpawnerExec {
+		exec = kube.SpawnerExecConfig(req.SpawnerAddr, req.Provider, region, req.AccountName, clusterName)
+	}
+
 	authinfos := make(map[string]*clientcmdapi.AuthInfo)
 	authinfos[defaultCluster] = &clientcmdapi.AuthInfo{
-		Exec: &clientcmdapi.ExecConfig{
-			Command: "aws",
-			Args: []string{
-				"--region", region,
-				"eks", "get-token",
-				"--cluster-name", clusterName,
-			},
-			APIVersion: "client.authentication.k8s.io/v1alpha1",
-		},
+		Exec: exec,
 	}
 
 	clientConfig := clientcmdapi.Config{
@@ -95,14 +104,20 @@ func (ctrl AWSController) GetToken(ctx context.Context, req *proto.GetTokenReque
 		return nil, err
 	}
 
-	kubeConfig, err := session.getKubeConfig(cluster)
+	tok, err := newToken(session.AwsSession, cluster)
 	if err != nil {
-		ctrl.logger.Errorw("failed to get k8s config", "error", err, "cluster", clusterName, "region", region)
+		ctrl.logger.Errorw("failed to get k8s token", "error", err, "cluster", clusterName, "region", region)
+		return nil, err
+	}
+	ca, err := base64.StdEncoding.DecodeString(aws.StringValue(cluster.CertificateAuthority.Data))
+	if err != nil {
+		ctrl.logger.Errorw("invalid cluster certificate authority", "error", err, "cluster", clusterName, "region", region)
 		return nil, err
 	}
 	return &proto.GetTokenResponse{
-		Token:    kubeConfig.BearerToken,
-		CaData:   string(kubeConfig.CAData),
-		Endpoint: kubeConfig.Host,
+		Token:               tok.Token,
+		CaData:              string(ca),
+		Endpoint:            aws.StringValue(cluster.Endpoint),
+		ExpirationTimestamp: tok.Expiration.UTC().Format(time.RFC3339),
 	}, nil
 }
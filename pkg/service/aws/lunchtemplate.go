@@ -2,118 +2,374 @@ package aws
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/davecgh/go-spew/spew"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
-func (a *AWSController) createSpotLaunchTemplate(ctx context.Context, client *ec2.EC2, amiId, price, instance, volumeType string, diskSize int64, label map[string]string) (string, error) {
-
-	//amiId = "ami-09dd25549dd970de5"
-	//fetch launch template ]
-	//	marketTyp := "spot"
-	//	spotType := "one-time" // "persistent" -- cant have persistent for autoscaling instacnes
-	//	// https://aws.amazon.com/blogs/aws/new-ec2-spot-blocks-for-defined-duration-workloads/
-	//	blockDuration := int64(360) //6hours
-	//	interruptionBehaviour := "terminate"
-
-	tags := asTags(label)
-	ltd := ec2.RequestLaunchTemplateData{
-		ImageId: &amiId,
-		//	InstanceMarketOptions: &ec2.LaunchTemplateInstanceMarketOptionsRequest{
-		//		MarketType: &marketTyp,
-		//		SpotOptions: &ec2.LaunchTemplateSpotMarketOptionsRequest{
-		//			BlockDurationMinutes:         &blockDuration,
-		//			InstanceInterruptionBehavior: &interruptionBehaviour,
-		//			MaxPrice:                     &price,
-		//			SpotInstanceType:             &spotType,
-		//		},
-		//	},
-		InstanceType: &instance,
-		BlockDeviceMappings: []*ec2.LaunchTemplateBlockDeviceMappingRequest{
-			{
-				DeviceName: aws.String("/dev/xvda"),
-				Ebs: &ec2.LaunchTemplateEbsBlockDeviceRequest{
-					DeleteOnTermination: aws.Bool(true),
-					VolumeSize:          &diskSize,
-					VolumeType:          &volumeType,
-				},
-			}},
+const (
+	//launchTemplatePrefix spawner managed launch templates are named `spawner-<cluster>-<nodegroup>`
+	launchTemplatePrefix = "spawner-"
+
+	//rootDeviceName root device of Amazon Linux 2 EKS AMI
+	rootDeviceName = "/dev/xvda"
+
+	userDataBoundary = "==SPAWNERBOUNDARY=="
+
+	launchTemplateNotFound   = "InvalidLaunchTemplateName.NotFoundException"
+	launchTemplateIdNotFound = "InvalidLaunchTemplateId.NotFound"
+
+	launchTemplateCleanupTimeout = time.Minute * 30
+
+	//firstLaunchTemplateVersion version of the template created by CreateLaunchTemplate, versions added later start from 2
+	firstLaunchTemplateVersion = "1"
+)
+
+func launchTemplateName(cluster, nodegroup string) string {
+	return fmt.Sprintf("%s%s-%s", launchTemplatePrefix, cluster, nodegroup)
+}
+
+//encodeUserData EKS merges launch template user data with its own bootstrap, which requires MIME multi-part format.
+//with custom AMI single script is passed as is, and it is responsible for bootstrapping the node.
+func encodeUserData(spec *proto.NodeSpec) (*string, error) {
+
+	scripts := []string{}
+	if migByUserData(spec) {
+		scripts = append(scripts, migScript(spec.MigProfile))
+	}
+
+	if strings.HasPrefix(spec.UserData, "MIME-Version") {
+		if len(scripts) > 0 {
+			return nil, errors.New("userData in MIME multi-part format cannot be combined with migProfile")
+		}
+		return aws.String(base64.StdEncoding.EncodeToString([]byte(spec.UserData))), nil
+	}
+
+	if spec.UserData != "" {
+		scripts = append(scripts, spec.UserData)
+	}
+
+	if len(scripts) == 0 {
+		return nil, nil
+	}
+
+	if spec.AmiId != "" && len(scripts) == 1 {
+		return aws.String(base64.StdEncoding.EncodeToString([]byte(scripts[0]))), nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "MIME-Version: 1.0\nContent-Type: multipart/mixed; boundary=\"%s\"\n\n", userDataBoundary)
+	for _, script := range scripts {
+		fmt.Fprintf(&b, "--%s\nContent-Type: text/x-shellscript; charset=\"us-ascii\"\n\n%s\n\n", userDataBoundary, script)
+	}
+	fmt.Fprintf(&b, "--%s--\n", userDataBoundary)
+	return aws.String(base64.StdEncoding.EncodeToString([]byte(b.String()))), nil
+}
+
+func ec2Tags(labels map[string]*string) []*ec2.Tag {
+	tags := make([]*ec2.Tag, 0, len(labels))
+	for k, v := range labels {
+		key := k
+		tags = append(tags, &ec2.Tag{
+			Key:   &key,
+			Value: v,
+		})
+	}
+	return tags
+}
+
+func blockDevices(spec *proto.NodeSpec, diskSize int64) []*ec2.LaunchTemplateBlockDeviceMappingRequest {
+
+	root := &ec2.LaunchTemplateEbsBlockDeviceRequest{
+		DeleteOnTermination: aws.Bool(true),
+	}
+	if diskSize != 0 {
+		root.VolumeSize = &diskSize
+	}
+	if spec.RootVolumeType != "" {
+		root.VolumeType = &spec.RootVolumeType
+	}
+	if spec.RootVolumeIops != 0 {
+		root.Iops = &spec.RootVolumeIops
+	}
+
+	devices := []*ec2.LaunchTemplateBlockDeviceMappingRequest{
+		{
+			DeviceName: aws.String(rootDeviceName),
+			Ebs:        root,
+		},
+	}
 
+	for _, v := range spec.EbsVolumes {
+		ebs := &ec2.LaunchTemplateEbsBlockDeviceRequest{
+			DeleteOnTermination: aws.Bool(true),
+			VolumeSize:          aws.Int64(v.Size),
+			Encrypted:           aws.Bool(v.Encrypted),
+		}
+		if v.VolumeType != "" {
+			ebs.VolumeType = aws.String(v.VolumeType)
+		}
+		if v.Iops != 0 {
+			ebs.Iops = aws.Int64(v.Iops)
+		}
+		if v.Throughput != 0 {
+			ebs.Throughput = aws.Int64(v.Throughput)
+		}
+		devices = append(devices, &ec2.LaunchTemplateBlockDeviceMappingRequest{
+			DeviceName: aws.String(v.DeviceName),
+			Ebs:        ebs,
+		})
+	}
+	return devices
+}
+
+func metadataOptions(spec *proto.NodeSpec) *ec2.LaunchTemplateInstanceMetadataOptionsRequest {
+	if spec.MetadataOptions == nil {
+		return nil
+	}
+
+	tokens := ec2.LaunchTemplateHttpTokensStateOptional
+	if spec.MetadataOptions.RequireTokens {
+		tokens = ec2.LaunchTemplateHttpTokensStateRequired
+	}
+
+	opts := &ec2.LaunchTemplateInstanceMetadataOptionsRequest{
+		HttpEndpoint: aws.String(ec2.LaunchTemplateInstanceMetadataEndpointStateEnabled),
+		HttpTokens:   &tokens,
+	}
+	if spec.MetadataOptions.HopLimit != 0 {
+		opts.HttpPutResponseHopLimit = &spec.MetadataOptions.HopLimit
+	}
+	return opts
+}
+
+//launchTemplateData instance type is left to the node group, so spot node groups can still use multiple instance types
+func launchTemplateData(spec *proto.NodeSpec, diskSize int64, securityGroups []*string, labels map[string]*string) (*ec2.RequestLaunchTemplateData, error) {
+
+	userData, err := encodeUserData(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := ec2Tags(labels)
+	ltd := &ec2.RequestLaunchTemplateData{
+		BlockDeviceMappings: blockDevices(spec, diskSize),
+		MetadataOptions:     metadataOptions(spec),
+		UserData:            userData,
 		TagSpecifications: []*ec2.LaunchTemplateTagSpecificationRequest{
 			{
-				ResourceType: aws.String("instance"),
-				Tags:         tags,
-			},
-			{
-				ResourceType: aws.String("volume"),
+				ResourceType: aws.String(ec2.ResourceTypeInstance),
 				Tags:         tags,
 			},
 			{
-				ResourceType: aws.String("elastic-gpu"),
+				ResourceType: aws.String(ec2.ResourceTypeVolume),
 				Tags:         tags,
 			},
-			//			{
-			//				ResourceType: aws.String("spot-instance-request"),
-			//				Tags:         tags,
-			//			},
 			{
-				ResourceType: aws.String("network-interface"),
+				ResourceType: aws.String(ec2.ResourceTypeNetworkInterface),
 				Tags:         tags,
 			},
 		},
 	}
 
-	s := time.Now().Second()
-	name := fmt.Sprintf("spt-lt-%d", s)
-	fmt.Println("creating tenplate  ", name)
-	res, err := client.CreateLaunchTemplateWithContext(ctx, &ec2.CreateLaunchTemplateInput{
+	if spec.AmiId != "" {
+		ltd.ImageId = &spec.AmiId
+	}
+	if len(securityGroups) > 0 {
+		ltd.SecurityGroupIds = securityGroups
+	}
+	return ltd, nil
+}
 
-		LaunchTemplateData: &ltd,
-		LaunchTemplateName: aws.String(name),
+//nodeSecurityGroups security groups set in launch template replaces the EKS defaults, so cluster security group is added
+//to let the nodes talk to control plane.
+func nodeSecurityGroups(ctx context.Context, session *Session, clusterName string, spec *proto.NodeSpec) ([]*string, error) {
+	if len(spec.SecurityGroupIds) == 0 {
+		return nil, nil
+	}
+
+	cluster, err := getClusterSpec(ctx, session.getEksClient(), clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := aws.StringSlice(spec.SecurityGroupIds)
+	if cluster.ResourcesVpcConfig != nil && cluster.ResourcesVpcConfig.ClusterSecurityGroupId != nil {
+		groups = append(groups, cluster.ResourcesVpcConfig.ClusterSecurityGroupId)
+	}
+	return groups, nil
+}
+
+//createLaunchTemplate create launch template for the node group, if the template exists already new version is added
+//and used for the node group.
+func (a *AWSController) createLaunchTemplate(ctx context.Context, session *Session, clusterName string, spec *proto.NodeSpec, diskSize int64, labels map[string]*string) (*eks.LaunchTemplateSpecification, error) {
+
+	client := session.getEC2Client()
+	name := launchTemplateName(clusterName, spec.Name)
+
+	securityGroups, err := nodeSecurityGroups(ctx, session, clusterName, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "createLaunchTemplate: failed to get cluster security group")
+	}
+
+	ltd, err := launchTemplateData(spec, diskSize, securityGroups, labels)
+	if err != nil {
+		return nil, errors.Wrap(err, "createLaunchTemplate")
+	}
+	description := fmt.Sprintf("nodegroup %s of cluster %s", spec.Name, clusterName)
+
+	existing, err := client.DescribeLaunchTemplatesWithContext(ctx, &ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateNames: []*string{&name},
+	})
+
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != launchTemplateNotFound {
+			return nil, errors.Wrap(err, "createLaunchTemplate")
+		}
+	}
+
+	if existing != nil && len(existing.LaunchTemplates) > 0 {
+		lt := existing.LaunchTemplates[0]
+		res, err := client.CreateLaunchTemplateVersionWithContext(ctx, &ec2.CreateLaunchTemplateVersionInput{
+			LaunchTemplateId:   lt.LaunchTemplateId,
+			LaunchTemplateData: ltd,
+			VersionDescription: &description,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "createLaunchTemplate: failed to create new version")
+		}
+
+		version := strconv.FormatInt(*res.LaunchTemplateVersion.VersionNumber, 10)
+		a.logger.Infow("created new launch template version", "name", name, "version", version)
+		return &eks.LaunchTemplateSpecification{
+			Id:      lt.LaunchTemplateId,
+			Version: &version,
+		}, nil
+	}
+
+	res, err := client.CreateLaunchTemplateWithContext(ctx, &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: &name,
+		LaunchTemplateData: ltd,
+		VersionDescription: &description,
 		TagSpecifications: []*ec2.TagSpecification{
 			{
-				ResourceType: aws.String("launch-template"),
-				Tags:         tags,
+				ResourceType: aws.String(ec2.ResourceTypeLaunchTemplate),
+				Tags:         ec2Tags(labels),
 			},
-			//			{
-			//				ResourceType: aws.String("volume"),
-			//				Tags:         tags,
-			//			},
-			//			{
-			//				ResourceType: aws.String("elastic-gpu"),
-			//				Tags:         tags,
-			//			},
-			//			{
-			//				ResourceType: aws.String("spot-instance-request"),
-			//				Tags:         tags,
-			//			},
-			//			{
-			//				ResourceType: aws.String("network-interface"),
-			//				Tags:         tags,
-			//			},
 		},
 	})
 
 	if err != nil {
-		return "", errors.Wrap(err, "createSpotLaunchTemplate")
+		return nil, errors.Wrap(err, "createLaunchTemplate")
+	}
+
+	version := strconv.FormatInt(*res.LaunchTemplate.LatestVersionNumber, 10)
+	a.logger.Infow("created launch template", "name", name, "id", *res.LaunchTemplate.LaunchTemplateId, "version", version)
+	return &eks.LaunchTemplateSpecification{
+		Id:      res.LaunchTemplate.LaunchTemplateId,
+		Version: &version,
+	}, nil
+}
+
+//deleteLaunchTemplate delete the launch template, templates not created by spawner are left untouched. Template is
+//kept while any node group of the cluster uses it, node group recreated with the same name reuses the template
+func (a *AWSController) deleteLaunchTemplate(ctx context.Context, session *Session, cluster string, lt *eks.LaunchTemplateSpecification) error {
+	if lt == nil || lt.Id == nil {
+		return nil
 	}
 
-	l, err := client.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
-		LaunchTemplateId: res.LaunchTemplate.LaunchTemplateId,
-		MaxVersion:       aws.String("3"),
-		MinVersion:       aws.String("0"),
+	client := session.getEC2Client()
+	out, err := client.DescribeLaunchTemplatesWithContext(ctx, &ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{lt.Id},
 	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == launchTemplateIdNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "deleteLaunchTemplate: failed to get launch template '%s'", *lt.Id)
+	}
+	if len(out.LaunchTemplates) == 0 {
+		return nil
+	}
+	name := aws.StringValue(out.LaunchTemplates[0].LaunchTemplateName)
+	if !strings.HasPrefix(name, launchTemplatePrefix) {
+		a.logger.Warnw("launch template is not created by spawner, not deleting it", "id", *lt.Id, "name", name)
+		return nil
+	}
+
+	nodegroups, err := describeNodegroups(ctx, session.getEksClient(), cluster)
+	if err != nil {
+		return errors.Wrapf(err, "deleteLaunchTemplate: failed to list nodegroups of cluster '%s'", cluster)
+	}
+	for _, ng := range nodegroups {
+		if ng.LaunchTemplate != nil && aws.StringValue(ng.LaunchTemplate.Id) == *lt.Id {
+			a.logger.Infow("launch template is used by another nodegroup, not deleting it", "id", *lt.Id, "name", name, "nodegroup", aws.StringValue(ng.NodegroupName))
+			return nil
+		}
+	}
+
+	_, err = client.DeleteLaunchTemplateWithContext(ctx, &ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: lt.Id,
+	})
+	if err != nil {
+		return errors.Wrap(err, "deleteLaunchTemplate")
+	}
+	a.logger.Infow("deleted launch template", "id", *lt.Id)
+	return nil
+}
 
+//rollbackLaunchTemplate undo createLaunchTemplate when the node group could not be created. Template created by the call
+//is deleted, version added to an existing template is deleted leaving the template as is.
+func (a *AWSController) rollbackLaunchTemplate(ctx context.Context, session *Session, cluster string, lt *eks.LaunchTemplateSpecification) error {
+	if lt == nil || lt.Id == nil || lt.Version == nil {
+		return nil
+	}
+
+	if *lt.Version == firstLaunchTemplateVersion {
+		return a.deleteLaunchTemplate(ctx, session, cluster, lt)
+	}
+
+	out, err := session.getEC2Client().DeleteLaunchTemplateVersionsWithContext(ctx, &ec2.DeleteLaunchTemplateVersionsInput{
+		LaunchTemplateId: lt.Id,
+		Versions:         []*string{lt.Version},
+	})
+	if err != nil {
+		return errors.Wrap(err, "rollbackLaunchTemplate")
+	}
+	for _, v := range out.UnsuccessfullyDeletedLaunchTemplateVersions {
+		if v.ResponseError != nil {
+			return fmt.Errorf("failed to delete version %s of launch template '%s': %s", *lt.Version, *lt.Id, aws.StringValue(v.ResponseError.Message))
+		}
+	}
+	a.logger.Infow("deleted launch template version", "id", *lt.Id, "version", *lt.Version)
+	return nil
+}
+
+//cleanupLaunchTemplate launch template is in use until the node group is gone, wait for the deletion and remove it
+func (a *AWSController) cleanupLaunchTemplate(session *Session, cluster, nodegroup string, lt *eks.LaunchTemplateSpecification) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), launchTemplateCleanupTimeout)
+	defer cancel()
+
+	err := session.getEksClient().WaitUntilNodegroupDeletedWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   &cluster,
+		NodegroupName: &nodegroup,
+	})
 	if err != nil {
-		a.logger.Errorw("failed to get the launch template version", "error", err)
-	} else {
-		spew.Dump(l.LaunchTemplateVersions)
+		a.logger.Errorw("failed waiting for nodegroup deletion, launch template not deleted", "cluster", cluster, "nodegroup", nodegroup, "error", err)
+		return
 	}
 
-	return *res.LaunchTemplate.LaunchTemplateId, nil
+	if err = a.deleteLaunchTemplate(ctx, session, cluster, lt); err != nil {
+		a.logger.Errorw("failed to delete launch template", "cluster", cluster, "nodegroup", nodegroup, "error", err)
+	}
 }
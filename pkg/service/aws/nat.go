@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+)
+
+const (
+	natDeletePollInterval = time.Second * 15
+	natDeleteTimeout      = time.Minute * 10
+)
+
+//networkStackTags tags of the region network stack resources, vpc tag is used to look them up
+func networkStackTags(name, vpcName string) []*ec2.Tag {
+	return []*ec2.Tag{
+		{
+			Key:   aws.String(constants.NameLabel),
+			Value: aws.String(name),
+		},
+		{
+			Key:   aws.String(constants.VpcTagKey),
+			Value: aws.String(vpcName),
+		},
+		{
+			Key:   aws.String(constants.CreatorLabel),
+			Value: aws.String(constants.SpawnerServiceLabel),
+		},
+		{
+			Key:   aws.String(constants.ProvisionerLabel),
+			Value: aws.String(constants.SpawnerServiceLabel),
+		},
+		{
+			Key:   aws.String(constants.Scope),
+			Value: aws.String(labels.ScopeTag()),
+		},
+	}
+}
+
+//CreateNatGateway allocate elastic ip and create NAT gateway in the public subnet, waits till gateway is available
+func CreateNatGateway(client *ec2.EC2, vpcName string, name string, subnet *ec2.Subnet) (*ec2.NatGateway, *ec2.Address, error) {
+	addrOut, err := client.AllocateAddress(&ec2.AllocateAddressInput{
+		Domain: aws.String(ec2.DomainTypeVpc),
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeElasticIp),
+				Tags:         networkStackTags(name, vpcName),
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error allocating elastic ip for nat gateway")
+	}
+	address := &ec2.Address{AllocationId: addrOut.AllocationId, PublicIp: addrOut.PublicIp}
+
+	natOut, err := client.CreateNatGateway(&ec2.CreateNatGatewayInput{
+		AllocationId: addrOut.AllocationId,
+		SubnetId:     subnet.SubnetId,
+		TagSpecifications: []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeNatgateway),
+				Tags:         networkStackTags(name, vpcName),
+			},
+		},
+	})
+	if err != nil {
+		return nil, address, errors.Wrapf(err, "error creating nat gateway in subnet %s", *subnet.SubnetId)
+	}
+
+	waitErr := client.WaitUntilNatGatewayAvailable(&ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []*string{natOut.NatGateway.NatGatewayId},
+	})
+	return natOut.NatGateway, address, waitErr
+}
+
+func CreateNatRoute(client *ec2.EC2, routeTable *ec2.RouteTable, nat *ec2.NatGateway) error {
+	_, err := client.CreateRoute(&ec2.CreateRouteInput{
+		RouteTableId:         routeTable.RouteTableId,
+		DestinationCidrBlock: aws.String("0.0.0.0/0"),
+		NatGatewayId:         nat.NatGatewayId,
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "error creating aws nat route")
+	}
+	return nil
+}
+
+//GetNatGateways NAT gateways of the vpc which are not deleted yet
+func GetNatGateways(client *ec2.EC2, vpc *ec2.Vpc) ([]*ec2.NatGateway, error) {
+	out, err := client.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: tagValue(*vpc.VpcId),
+			},
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable, ec2.NatGatewayStateFailed}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting nat gateways of vpc %s", *vpc.VpcId)
+	}
+	return out.NatGateways, nil
+}
+
+//GetNatAddresses elastic ips allocated for the NAT gateways of the stack
+func GetNatAddresses(client *ec2.EC2, vpcName string) ([]*ec2.Address, error) {
+	out, err := client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   tagName(constants.VpcTagKey),
+				Values: tagValue(vpcName),
+			},
+			{
+				Name:   tagName(constants.Scope),
+				Values: tagValue(labels.ScopeTag()),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting elastic ips of vpc %s", vpcName)
+	}
+	return out.Addresses, nil
+}
+
+//DeleteNatGateways delete the NAT gateways and release their elastic ips
+func DeleteNatGateways(ctx context.Context, client *ec2.EC2, nats []*ec2.NatGateway, addresses []*ec2.Address) error {
+	ids := []*string{}
+	for _, n := range nats {
+		_, err := client.DeleteNatGatewayWithContext(ctx, &ec2.DeleteNatGatewayInput{
+			NatGatewayId: n.NatGatewayId,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error deleting nat gateway %s", *n.NatGatewayId)
+		}
+		ids = append(ids, n.NatGatewayId)
+	}
+
+	if err := waitNatGatewaysDeleted(ctx, client, ids); err != nil {
+		return err
+	}
+
+	for _, a := range addresses {
+		_, err := client.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{
+			AllocationId: a.AllocationId,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error releasing elastic ip %s", aws.StringValue(a.AllocationId))
+		}
+	}
+	return nil
+}
+
+//waitNatGatewaysDeleted elastic ips can be released only after NAT gateways are deleted
+func waitNatGatewaysDeleted(ctx context.Context, client *ec2.EC2, ids []*string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, natDeleteTimeout)
+	defer cancel()
+
+	for {
+		out, err := client.DescribeNatGatewaysWithContext(ctx, &ec2.DescribeNatGatewaysInput{
+			NatGatewayIds: ids,
+		})
+		if err != nil {
+			return errors.Wrap(err, "error getting nat gateways")
+		}
+
+		deleted := true
+		for _, n := range out.NatGateways {
+			if aws.StringValue(n.State) != ec2.NatGatewayStateDeleted {
+				deleted = false
+				break
+			}
+		}
+		if deleted {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "timed out waiting for nat gateways to be deleted")
+		case <-time.After(natDeletePollInterval):
+		}
+	}
+}
+
+func natGatewayName(region string, index int) string {
+	return fmt.Sprintf(natGatewayNameFmt, region, fmt.Sprint(index))
+}
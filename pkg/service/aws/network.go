@@ -1,7 +1,10 @@
 package aws
 
 import (
+	"context"
+	"encoding/binary"
 	"fmt"
+	"net"
 	"sort"
 	"strconv"
 
@@ -10,34 +13,111 @@ import (
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
 type AwsWkspRegionNetworkStack struct {
 	Vpc         *ec2.Vpc
 	Gateway     *ec2.InternetGateway
 	RouteTables []*ec2.RouteTable
-	Subnets     []*ec2.Subnet
+	//Subnets all the subnets of the stack, public and private
+	Subnets        []*ec2.Subnet
+	PublicSubnets  []*ec2.Subnet
+	PrivateSubnets []*ec2.Subnet
+	NatGateways    []*ec2.NatGateway
+	//Addresses elastic ips of the NAT gateways
+	Addresses             []*ec2.Address
+	Endpoints             []*ec2.VpcEndpoint
+	EndpointSecurityGroup *ec2.SecurityGroup
 }
 
 const (
-	vpcCidr           = "192.168.0.0/16"
-	vpcNameFmt        = "nb-wkps-vpc-%s"
-	gatewayNameFmt    = "nb-wksp-internet-gateway-%s"
-	routeTableNameFmt = "nb-wkps-route-table-%s"
-	routeNameFmt      = "nb-wkps-route-%s"
-	subnetNameFmt     = "nb-wksp-subnet-%s-%s"
-	subnet01Cidr      = "192.168.64.0/18"
-	subnet02Cidr      = "192.168.128.0/18"
-	subnet03Cidr      = "192.168.192.0/18"
+	vpcCidr                  = "192.168.0.0/16"
+	vpcNameFmt               = "nb-wkps-vpc-%s"
+	gatewayNameFmt           = "nb-wksp-internet-gateway-%s"
+	routeTableNameFmt        = "nb-wkps-route-table-%s"
+	routeNameFmt             = "nb-wkps-route-%s"
+	subnetNameFmt            = "nb-wksp-subnet-%s-%s"
+	privateSubnetNameFmt     = "nb-wksp-private-subnet-%s-%s"
+	privateRouteTableNameFmt = "nb-wkps-private-route-table-%s-%s"
+	natGatewayNameFmt        = "nb-wksp-nat-gateway-%s-%s"
+	endpointSgNameFmt        = "nb-wksp-endpoints-%s"
+
+	//defaultStackZones instances are not available in every zone of some regions like us-west-2d, stay with first 3
+	defaultStackZones = 3
+	//minSubnetPrefix smallest subnet carved out of the vpc cidr
+	minSubnetPrefix = 28
+
+	subnetTierPublic  = "public"
+	subnetTierPrivate = "private"
 )
 
-var (
-	// subnetUpto4Cidr = [4]string{"192.168.0.0/18", "192.168.64.0/18", "192.168.128.0/18", "192.168.192.0/18"}
-	// subnetUpto8Cidr = [8]string{"192.168.0.0/19", "192.168.32.0/19", "192.168.64.0/19", "192.168.96.0/19", "192.168.128.0/19", "192.168.160.0/19", "192.168.192.0/19", "192.168.224.0/19"}
-	// Using same subnets because running into isses with instances not being available in 4th AZ like us-west-2d
-	subnetUpto4Cidr = [4]string{"192.168.0.0/18", "192.168.64.0/18", "192.168.128.0/18"}
-	subnetUpto8Cidr = [8]string{"192.168.0.0/18", "192.168.64.0/18", "192.168.128.0/18"}
-)
+//stackOptions network stack options with defaults applied
+func stackOptions(opts *proto.NetworkStackOptions) *proto.NetworkStackOptions {
+	o := &proto.NetworkStackOptions{
+		VpcCidr:    vpcCidr,
+		Zones:      defaultStackZones,
+		NatGateway: proto.NatGateway_NAT_SINGLE,
+	}
+	if opts == nil {
+		return o
+	}
+
+	if opts.VpcCidr != "" {
+		o.VpcCidr = opts.VpcCidr
+	}
+	if opts.Zones > 0 {
+		o.Zones = opts.Zones
+	}
+	o.PrivateSubnets = opts.PrivateSubnets
+	o.NatGateway = opts.NatGateway
+	o.VpcEndpoints = opts.VpcEndpoints
+	return o
+}
+
+//subnetCidrs split the IPv4 vpc cidr evenly into count subnets
+func subnetCidrs(cidr string, count int) ([]string, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid vpc cidr %s", cidr)
+	}
+
+	ip := network.IP.To4()
+	if ip == nil {
+		return nil, fmt.Errorf("vpc cidr %s must be IPv4", cidr)
+	}
+
+	ones, bits := network.Mask.Size()
+	newBits := 0
+	for 1<<newBits < count {
+		newBits++
+	}
+
+	prefix := ones + newBits
+	if prefix > minSubnetPrefix {
+		return nil, fmt.Errorf("vpc cidr %s is too small for %d subnets", cidr, count)
+	}
+
+	base := binary.BigEndian.Uint32(ip)
+	size := uint32(1) << (bits - prefix)
+	cidrs := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		subnet := make(net.IP, 4)
+		binary.BigEndian.PutUint32(subnet, base+uint32(i)*size)
+		cidrs = append(cidrs, fmt.Sprintf("%s/%d", subnet, prefix))
+	}
+	return cidrs, nil
+}
+
+//subnetTier subnets created before tiers were introduced are all public
+func subnetTier(subnet *ec2.Subnet) string {
+	for _, t := range subnet.Tags {
+		if aws.StringValue(t.Key) == constants.SubnetTierTagKey {
+			return aws.StringValue(t.Value)
+		}
+	}
+	return subnetTierPublic
+}
 
 func tagName(name string) *string {
 	return aws.String(fmt.Sprintf("tag:%s", name))
@@ -152,14 +232,65 @@ func GetRegionWkspNetworkStack(session *Session) (*AwsWkspRegionNetworkStack, er
 		return rv, fmt.Errorf("subnets not associated with vpc %s", *vpc.VpcId)
 	}
 
+	for _, subn := range rv.Subnets {
+		if subnetTier(subn) == subnetTierPrivate {
+			rv.PrivateSubnets = append(rv.PrivateSubnets, subn)
+		} else {
+			rv.PublicSubnets = append(rv.PublicSubnets, subn)
+		}
+	}
+
+	rv.NatGateways, err = GetNatGateways(sess, vpc)
+	if err != nil {
+		return rv, err
+	}
+
+	rv.Addresses, err = GetNatAddresses(sess, vpcName)
+	if err != nil {
+		return rv, err
+	}
+
+	rv.Endpoints, err = GetVpcEndpoints(sess, vpc)
+	if err != nil {
+		return rv, err
+	}
+
+	rv.EndpointSecurityGroup, err = GetEndpointSecurityGroup(sess, vpc, fmt.Sprintf(endpointSgNameFmt, region))
+	if err != nil {
+		return rv, err
+	}
+
 	return rv, nil
 }
 
-func DeleteRegionWkspNetworkStack(session *Session, netStk AwsWkspRegionNetworkStack) error {
+func DeleteRegionWkspNetworkStack(ctx context.Context, session *Session, netStk AwsWkspRegionNetworkStack) error {
 
 	client := session.getEC2Client()
 	region := session.Region
 	var err error
+
+	//endpoint network interfaces and NAT gateways hold on to the subnets, remove them first
+	if netStk.Vpc != nil {
+		err = DeleteVpcEndpoints(ctx, client, netStk.Vpc, netStk.Endpoints)
+		if err != nil {
+			return errors.Wrapf(err, "error deleting vpc endpoints in region %s", region)
+		}
+	}
+
+	if netStk.EndpointSecurityGroup != nil {
+		_, err = client.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+			GroupId: netStk.EndpointSecurityGroup.GroupId,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error deleting security group %s in region %s", *netStk.EndpointSecurityGroup.GroupId, region)
+		}
+	}
+
+	err = DeleteNatGateways(ctx, client, netStk.NatGateways, netStk.Addresses)
+	if err != nil {
+		return errors.Wrapf(err, "error deleting nat gateways in region %s", region)
+	}
+
 	for _, subn := range netStk.Subnets {
 		_, err := client.DeleteSubnet(&ec2.DeleteSubnetInput{
 			SubnetId: subn.SubnetId,
@@ -178,6 +309,19 @@ func DeleteRegionWkspNetworkStack(session *Session, netStk AwsWkspRegionNetworkS
 	})
 	for _, routeTbl := range netStk.RouteTables {
 		if routeTbl.Associations == nil || len(routeTbl.Associations) == 0 || !*routeTbl.Associations[0].Main {
+			//associations of subnets which are not part of the stack block the deletion
+			for _, assn := range routeTbl.Associations {
+				if aws.BoolValue(assn.Main) || assn.SubnetId == nil {
+					continue
+				}
+				_, err = client.DisassociateRouteTable(&ec2.DisassociateRouteTableInput{
+					AssociationId: assn.RouteTableAssociationId,
+				})
+				if err != nil {
+					return errors.Wrapf(err, "error disassociating route table %s in vpc %s in region %s", *routeTbl.RouteTableId, *netStk.Vpc.VpcId, region)
+				}
+			}
+
 			_, err = client.DeleteRouteTable(&ec2.DeleteRouteTableInput{
 				RouteTableId: routeTbl.RouteTableId,
 			})
@@ -216,9 +360,11 @@ func DeleteRegionWkspNetworkStack(session *Session, netStk AwsWkspRegionNetworkS
 	return nil
 }
 
-func CreateRegionWkspNetworkStack(session *Session) (*AwsWkspRegionNetworkStack, error) {
+//CreateRegionWkspNetworkStack create network stack of the region, public subnet per zone and optionally private subnets behind NAT gateways
+func CreateRegionWkspNetworkStack(ctx context.Context, session *Session, options *proto.NetworkStackOptions) (*AwsWkspRegionNetworkStack, error) {
 	region := session.Region
 	client := session.getEC2Client()
+	opts := stackOptions(options)
 
 	vpcName := fmt.Sprintf(vpcNameFmt, region)
 	gatewayName := fmt.Sprintf(gatewayNameFmt, region)
@@ -233,18 +379,53 @@ func CreateRegionWkspNetworkStack(session *Session) (*AwsWkspRegionNetworkStack,
 				Name:   aws.String("region-name"),
 				Values: []*string{aws.String(region)},
 			},
+			{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String(ec2.AvailabilityZoneStateAvailable)},
+			},
 		},
 	})
 	if err != nil {
 		return rv, errors.Wrapf(err, "error getting azs for region %s", region)
 	}
 
-	vpc, err := CreateVPC(client, vpcName, vpcCidr)
+	azs := make([]string, 0)
+	for _, az := range azsInRegion.AvailabilityZones {
+		azs = append(azs, *az.ZoneName)
+	}
+	// Sort AZ by names
+	sort.Strings(azs)
+
+	if int(opts.Zones) < len(azs) {
+		azs = azs[:opts.Zones]
+	}
+	if len(azs) < 2 {
+		return rv, fmt.Errorf("network stack needs at least 2 availability zones, region %s has %d", region, len(azs))
+	}
+
+	subnetCount := len(azs)
+	if opts.PrivateSubnets {
+		subnetCount *= 2
+	}
+	subnetCidrArr, err := subnetCidrs(opts.VpcCidr, subnetCount)
+	if err != nil {
+		return rv, err
+	}
+
+	vpc, err := CreateVPC(client, vpcName, opts.VpcCidr)
 	if err != nil {
 		return rv, errors.Wrapf(err, "error creating vpc for region %s", region)
 	}
 	rv.Vpc = vpc
 
+	//private nodes and endpoint private dns resolve through vpc dns
+	if opts.PrivateSubnets || opts.VpcEndpoints {
+		err = enableVpcDNS(ctx, client, *vpc.VpcId)
+		if err != nil {
+			return rv, err
+		}
+	}
+
 	gateway, err := CreateInternetGateway(client, gatewayName)
 	if err != nil {
 		return rv, errors.Wrapf(err, "error creating gateway for region %s", region)
@@ -267,38 +448,93 @@ func CreateRegionWkspNetworkStack(session *Session) (*AwsWkspRegionNetworkStack,
 		return rv, errors.Wrapf(err, "error creating route for region %s route table %s gateway %s", region, *routeTable.RouteTableId, *gateway.InternetGatewayId)
 	}
 
-	var subnetCidrArr []string
-	if len(azsInRegion.AvailabilityZones) <= 4 {
-		subnetCidrArr = subnetUpto4Cidr[:]
-	} else {
-		subnetCidrArr = subnetUpto8Cidr[:]
-	}
-
-	azs := make([]string, 0)
-	for _, az := range azsInRegion.AvailabilityZones {
-		azs = append(azs, *az.ZoneName)
-	}
-	// Sort AZ by names
-	sort.Strings(azs)
-
 	rv.Subnets = []*ec2.Subnet{}
 	for ind, avblZone := range azs {
-		// Only considering first 3 AZs per region
-		if ind > 2 {
-			break
-		}
 		subnetName := fmt.Sprintf(subnetNameFmt, region, strconv.Itoa(ind))
 		subnetAz := avblZone
-		subnet, err := CreateSubnetStack(client, vpc, vpcName, subnetName, subnetCidrArr[ind], subnetAz, routeTable)
+		subnet, err := CreateSubnetStack(client, vpc, vpcName, subnetName, subnetCidrArr[ind], subnetAz, routeTable, subnetTierPublic)
 		if err != nil {
 			return rv, errors.Wrapf(err, "error creating subnet %s for region %s vpc %s az %s", subnetName, region, *vpc.VpcId, subnetAz)
 		}
 		rv.Subnets = append(rv.Subnets, subnet)
+		rv.PublicSubnets = append(rv.PublicSubnets, subnet)
+	}
+
+	//endpoints serve the subnets where the nodes run
+	endpointRouteTables := rv.RouteTables
+	endpointSubnets := rv.PublicSubnets
+
+	if opts.PrivateSubnets {
+		privateRouteTables, err := createPrivateTier(client, rv, region, vpcName, azs, subnetCidrArr[len(azs):], opts.NatGateway)
+		if err != nil {
+			return rv, err
+		}
+		endpointRouteTables = privateRouteTables
+		endpointSubnets = rv.PrivateSubnets
+	}
+
+	if opts.VpcEndpoints {
+		sg, err := CreateEndpointSecurityGroup(client, vpc, vpcName, fmt.Sprintf(endpointSgNameFmt, region))
+		if err != nil {
+			return rv, errors.Wrapf(err, "error creating endpoint security group for region %s vpc %s", region, *vpc.VpcId)
+		}
+		rv.EndpointSecurityGroup = sg
+
+		rv.Endpoints, err = CreateVpcEndpoints(client, region, vpc, vpcName, endpointRouteTables, endpointSubnets, sg)
+		if err != nil {
+			return rv, errors.Wrapf(err, "error creating vpc endpoints for region %s vpc %s", region, *vpc.VpcId)
+		}
 	}
 
 	return rv, nil
 }
 
+//createPrivateTier NAT gateways in public subnets and a private subnet per zone routed through them
+func createPrivateTier(client *ec2.EC2, rv *AwsWkspRegionNetworkStack, region, vpcName string, azs []string, cidrs []string, mode proto.NatGateway) ([]*ec2.RouteTable, error) {
+	natCount := 1
+	if mode == proto.NatGateway_NAT_PER_ZONE {
+		natCount = len(azs)
+	}
+
+	routeTables := []*ec2.RouteTable{}
+	for ind := 0; ind < natCount; ind++ {
+		nat, address, err := CreateNatGateway(client, vpcName, natGatewayName(region, ind), rv.PublicSubnets[ind])
+		if address != nil {
+			rv.Addresses = append(rv.Addresses, address)
+		}
+		if nat != nil {
+			rv.NatGateways = append(rv.NatGateways, nat)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating nat gateway for region %s az %s", region, azs[ind])
+		}
+
+		routeTable, err := CreateRouteTable(client, rv.Vpc, fmt.Sprintf(privateRouteTableNameFmt, region, strconv.Itoa(ind)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating private route table for region %s vpc %s", region, *rv.Vpc.VpcId)
+		}
+		rv.RouteTables = append(rv.RouteTables, routeTable)
+		routeTables = append(routeTables, routeTable)
+
+		err = CreateNatRoute(client, routeTable, nat)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating nat route for region %s route table %s", region, *routeTable.RouteTableId)
+		}
+	}
+
+	for ind, avblZone := range azs {
+		subnetName := fmt.Sprintf(privateSubnetNameFmt, region, strconv.Itoa(ind))
+		routeTable := routeTables[ind%natCount]
+		subnet, err := CreateSubnetStack(client, rv.Vpc, vpcName, subnetName, cidrs[ind], avblZone, routeTable, subnetTierPrivate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating private subnet %s for region %s vpc %s az %s", subnetName, region, *rv.Vpc.VpcId, avblZone)
+		}
+		rv.Subnets = append(rv.Subnets, subnet)
+		rv.PrivateSubnets = append(rv.PrivateSubnets, subnet)
+	}
+	return routeTables, nil
+}
+
 func CreateVPC(client *ec2.EC2, name string, vpcCidr string) (*ec2.Vpc, error) {
 	vpcOut, err := client.CreateVpc(&ec2.CreateVpcInput{
 		CidrBlock: aws.String(vpcCidr),
@@ -438,7 +674,13 @@ func CreateRoute(client *ec2.EC2, routeTable *ec2.RouteTable, intGateway *ec2.In
 	return routeOut.Return, nil
 }
 
-func CreateSubnet(client *ec2.EC2, vpc *ec2.Vpc, vpcName string, name string, cidrBlock string, avblZone string) (*ec2.Subnet, error) {
+func CreateSubnet(client *ec2.EC2, vpc *ec2.Vpc, vpcName string, name string, cidrBlock string, avblZone string, tier string) (*ec2.Subnet, error) {
+	//load balancer role lets kubernetes place internet facing load balancers in public and internal ones in private subnets
+	roleTag := elbRoleTag
+	if tier == subnetTierPrivate {
+		roleTag = internalElbRoleTag
+	}
+
 	subnetOut, err := client.CreateSubnet(&ec2.CreateSubnetInput{
 		AvailabilityZone: &avblZone,
 		CidrBlock:        &cidrBlock,
@@ -455,6 +697,14 @@ func CreateSubnet(client *ec2.EC2, vpc *ec2.Vpc, vpcName string, name string, ci
 						Key:   aws.String(constants.VpcTagKey),
 						Value: aws.String(vpcName),
 					},
+					{
+						Key:   aws.String(constants.SubnetTierTagKey),
+						Value: aws.String(tier),
+					},
+					{
+						Key:   aws.String(roleTag),
+						Value: aws.String("1"),
+					},
 					{
 						Key:   aws.String(constants.CreatorLabel),
 						Value: aws.String(constants.SpawnerServiceLabel),
@@ -509,14 +759,17 @@ func CreateSubnetRouteTblAssn(client *ec2.EC2, routeTable *ec2.RouteTable, subne
 	return assnOut.AssociationId, nil
 }
 
-func CreateSubnetStack(client *ec2.EC2, vpc *ec2.Vpc, vpcName string, name string, cidrBlock string, avblZone string, routeTable *ec2.RouteTable) (*ec2.Subnet, error) {
-	subnet, err := CreateSubnet(client, vpc, vpcName, name, cidrBlock, avblZone)
+func CreateSubnetStack(client *ec2.EC2, vpc *ec2.Vpc, vpcName string, name string, cidrBlock string, avblZone string, routeTable *ec2.RouteTable, tier string) (*ec2.Subnet, error) {
+	subnet, err := CreateSubnet(client, vpc, vpcName, name, cidrBlock, avblZone, tier)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error creating subnet %s for vpc %s az %s", name, *vpc.VpcId, avblZone)
 	}
-	err = ModifySubnetMapPublicIp(client, subnet)
-	if err != nil {
-		return nil, errors.Wrapf(err, "error modifying subnet %s for vpc %s az %s", *subnet.SubnetId, *vpc.VpcId, avblZone)
+
+	if tier == subnetTierPublic {
+		err = ModifySubnetMapPublicIp(client, subnet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error modifying subnet %s for vpc %s az %s", *subnet.SubnetId, *vpc.VpcId, avblZone)
+		}
 	}
 
 	_, err = CreateSubnetRouteTblAssn(client, routeTable, subnet)
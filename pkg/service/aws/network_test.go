@@ -0,0 +1,24 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_subnetCidrs(t *testing.T) {
+
+	got, err := subnetCidrs("192.168.0.0/16", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.168.0.0/18", "192.168.64.0/18", "192.168.128.0/18"}, got, "public subnets of default stack")
+
+	got, err = subnetCidrs("10.0.0.0/16", 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/19", "10.0.32.0/19", "10.0.64.0/19", "10.0.96.0/19", "10.0.128.0/19", "10.0.160.0/19"}, got, "public and private subnets")
+
+	_, err = subnetCidrs("10.0.0.0/26", 6)
+	assert.Error(t, err, "vpc cidr too small")
+
+	_, err = subnetCidrs("fd00::/56", 2)
+	assert.Error(t, err, "IPv6 vpc cidr")
+}
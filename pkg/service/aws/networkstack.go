@@ -0,0 +1,341 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//awsDiscoveryRegion region used to discover enabled regions of the account, DescribeRegions is not regional
+const awsDiscoveryRegion = "us-east-1"
+
+func tagValueOf(tags []*ec2.Tag, key string) string {
+	for _, t := range tags {
+		if aws.StringValue(t.Key) == key {
+			return aws.StringValue(t.Value)
+		}
+	}
+	return ""
+}
+
+func toNetworkStack(region string, stk *AwsWkspRegionNetworkStack, clusters []string) *proto.NetworkStack {
+	ns := &proto.NetworkStack{
+		Id:       aws.StringValue(stk.Vpc.VpcId),
+		Name:     fmt.Sprintf(vpcNameFmt, region),
+		Region:   region,
+		Cidrs:    []string{aws.StringValue(stk.Vpc.CidrBlock)},
+		Subnets:  []*proto.NetworkSubnet{},
+		Clusters: clusters,
+	}
+
+	for _, s := range stk.Subnets {
+		ns.Subnets = append(ns.Subnets, &proto.NetworkSubnet{
+			Id:               aws.StringValue(s.SubnetId),
+			Name:             tagValueOf(s.Tags, constants.NameLabel),
+			Cidr:             aws.StringValue(s.CidrBlock),
+			AvailabilityZone: aws.StringValue(s.AvailabilityZone),
+			Tier:             subnetTier(s),
+		})
+	}
+
+	if stk.Gateway != nil {
+		ns.InternetGateway = aws.StringValue(stk.Gateway.InternetGatewayId)
+	}
+	for _, n := range stk.NatGateways {
+		ns.NatGateways = append(ns.NatGateways, aws.StringValue(n.NatGatewayId))
+	}
+	for _, e := range stk.Endpoints {
+		ns.VpcEndpoints = append(ns.VpcEndpoints, aws.StringValue(e.ServiceName))
+	}
+	return ns
+}
+
+//vpcClusters clusters of the region placed in the vpc
+func vpcClusters(ctx context.Context, client *eks.EKS, vpcId string) ([]string, error) {
+	names := []*string{}
+	err := client.ListClustersPagesWithContext(ctx, &eks.ListClustersInput{}, func(page *eks.ListClustersOutput, lastPage bool) bool {
+		names = append(names, page.Clusters...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list clusters")
+	}
+
+	clusters := []string{}
+	for _, name := range names {
+		cluster, err := getClusterSpec(ctx, client, *name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get cluster '%s'", *name)
+		}
+		if cluster.ResourcesVpcConfig != nil && aws.StringValue(cluster.ResourcesVpcConfig.VpcId) == vpcId {
+			clusters = append(clusters, *name)
+		}
+	}
+	return clusters, nil
+}
+
+//regionNetworkStack network stack of the session region, error if region has none
+func regionNetworkStack(ctx context.Context, session *Session) (*AwsWkspRegionNetworkStack, []string, error) {
+	stk, err := GetRegionWkspNetworkStack(session)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stk.Vpc == nil {
+		return nil, nil, fmt.Errorf("no network stack in region '%s'", session.Region)
+	}
+
+	clusters, err := vpcClusters(ctx, session.getEksClient(), *stk.Vpc.VpcId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stk, clusters, nil
+}
+
+//GetNetworkStack network stack of the region and the clusters using it
+func (ctrl AWSController) GetNetworkStack(ctx context.Context, req *proto.GetNetworkStackRequest) (*proto.GetNetworkStackResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	stk, clusters, err := regionNetworkStack(ctx, session)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get network stack", "region", req.Region, "error", err)
+		return nil, err
+	}
+
+	return &proto.GetNetworkStackResponse{NetworkStack: toNetworkStack(req.Region, stk, clusters)}, nil
+}
+
+//enabledRegions regions enabled for the account
+func enabledRegions(ctx context.Context, accountName string) ([]string, error) {
+	session, err := NewSession(ctx, awsDiscoveryRegion, accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := session.getEC2Client().DescribeRegionsWithContext(ctx, &ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list regions")
+	}
+
+	regions := []string{}
+	for _, r := range out.Regions {
+		regions = append(regions, aws.StringValue(r.RegionName))
+	}
+	return regions, nil
+}
+
+//ListNetworkStacks network stacks of the requested regions, regions which fail to respond are skipped
+func (ctrl AWSController) ListNetworkStacks(ctx context.Context, req *proto.ListNetworkStacksRequest) (*proto.ListNetworkStacksResponse, error) {
+	regions := req.Regions
+	if len(regions) == 0 {
+		var err error
+		regions, err = enabledRegions(ctx, req.AccountName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &proto.ListNetworkStacksResponse{NetworkStacks: []*proto.NetworkStack{}}
+	for _, region := range regions {
+		session, err := NewSession(ctx, region, req.AccountName)
+		if err != nil {
+			return nil, err
+		}
+
+		stk, err := GetRegionWkspNetworkStack(session)
+		if err != nil {
+			ctrl.logger.Errorw("failed to get network stack", "region", region, "error", err)
+			continue
+		}
+		if stk.Vpc == nil {
+			continue
+		}
+
+		clusters, err := vpcClusters(ctx, session.getEksClient(), *stk.Vpc.VpcId)
+		if err != nil {
+			ctrl.logger.Errorw("failed to get clusters of network stack", "region", region, "error", err)
+			continue
+		}
+		resp.NetworkStacks = append(resp.NetworkStacks, toNetworkStack(region, stk, clusters))
+	}
+	return resp, nil
+}
+
+//vpcNetworkInterfaces network interfaces left in the vpc
+func vpcNetworkInterfaces(ctx context.Context, client *ec2.EC2, vpcId string) ([]*ec2.NetworkInterface, error) {
+	enis := []*ec2.NetworkInterface{}
+	err := client.DescribeNetworkInterfacesPagesWithContext(ctx, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: tagValue(vpcId),
+			},
+		},
+	}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		enis = append(enis, page.NetworkInterfaces...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get network interfaces of vpc %s", vpcId)
+	}
+	return enis, nil
+}
+
+//foreignInterfaces in use network interfaces not owned by the stack, i.e load balancers or instances still running in the vpc
+func foreignInterfaces(enis []*ec2.NetworkInterface) []string {
+	inUse := []string{}
+	for _, eni := range enis {
+		switch aws.StringValue(eni.InterfaceType) {
+		case ec2.NetworkInterfaceTypeNatGateway, ec2.NetworkInterfaceTypeVpcEndpoint:
+			continue
+		}
+		if aws.StringValue(eni.Status) != ec2.NetworkInterfaceStatusAvailable {
+			inUse = append(inUse, fmt.Sprintf("%s (%s)", aws.StringValue(eni.NetworkInterfaceId), aws.StringValue(eni.Description)))
+		}
+	}
+	return inUse
+}
+
+//deleteDetachedInterfaces network interfaces left behind by the cluster CNI and load balancers
+func deleteDetachedInterfaces(ctx context.Context, client *ec2.EC2, vpcId string) error {
+	enis, err := vpcNetworkInterfaces(ctx, client, vpcId)
+	if err != nil {
+		return err
+	}
+
+	for _, eni := range enis {
+		if aws.StringValue(eni.Status) != ec2.NetworkInterfaceStatusAvailable {
+			continue
+		}
+		_, err := client.DeleteNetworkInterfaceWithContext(ctx, &ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: eni.NetworkInterfaceId,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to delete network interface %s", *eni.NetworkInterfaceId)
+		}
+	}
+	return nil
+}
+
+//deleteSecurityGroups security groups left in the vpc, rules are revoked first as groups can reference each other
+func deleteSecurityGroups(ctx context.Context, client *ec2.EC2, vpcId string) error {
+	out, err := client.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: tagValue(vpcId),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get security groups of vpc %s", vpcId)
+	}
+
+	groups := []*ec2.SecurityGroup{}
+	for _, sg := range out.SecurityGroups {
+		//default group is deleted along with the vpc
+		if aws.StringValue(sg.GroupName) != "default" {
+			groups = append(groups, sg)
+		}
+	}
+
+	for _, sg := range groups {
+		if len(sg.IpPermissions) > 0 {
+			_, err = client.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: sg.IpPermissions,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to revoke ingress rules of security group %s", *sg.GroupId)
+			}
+		}
+		if len(sg.IpPermissionsEgress) > 0 {
+			_, err = client.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: sg.IpPermissionsEgress,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to revoke egress rules of security group %s", *sg.GroupId)
+			}
+		}
+	}
+
+	for _, sg := range groups {
+		_, err = client.DeleteSecurityGroupWithContext(ctx, &ec2.DeleteSecurityGroupInput{
+			GroupId: sg.GroupId,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to delete security group %s", *sg.GroupId)
+		}
+	}
+	return nil
+}
+
+//DeleteNetworkStack delete the region network stack, refused while any cluster or other resource is using it
+func (ctrl AWSController) DeleteNetworkStack(ctx context.Context, req *proto.DeleteNetworkStackRequest) (*proto.DeleteNetworkStackResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEC2Client()
+
+	stk, clusters, err := regionNetworkStack(ctx, session)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get network stack", "region", req.Region, "error", err)
+		return nil, err
+	}
+	vpcId := *stk.Vpc.VpcId
+
+	if len(clusters) > 0 {
+		return nil, fmt.Errorf("network stack '%s' is used by clusters %s", vpcId, strings.Join(clusters, ", "))
+	}
+
+	enis, err := vpcNetworkInterfaces(ctx, client, vpcId)
+	if err != nil {
+		return nil, err
+	}
+	if inUse := foreignInterfaces(enis); len(inUse) > 0 {
+		return nil, fmt.Errorf("network stack '%s' has network interfaces in use %s", vpcId, strings.Join(inUse, ", "))
+	}
+
+	ctrl.logger.Infow("deleting network stack", "region", req.Region, "vpc", vpcId)
+
+	//endpoint and NAT gateway interfaces are released once they are deleted
+	if err = DeleteVpcEndpoints(ctx, client, stk.Vpc, stk.Endpoints); err != nil {
+		return nil, err
+	}
+	stk.Endpoints = nil
+
+	if err = DeleteNatGateways(ctx, client, stk.NatGateways, stk.Addresses); err != nil {
+		return nil, err
+	}
+	stk.NatGateways = nil
+	stk.Addresses = nil
+
+	if err = deleteDetachedInterfaces(ctx, client, vpcId); err != nil {
+		return nil, err
+	}
+
+	if err = deleteSecurityGroups(ctx, client, vpcId); err != nil {
+		return nil, err
+	}
+	stk.EndpointSecurityGroup = nil
+
+	if err = DeleteRegionWkspNetworkStack(ctx, session, *stk); err != nil {
+		ctrl.logger.Errorw("failed to delete network stack", "region", req.Region, "vpc", vpcId, "error", err)
+		return nil, err
+	}
+
+	ctrl.logger.Infow("deleted network stack", "region", req.Region, "vpc", vpcId)
+	return &proto.DeleteNetworkStackResponse{}, nil
+}
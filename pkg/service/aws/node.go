@@ -6,28 +6,18 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
-func (ctrl AWSController) getNodeHealth(ctx context.Context, client *eks.EKS, cluster, nodeName string) (*eks.NodegroupHealth, error) {
-
-	node, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
-		ClusterName:   &cluster,
-		NodegroupName: &nodeName,
-	})
-
-	if err != nil {
-		return nil, err
-	}
-	return node.Nodegroup.Health, nil
-}
-
 func healthProto(health *eks.NodegroupHealth) *proto.Health {
 	pr := &proto.Health{}
 	issues := make([]*proto.Issue, 0, len(health.Issues))
@@ -121,25 +111,56 @@ func (a *AWSController) buildNodegroupInput(ctx context.Context, session *Sessio
 
 	capacityType, instanceTypes, err := getInstance(nodeSpec)
 
+	if err != nil {
+		return nil, err
+	}
+
+	subnetIds, err = nodeSubnets(ctx, session, subnetIds, nodeSpec.AvailabilityZones)
 	if err != nil {
 		return nil, err
 	}
 	gpuEnabled := nodeSpec.GpuEnabled
 
+	if nodeSpec.MigProfile != proto.MIGProfile_UNKNOWN {
+		memory, err := migMemory(instanceTypes)
+		if err != nil {
+			return nil, err
+		}
+		gpuEnabled = true
+
+		if nodeSpec.GpuPlugin == proto.GPUPlugin_GPU_OPERATOR {
+			//mig-manager partitions the GPUs as per the node label
+			labels[kube.MigConfigLabel] = aws.String("all-" + migProfileName(nodeSpec.MigProfile, memory))
+		}
+	}
+
 	if common.IsGPU(nodeSpec.MachineType) {
 		gpuEnabled = true
 	}
 	amiType := ""
 	//Choose Amazon Linux 2 (AL2_x86_64) for Linux non-GPU instances, Amazon Linux 2 GPU Enabled (AL2_x86_64_GPU) for Linux GPU instances
-	if gpuEnabled {
+	//and Amazon Linux 2 Arm (AL2_ARM_64) for Graviton instances, EKS has no GPU AMI for arm
+	arm := common.IsARM(nodeSpec.MachineType)
+	switch {
+	case arm && gpuEnabled:
+		return nil, fmt.Errorf("gpu is not supported on arm machine type '%s'", nodeSpec.MachineType)
+	case arm:
+		amiType = eks.AMITypesAl2Arm64
+	case gpuEnabled:
 		a.logger.Infow("requested gpu node", "name", nodeSpec.Name, "instance ", instanceTypes, "machine_type", nodeSpec.MachineType)
-		amiType = "AL2_x86_64_GPU"
-	} else {
-		amiType = "AL2_x86_64"
+		amiType = eks.AMITypesAl2X8664Gpu
+	default:
+		amiType = eks.AMITypesAl2X8664
 	}
 	a.logger.Debugw("building node group input", "name", nodeSpec.Name, "instance ", instanceTypes, "machine_type", nodeSpec.MachineType)
 
-	return &eks.CreateNodegroupInput{
+	//nodegroup defaults to cluster version when not specified
+	var version *string
+	if nodeSpec.KubernetesVersion != "" {
+		version = &nodeSpec.KubernetesVersion
+	}
+
+	input := &eks.CreateNodegroupInput{
 		AmiType:       &amiType,
 		CapacityType:  &capacityType,
 		NodeRole:      nodeRoleArn,
@@ -154,13 +175,29 @@ func (a *AWSController) buildNodegroupInput(ctx context.Context, session *Sessio
 			MinSize:     &count,
 			MaxSize:     &count,
 		},
-		Tags: labels,
-	}, nil
-}
+		Tags:    labels,
+		Version: version,
+	}
 
-func (ctrl AWSController) getNewNodeGroupSpecFromCluster(ctx context.Context, session *Session, cluster *eks.Cluster, nodeSpec *proto.NodeSpec) (*eks.CreateNodegroupInput, error) {
+	//every node group gets a launch template, EKS does not tag the instances, volumes and network interfaces it launches
+	lt, err := a.createLaunchTemplate(ctx, session, *clusterName, nodeSpec, diskSize, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	//disk size goes to launch template block device mapping, EKS rejects both being set
+	input.DiskSize = nil
+	input.LaunchTemplate = lt
+	if nodeSpec.AmiId != "" {
+		//custom AMI decides the kubernetes version and type
+		input.AmiType = nil
+		input.Version = nil
+	}
+	return input, nil
+}
 
-	iamClient := session.getIAMClient()
+//nodeGroupRole get or create the node group instance role with the worker node policies
+func (ctrl AWSController) nodeGroupRole(ctx context.Context, iamClient *iam.IAM) (*iam.Role, error) {
 
 	roleName := AWS_NODE_GROUP_ROLE_NAME
 	nodeRole, newRole, err := ctrl.createRoleOrGetExisting(ctx, iamClient, roleName, "node group instance policy role", EC2_ASSUME_ROLE_DOC)
@@ -193,6 +230,15 @@ func (ctrl AWSController) getNewNodeGroupSpecFromCluster(ctx context.Context, se
 			return nil, err
 		}
 	}
+	return nodeRole, nil
+}
+
+func (ctrl AWSController) getNewNodeGroupSpecFromCluster(ctx context.Context, session *Session, cluster *eks.Cluster, nodeSpec *proto.NodeSpec) (*eks.CreateNodegroupInput, error) {
+
+	nodeRole, err := ctrl.nodeGroupRole(ctx, session.getIAMClient())
+	if err != nil {
+		return nil, err
+	}
 
 	input, err := ctrl.buildNodegroupInput(ctx, session, cluster.Name, nodeSpec, cluster.ResourcesVpcConfig.SubnetIds, nodeRole.Arn)
 	if err != nil {
@@ -233,6 +279,19 @@ func (ctrl AWSController) AddNode(ctx context.Context, req *proto.NodeSpawnReque
 		return nil, err
 	}
 
+	capacity, err := ctrl.preflight(ctx, session, cluster, nodeSpec)
+	if err != nil {
+		return nil, err
+	}
+	if err = common.CapacityError(capacity); err != nil {
+		ctrl.logger.Errorw("capacity preflight failed", "cluster", clusterName, "nodegroup", nodeSpec.Name, "error", err)
+		return nil, err
+	}
+	warnings := common.CapacityWarnings(capacity)
+	for _, w := range warnings {
+		ctrl.logger.Warnw("capacity preflight warning", "cluster", clusterName, "nodegroup", nodeSpec.Name, "warning", w)
+	}
+
 	ctrl.logger.Infof("querying default nodes on cluster '%s' in region '%s'", clusterName, region)
 	defaultNode, err := ctrl.getDefaultNode(ctx, client, clusterName, nodeSpec.Name)
 
@@ -262,15 +321,26 @@ func (ctrl AWSController) AddNode(ctx context.Context, req *proto.NodeSpawnReque
 	out, err := client.CreateNodegroupWithContext(ctx, newNodeGroupInput)
 	if err != nil {
 		ctrl.logger.Errorf("failed to add a node '%s': %w", nodeSpec.Name, err)
+		if lterr := ctrl.rollbackLaunchTemplate(ctx, session, clusterName, newNodeGroupInput.LaunchTemplate); lterr != nil {
+			ctrl.logger.Errorw("failed to delete launch template of the nodegroup", "nodegroup", nodeSpec.Name, "error", lterr)
+		}
 		return nil, err
 	}
 	ctrl.logger.Infof("creating nodegroup '%s' on cluster '%s', Status : %s, it might take some time. Please check AWS console.", nodeSpec.Name, clusterName, *out.Nodegroup.Status)
-	return &proto.NodeSpawnResponse{}, err
+
+	if nodeSpec.GpuPlugin != proto.GPUPlugin_PluginNONE {
+		go ctrl.installGPUPlugin(session, cluster, nodeSpec)
+	}
+
+	if nodeSpec.CapacityType == proto.CapacityType_SPOT && nodeSpec.SpotFallback.GetEnabled() {
+		go ctrl.watchSpotNodegroup(session, cluster, nodeSpec, newNodeGroupInput)
+	}
+	return &proto.NodeSpawnResponse{Warnings: warnings}, err
 }
 
-func (ctrl AWSController) deleteAllNodegroups(ctx context.Context, client *eks.EKS, clusterName string) error {
+func (ctrl AWSController) deleteAllNodegroups(ctx context.Context, session *Session, clusterName string) error {
 	input := &eks.ListNodegroupsInput{ClusterName: &clusterName}
-	nodeGroupList, err := client.ListNodegroupsWithContext(ctx, input)
+	nodeGroupList, err := session.getEksClient().ListNodegroupsWithContext(ctx, input)
 	if err != nil {
 		return err
 	}
@@ -281,7 +351,7 @@ func (ctrl AWSController) deleteAllNodegroups(ctx context.Context, client *eks.E
 
 	for _, nodeGroupName := range nodeGroupList.Nodegroups {
 		//drop em
-		if err = ctrl.deleteNode(ctx, client, clusterName, *nodeGroupName); err != nil {
+		if err = ctrl.deleteNode(ctx, session, clusterName, *nodeGroupName); err != nil {
 			ctrl.logger.Errorw("error when deleting nodegroup", "nodegroup", nodeGroupName)
 			return err
 		}
@@ -360,8 +430,8 @@ func (ctrl AWSController) waitForAllNodegroupsDeletion(ctx context.Context, clie
 	return nil
 }
 
-func (ctrl AWSController) deleteNode(ctx context.Context, client *eks.EKS, cluster, node string) error {
-	nodeDeleteOut, err := client.DeleteNodegroupWithContext(ctx, &eks.DeleteNodegroupInput{
+func (ctrl AWSController) deleteNode(ctx context.Context, session *Session, cluster, node string) error {
+	nodeDeleteOut, err := session.getEksClient().DeleteNodegroupWithContext(ctx, &eks.DeleteNodegroupInput{
 		ClusterName:   &cluster,
 		NodegroupName: &node,
 	})
@@ -370,10 +440,31 @@ func (ctrl AWSController) deleteNode(ctx context.Context, client *eks.EKS, clust
 		return err
 
 	}
+
+	if lt := nodeDeleteOut.Nodegroup.LaunchTemplate; lt != nil {
+		go ctrl.cleanupLaunchTemplate(session, cluster, node, lt)
+	}
 	ctrl.logger.Infof("requested nodegroup '%s' to be deleted, Status %s. It might take some time, check AWS console for more.", node, *nodeDeleteOut.Nodegroup.Status)
 	return nil
 }
 
+//drainNodes cordon and evict pods from the nodes matching selector, waits until timeout
+func (ctrl AWSController) drainNodes(ctx context.Context, session *Session, client *eks.EKS, clusterName, selector string, timeout int32) {
+	cluster, err := getClusterSpec(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Warnw("failed to get cluster, skipping drain", "cluster", clusterName, "error", err)
+		return
+	}
+
+	k8s, err := session.getK8sClient(cluster)
+	if err != nil {
+		ctrl.logger.Warnw("failed to get kubernetes client, skipping drain", "cluster", clusterName, "error", err)
+		return
+	}
+
+	kube.DrainBeforeDelete(ctx, k8s, selector, timeout, ctrl.logger.With("cluster", clusterName))
+}
+
 //DeleteNode delete nodes attched to cluster which is created by spawner
 func (ctrl AWSController) DeleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
 	clusterName := req.ClusterName
@@ -401,7 +492,11 @@ func (ctrl AWSController) DeleteNode(ctx context.Context, req *proto.NodeDeleteR
 		return nil, fmt.Errorf("nodegroup '%s' not available in scope '%s'", nodeName, labels.ScopeTag())
 	}
 
-	err = ctrl.deleteNode(ctx, client, clusterName, nodeName)
+	if req.Drain {
+		ctrl.drainNodes(ctx, session, client, clusterName, kube.NodePoolSelector(constants.NodeNameLabel, nodeName), req.DrainTimeoutSeconds)
+	}
+
+	err = ctrl.deleteNode(ctx, session, clusterName, nodeName)
 	if err != nil {
 		ctrl.logger.Errorw("failed to delete nodegroup", "nodename", nodeName)
 		return &proto.NodeDeleteResponse{Error: err.Error()}, err
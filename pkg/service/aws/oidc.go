@@ -0,0 +1,345 @@
+package aws
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	stsAudience = "sts.amazonaws.com"
+	//serviceAccountRoleAnnotation picked up by the EKS pod identity webhook to inject the role credentials
+	serviceAccountRoleAnnotation = "eks.amazonaws.com/role-arn"
+	//maxRoleName IAM role names are limited to 64 characters
+	maxRoleName = 64
+	//roleNameHashLen hex characters of the identity hash suffixed to service account role names
+	roleNameHashLen = 8
+)
+
+//oidcIssuer issuer url of the cluster, available once the cluster is active
+func oidcIssuer(cluster *eks.Cluster) (string, error) {
+	if cluster.Identity == nil || cluster.Identity.Oidc == nil || aws.StringValue(cluster.Identity.Oidc.Issuer) == "" {
+		return "", fmt.Errorf("cluster '%s' has no OIDC issuer yet", aws.StringValue(cluster.Name))
+	}
+	return aws.StringValue(cluster.Identity.Oidc.Issuer), nil
+}
+
+//issuerThumbprint SHA-1 thumbprint of the root CA of the issuer, IAM requires it while creating the provider
+func issuerThumbprint(issuer string) (string, error) {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid OIDC issuer '%s'", issuer)
+	}
+
+	dialer := &net.Dialer{Timeout: time.Second * 10}
+	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(u.Hostname(), "443"), &tls.Config{ServerName: u.Hostname()})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to connect to OIDC issuer '%s'", issuer)
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", fmt.Errorf("OIDC issuer '%s' presented no certificates", issuer)
+	}
+	sum := sha1.Sum(certs[len(certs)-1].Raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+//findOIDCProvider arn of the IAM OIDC provider of the issuer, empty when it does not exist
+func findOIDCProvider(ctx context.Context, client *iam.IAM, issuer string) (string, error) {
+	out, err := client.ListOpenIDConnectProvidersWithContext(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list OIDC providers")
+	}
+
+	suffix := "oidc-provider/" + strings.TrimPrefix(issuer, "https://")
+	for _, p := range out.OpenIDConnectProviderList {
+		if strings.HasSuffix(aws.StringValue(p.Arn), suffix) {
+			return aws.StringValue(p.Arn), nil
+		}
+	}
+	return "", nil
+}
+
+//ensureOIDCProvider create the IAM OIDC provider for the cluster issuer if it does not exist
+func (ctrl AWSController) ensureOIDCProvider(ctx context.Context, session *Session, cluster *eks.Cluster) (string, error) {
+	issuer, err := oidcIssuer(cluster)
+	if err != nil {
+		return "", err
+	}
+
+	client := session.getIAMClient()
+	arn, err := findOIDCProvider(ctx, client, issuer)
+	if err != nil || arn != "" {
+		return arn, err
+	}
+
+	thumbprint, err := issuerThumbprint(issuer)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := client.CreateOpenIDConnectProviderWithContext(ctx, &iam.CreateOpenIDConnectProviderInput{
+		Url:            &issuer,
+		ClientIDList:   aws.StringSlice([]string{stsAudience}),
+		ThumbprintList: aws.StringSlice([]string{thumbprint}),
+		Tags: []*iam.Tag{
+			{
+				Key:   aws.String(constants.CreatorLabel),
+				Value: aws.String(constants.SpawnerServiceLabel),
+			},
+			{
+				Key:   aws.String(constants.ClusterNameLabel),
+				Value: cluster.Name,
+			},
+			{
+				Key:   aws.String(constants.Scope),
+				Value: aws.String(labels.ScopeTag()),
+			},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create OIDC provider for cluster '%s'", aws.StringValue(cluster.Name))
+	}
+
+	ctrl.logger.Infow("created OIDC provider", "cluster", aws.StringValue(cluster.Name), "provider", aws.StringValue(out.OpenIDConnectProviderArn))
+	return aws.StringValue(out.OpenIDConnectProviderArn), nil
+}
+
+//deleteOIDCProvider delete the IAM OIDC provider of the cluster, no op if it does not exist
+func (ctrl AWSController) deleteOIDCProvider(ctx context.Context, session *Session, cluster *eks.Cluster) error {
+	issuer, err := oidcIssuer(cluster)
+	if err != nil {
+		return nil
+	}
+
+	client := session.getIAMClient()
+	arn, err := findOIDCProvider(ctx, client, issuer)
+	if err != nil || arn == "" {
+		return err
+	}
+
+	_, err = client.DeleteOpenIDConnectProviderWithContext(ctx, &iam.DeleteOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: &arn,
+	})
+	return err
+}
+
+//serviceAccountTrustDoc trust policy allowing only the service account to assume the role through the OIDC provider
+func serviceAccountTrustDoc(providerArn, issuer, namespace, serviceAccount string) (string, error) {
+	host := strings.TrimPrefix(issuer, "https://")
+	doc := map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect":    "Allow",
+				"Principal": map[string]string{"Federated": providerArn},
+				"Action":    "sts:AssumeRoleWithWebIdentity",
+				"Condition": map[string]interface{}{
+					"StringEquals": map[string]string{
+						host + ":sub": fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount),
+						host + ":aud": stsAudience,
+					},
+				},
+			},
+		},
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+//serviceAccountRoleName role name of the service account, hash of the full identity keeps truncated or
+//ambiguous names such as a-b/c and a/b-c apart
+func serviceAccountRoleName(cluster, namespace, serviceAccount string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", cluster, namespace, serviceAccount)))
+	suffix := hex.EncodeToString(sum[:])[:roleNameHashLen]
+
+	name := fmt.Sprintf("%s-%s-%s", cluster, namespace, serviceAccount)
+	if len(name) > maxRoleName-roleNameHashLen-1 {
+		name = name[:maxRoleName-roleNameHashLen-1]
+	}
+	return name + "-" + suffix
+}
+
+func iamTagValue(tags []*iam.Tag, key string) string {
+	for _, t := range tags {
+		if aws.StringValue(t.Key) == key {
+			return aws.StringValue(t.Value)
+		}
+	}
+	return ""
+}
+
+//isClusterRole role created by spawner of this env scope for the cluster
+func isClusterRole(role *iam.Role, clusterName string) bool {
+	return iamTagValue(role.Tags, constants.CreatorLabel) == constants.SpawnerServiceLabel &&
+		iamTagValue(role.Tags, constants.Scope) == labels.ScopeTag() &&
+		iamTagValue(role.Tags, constants.ClusterNameLabel) == clusterName
+}
+
+//serviceAccountRole create the role trusted by the service account of the cluster and attach the policies.
+//Trust policy of an existing role is replaced, cluster recreated with the same name gets a new issuer, roles not
+//created by spawner for the cluster are refused so their trust policy is never overwritten
+func (ctrl AWSController) serviceAccountRole(ctx context.Context, session *Session, cluster *eks.Cluster, namespace, serviceAccount, roleName string, policyArns []string) (*iam.Role, error) {
+	providerArn, err := ctrl.ensureOIDCProvider(ctx, session, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := oidcIssuer(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	trustDoc, err := serviceAccountTrustDoc(providerArn, issuer, namespace, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	iamClient := session.getIAMClient()
+	clusterName := aws.StringValue(cluster.Name)
+	description := fmt.Sprintf("service account %s/%s of cluster %s", namespace, serviceAccount, clusterName)
+	clusterTag := &iam.Tag{Key: aws.String(constants.ClusterNameLabel), Value: &clusterName}
+	role, newRole, err := ctrl.createRoleOrGetExisting(ctx, iamClient, roleName, description, trustDoc, clusterTag)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newRole {
+		if !isClusterRole(role, clusterName) {
+			return nil, fmt.Errorf("role '%s' exists and is not created by spawner for cluster '%s'", roleName, clusterName)
+		}
+
+		_, err = iamClient.UpdateAssumeRolePolicyWithContext(ctx, &iam.UpdateAssumeRolePolicyInput{
+			RoleName:       &roleName,
+			PolicyDocument: &trustDoc,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to update trust policy of role '%s'", roleName)
+		}
+	}
+
+	for _, policy := range policyArns {
+		if err = ctrl.attachPolicy(ctx, iamClient, roleName, policy); err != nil {
+			ctrl.logger.Errorw("failed to attach policy to role", "policy", policy, "role", roleName, "error", err)
+			return nil, err
+		}
+	}
+	return role, nil
+}
+
+//deleteServiceAccountRole detach the policies and delete the role, roles not created by spawner for the cluster are left as is
+func (ctrl AWSController) deleteServiceAccountRole(ctx context.Context, client *iam.IAM, clusterName, roleName string) error {
+	out, err := client.GetRoleWithContext(ctx, &iam.GetRoleInput{RoleName: &roleName})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == iam.ErrCodeNoSuchEntityException {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get role '%s'", roleName)
+	}
+	if !isClusterRole(out.Role, clusterName) {
+		ctrl.logger.Warnw("role is not created by spawner for the cluster, not deleting it", "cluster", clusterName, "role", roleName)
+		return nil
+	}
+
+	policies := []*string{}
+	err = client.ListAttachedRolePoliciesPagesWithContext(ctx, &iam.ListAttachedRolePoliciesInput{RoleName: &roleName},
+		func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+			for _, p := range page.AttachedPolicies {
+				policies = append(policies, p.PolicyArn)
+			}
+			return true
+		})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list policies of role '%s'", roleName)
+	}
+
+	for _, policy := range policies {
+		_, err = client.DetachRolePolicyWithContext(ctx, &iam.DetachRolePolicyInput{
+			RoleName:  &roleName,
+			PolicyArn: policy,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to detach policy '%s' from role '%s'", aws.StringValue(policy), roleName)
+		}
+	}
+
+	if _, err = client.DeleteRoleWithContext(ctx, &iam.DeleteRoleInput{RoleName: &roleName}); err != nil {
+		return errors.Wrapf(err, "failed to delete role '%s'", roleName)
+	}
+	ctrl.logger.Infow("deleted service account role", "cluster", clusterName, "role", roleName)
+	return nil
+}
+
+//BindServiceAccountRole create IAM role for the kubernetes service account and annotate the service account with it
+func (ctrl AWSController) BindServiceAccountRole(ctx context.Context, req *proto.BindServiceAccountRoleRequest) (*proto.BindServiceAccountRoleResponse, error) {
+	if req.Namespace == "" || req.ServiceAccount == "" {
+		return nil, errors.New("namespace and service account must be provided")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := getClusterSpec(ctx, session.getEksClient(), req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", req.ClusterName, "region", req.Region)
+		return nil, err
+	}
+	if err = checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+
+	roleName := req.RoleName
+	if roleName == "" {
+		roleName = serviceAccountRoleName(req.ClusterName, req.Namespace, req.ServiceAccount)
+	}
+
+	role, err := ctrl.serviceAccountRole(ctx, session, cluster, req.Namespace, req.ServiceAccount, roleName, req.PolicyArns)
+	if err != nil {
+		ctrl.logger.Errorw("failed to create service account role", "cluster", req.ClusterName, "role", roleName, "error", err)
+		return nil, err
+	}
+
+	k8s, err := session.getK8sClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := map[string]string{serviceAccountRoleAnnotation: aws.StringValue(role.Arn)}
+	if err = kube.BindServiceAccount(ctx, k8s, req.Namespace, req.ServiceAccount, annotations, nil); err != nil {
+		ctrl.logger.Errorw("failed to annotate service account", "cluster", req.ClusterName, "namespace", req.Namespace, "serviceaccount", req.ServiceAccount, "error", err)
+		return nil, err
+	}
+
+	issuer, _ := oidcIssuer(cluster)
+	ctrl.logger.Infow("bound role to service account", "cluster", req.ClusterName, "namespace", req.Namespace, "serviceaccount", req.ServiceAccount, "role", roleName)
+	return &proto.BindServiceAccountRoleResponse{
+		RoleArn:    aws.StringValue(role.Arn),
+		OidcIssuer: issuer,
+	}, nil
+}
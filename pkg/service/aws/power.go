@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//stoppedScalingTag scaling config of the node group before the cluster was stopped, as "min/max/desired"
+const stoppedScalingTag = "spawner-stopped-scaling"
+
+func scalingTagValue(sc *eks.NodegroupScalingConfig) string {
+	return fmt.Sprintf("%d/%d/%d", aws.Int64Value(sc.MinSize), aws.Int64Value(sc.MaxSize), aws.Int64Value(sc.DesiredSize))
+}
+
+func parseScalingTag(value string) (*eks.NodegroupScalingConfig, error) {
+	var min, max, desired int64
+	if _, err := fmt.Sscanf(value, "%d/%d/%d", &min, &max, &desired); err != nil {
+		return nil, errors.Wrapf(err, "invalid scaling tag '%s'", value)
+	}
+	return &eks.NodegroupScalingConfig{
+		MinSize:     &min,
+		MaxSize:     &max,
+		DesiredSize: &desired,
+	}, nil
+}
+
+//activeNodegroups node groups of the spawner cluster, scaling config can be changed only when all of them are active
+func activeNodegroups(ctx context.Context, client *eks.EKS, clusterName string) (map[string]*eks.Nodegroup, error) {
+	cluster, err := getClusterSpec(ctx, client, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+	if aws.StringValue(cluster.Status) != eks.ClusterStatusActive {
+		return nil, fmt.Errorf("cluster '%s' is %s", clusterName, aws.StringValue(cluster.Status))
+	}
+
+	nodegroups, err := describeNodegroups(ctx, client, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	for name, ng := range nodegroups {
+		if aws.StringValue(ng.Status) != eks.NodegroupStatusActive {
+			return nil, fmt.Errorf("nodegroup '%s' is %s, try again once it is active", name, aws.StringValue(ng.Status))
+		}
+	}
+	return nodegroups, nil
+}
+
+//StopCluster scale every node group to zero, scaling config is kept in the node group tags so start can restore it.
+//EKS control plane cannot be stopped and is still billed
+func (ctrl AWSController) StopCluster(ctx context.Context, req *proto.StopClusterRequest) (*proto.StopClusterResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	nodegroups, err := activeNodegroups(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("cannot stop cluster", "cluster", req.ClusterName, "region", req.Region, "error", err)
+		return nil, err
+	}
+
+	state := proto.ClusterState_STOPPED
+	for name, ng := range nodegroups {
+		if _, ok := ng.Tags[stoppedScalingTag]; ok || ng.ScalingConfig == nil {
+			continue
+		}
+
+		//scaling config is saved first, node group scaled to zero without it cannot be restored
+		_, err = client.TagResourceWithContext(ctx, &eks.TagResourceInput{
+			ResourceArn: ng.NodegroupArn,
+			Tags:        map[string]*string{stoppedScalingTag: aws.String(scalingTagValue(ng.ScalingConfig))},
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to save nodegroup scaling config", "cluster", req.ClusterName, "nodegroup", name, "error", err)
+			return nil, err
+		}
+
+		_, err = client.UpdateNodegroupConfigWithContext(ctx, &eks.UpdateNodegroupConfigInput{
+			ClusterName:   &req.ClusterName,
+			NodegroupName: ng.NodegroupName,
+			ScalingConfig: &eks.NodegroupScalingConfig{
+				MinSize:     aws.Int64(0),
+				MaxSize:     ng.ScalingConfig.MaxSize,
+				DesiredSize: aws.Int64(0),
+			},
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to scale down nodegroup", "cluster", req.ClusterName, "nodegroup", name, "error", err)
+			return nil, err
+		}
+		ctrl.logger.Infow("scaling down nodegroup", "cluster", req.ClusterName, "nodegroup", name, "scaling", scalingTagValue(ng.ScalingConfig))
+		state = proto.ClusterState_UPDATING
+	}
+	return &proto.StopClusterResponse{State: state}, nil
+}
+
+//StartCluster scale the node groups back to the size they had before stop
+func (ctrl AWSController) StartCluster(ctx context.Context, req *proto.StartClusterRequest) (*proto.StartClusterResponse, error) {
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	nodegroups, err := activeNodegroups(ctx, client, req.ClusterName)
+	if err != nil {
+		ctrl.logger.Errorw("cannot start cluster", "cluster", req.ClusterName, "region", req.Region, "error", err)
+		return nil, err
+	}
+
+	state := proto.ClusterState_RUNNING
+	for name, ng := range nodegroups {
+		value, ok := ng.Tags[stoppedScalingTag]
+		if !ok {
+			continue
+		}
+
+		scaling, err := parseScalingTag(aws.StringValue(value))
+		if err != nil {
+			ctrl.logger.Errorw("cannot restore nodegroup", "cluster", req.ClusterName, "nodegroup", name, "error", err)
+			return nil, err
+		}
+
+		_, err = client.UpdateNodegroupConfigWithContext(ctx, &eks.UpdateNodegroupConfigInput{
+			ClusterName:   &req.ClusterName,
+			NodegroupName: ng.NodegroupName,
+			ScalingConfig: scaling,
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to scale up nodegroup", "cluster", req.ClusterName, "nodegroup", name, "error", err)
+			return nil, err
+		}
+
+		_, err = client.UntagResourceWithContext(ctx, &eks.UntagResourceInput{
+			ResourceArn: ng.NodegroupArn,
+			TagKeys:     []*string{aws.String(stoppedScalingTag)},
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to remove saved scaling config", "cluster", req.ClusterName, "nodegroup", name, "error", err)
+			return nil, err
+		}
+		ctrl.logger.Infow("scaling up nodegroup", "cluster", req.ClusterName, "nodegroup", name, "scaling", aws.StringValue(value))
+		state = proto.ClusterState_UPDATING
+	}
+	return &proto.StartClusterResponse{State: state}, nil
+}
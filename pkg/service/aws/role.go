@@ -10,8 +10,8 @@ import (
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 )
 
-//createRoleOrGetExisting creates a role if it does not exist
-func (svc AWSController) createRoleOrGetExisting(ctx context.Context, iamClient *iam.IAM, roleName string, description string, assumeRoleDoc string) (*iam.Role, bool, error) {
+//createRoleOrGetExisting creates a role if it does not exist, tags are added to the default ones of the new role
+func (svc AWSController) createRoleOrGetExisting(ctx context.Context, iamClient *iam.IAM, roleName string, description string, assumeRoleDoc string, tags ...*iam.Tag) (*iam.Role, bool, error) {
 
 	role, err := iamClient.GetRoleWithContext(ctx, &iam.GetRoleInput{
 		RoleName: &roleName,
@@ -46,6 +46,7 @@ func (svc AWSController) createRoleOrGetExisting(ctx context.Context, iamClient
 				},
 			},
 		}
+		roleInput.Tags = append(roleInput.Tags, tags...)
 
 		roleOut, err := iamClient.CreateRoleWithContext(ctx, roleInput)
 		if err != nil {
@@ -4,16 +4,23 @@ import (
 	"context"
 	"encoding/base64"
 	"log"
+	"net/http"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/config"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
 	"k8s.io/client-go/dynamic"
@@ -22,6 +29,9 @@ import (
 	"sigs.k8s.io/aws-iam-authenticator/pkg/token"
 )
 
+//tokenRefreshMargin kubernetes token is replaced this long before it expires
+const tokenRefreshMargin = time.Minute
+
 type Session struct {
 	AwsSession *session.Session
 	Region     string
@@ -67,16 +77,59 @@ func NewSession(ctx context.Context, region string, accountName string) (*Sessio
 	}, nil
 }
 
-func newKubeConfig(session *session.Session, cluster *eks.Cluster) (*rest.Config, error) {
+//newToken kubernetes token of the cluster, valid for a few minutes
+func newToken(session *session.Session, cluster *eks.Cluster) (token.Token, error) {
 	gen, err := token.NewGenerator(true, false)
 	if err != nil {
-		return nil, err
+		return token.Token{}, err
 	}
 	opts := &token.GetTokenOptions{
 		ClusterID: aws.StringValue(cluster.Name),
 		Session:   session,
 	}
-	tok, err := gen.GetWithOptions(opts)
+	return gen.GetWithOptions(opts)
+}
+
+//tokenRefresher sets a valid kubernetes token on every request, a new one is generated once the current one
+//is about to expire so long running operations like bootstrap keep their access
+type tokenRefresher struct {
+	session *session.Session
+	cluster *eks.Cluster
+	next    http.RoundTripper
+
+	mu  sync.Mutex
+	tok token.Token
+}
+
+func (t *tokenRefresher) token() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if time.Now().Add(tokenRefreshMargin).Before(t.tok.Expiration) {
+		return t.tok.Token, nil
+	}
+	tok, err := newToken(t.session, t.cluster)
+	if err != nil {
+		return "", err
+	}
+	t.tok = tok
+	return tok.Token, nil
+}
+
+func (t *tokenRefresher) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.token()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to refresh kubernetes token")
+	}
+	//round trippers must not modify the request
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+tok)
+	return t.next.RoundTrip(req)
+}
+
+func newKubeConfig(session *session.Session, cluster *eks.Cluster) (*rest.Config, error) {
+	//first token is generated up front, so credential errors surface here and not on the first request
+	tok, err := newToken(session, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -85,11 +138,13 @@ func newKubeConfig(session *session.Session, cluster *eks.Cluster) (*rest.Config
 		return nil, err
 	}
 	return &rest.Config{
-		Host:        aws.StringValue(cluster.Endpoint),
-		BearerToken: tok.Token,
+		Host: aws.StringValue(cluster.Endpoint),
 		TLSClientConfig: rest.TLSClientConfig{
 			CAData: ca,
 		},
+		WrapTransport: func(rt http.RoundTripper) http.RoundTripper {
+			return &tokenRefresher{session: session, cluster: cluster, next: rt, tok: tok}
+		},
 	}, nil
 }
 
@@ -115,6 +170,10 @@ func (ses *Session) getEC2Client() *ec2.EC2 {
 	return ec2.New(ses.AwsSession)
 }
 
+func (ses *Session) getAutoscalingClient() *autoscaling.AutoScaling {
+	return autoscaling.New(ses.AwsSession)
+}
+
 func (ses *Session) getCostExplorerClient() *costexplorer.CostExplorer {
 	return costexplorer.New(ses.AwsSession)
 }
@@ -131,6 +190,14 @@ func (ses *Session) getIAMClient() *iam.IAM {
 	return iam.New(ses.AwsSession)
 }
 
+func (ses *Session) getKMSClient() *kms.KMS {
+	return kms.New(ses.AwsSession)
+}
+
+func (ses *Session) getServiceQuotasClient() *servicequotas.ServiceQuotas {
+	return servicequotas.New(ses.AwsSession)
+}
+
 func (ses *Session) getKubeConfig(cluster *eks.Cluster) (*rest.Config, error) {
 	return newKubeConfig(ses.AwsSession, cluster)
 }
@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	protobuf "google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	//spotFallbackSuffix suffix for the on-demand node pool created in place of the spot pool
+	spotFallbackSuffix = "-ondemand"
+
+	//spotFallbackLabel set on the on-demand pool, holds the spot pool name it replaced
+	spotFallbackLabel = "spot-fallback-for"
+
+	spotFallbackReason = "SpotCapacityFallback"
+
+	defaultSpotFallbackTimeout = 15
+
+	//spotDeleteTimeout spot nodegroup which cannot get capacity may stay in creating state for a while before it fails
+	spotDeleteTimeout = time.Minute * 30
+)
+
+//capacityFailures markers in AsgInstanceLaunchFailures issue message, which tells spot capacity was not available
+var capacityFailures = []string{
+	"InsufficientInstanceCapacity",
+	"UnfulfillableCapacity",
+	"MaxSpotInstanceCountExceeded",
+	"SpotMaxPriceTooLow",
+	"capacity-not-available",
+}
+
+func spotFallbackTimeout(policy *proto.SpotFallback) time.Duration {
+	t := policy.TimeoutMinutes
+	if t <= 0 {
+		t = defaultSpotFallbackTimeout
+	}
+	return time.Minute * time.Duration(t)
+}
+
+//hasCapacityIssue check whether nodegroup health reports instances could not be launched for lack of capacity
+func hasCapacityIssue(health *eks.NodegroupHealth) (bool, string) {
+	if health == nil {
+		return false, ""
+	}
+
+	for _, is := range health.Issues {
+		code := aws.StringValue(is.Code)
+		msg := aws.StringValue(is.Message)
+		if code == eks.NodegroupIssueCodeInstanceLimitExceeded {
+			return true, msg
+		}
+		if code != eks.NodegroupIssueCodeAsgInstanceLaunchFailures {
+			continue
+		}
+		for _, f := range capacityFailures {
+			if strings.Contains(msg, f) {
+				return true, msg
+			}
+		}
+	}
+	return false, ""
+}
+
+//onDemandSpec node spec for the on-demand pool replacing the spot pool.
+//nodeLabelSelector is kept same as spot pool, so workloads targeting the spot pool land on the on-demand nodes.
+func onDemandSpec(nodeSpec *proto.NodeSpec) *proto.NodeSpec {
+	spec := protobuf.Clone(nodeSpec).(*proto.NodeSpec)
+	spec.Name = nodeSpec.Name + spotFallbackSuffix
+	spec.CapacityType = proto.CapacityType_ONDEMAND
+	spec.SpotFallback = nil
+
+	if spec.Instance == "" && spec.MachineType == "" && len(spec.SpotInstances) > 0 {
+		spec.Instance = spec.SpotInstances[0]
+	}
+	spec.SpotInstances = nil
+
+	if spec.Labels == nil {
+		spec.Labels = map[string]string{}
+	}
+	spec.Labels[constants.NodeLabelSelectorLabel] = nodeSpec.Name
+	spec.Labels[spotFallbackLabel] = nodeSpec.Name
+	return spec
+}
+
+//watchSpotNodegroup wait for spot node group to become active, on capacity issues or when it does not become active
+//within the fallback timeout, on-demand node group is created with the same spec.
+func (ctrl AWSController) watchSpotNodegroup(session *Session, cluster *eks.Cluster, nodeSpec *proto.NodeSpec, input *eks.CreateNodegroupInput) {
+
+	policy := nodeSpec.SpotFallback
+	clusterName := *cluster.Name
+	pool := nodeSpec.Name
+	client := session.getEksClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), spotFallbackTimeout(policy))
+	defer cancel()
+
+	reason := ""
+	for reason == "" {
+		select {
+		case <-ctx.Done():
+			reason = fmt.Sprintf("spot nodegroup did not become active within %s", spotFallbackTimeout(policy))
+			continue
+		case <-time.After(updatePollInterval):
+		}
+
+		out, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+			ClusterName:   &clusterName,
+			NodegroupName: &pool,
+		})
+		if err != nil {
+			ctrl.logger.Warnw("failed to get spot nodegroup, retrying", "cluster", clusterName, "nodegroup", pool, "error", err)
+			continue
+		}
+		ng := out.Nodegroup
+
+		status := aws.StringValue(ng.Status)
+		capacityIssue, msg := hasCapacityIssue(ng.Health)
+		switch {
+		case capacityIssue:
+			reason = fmt.Sprintf("spot capacity not available: %s", msg)
+		case status == eks.NodegroupStatusActive:
+			ctrl.logger.Infow("spot nodegroup is active, fallback not required", "cluster", clusterName, "nodegroup", pool)
+			return
+		case status == eks.NodegroupStatusCreateFailed || status == eks.NodegroupStatusDeleting:
+			//failure is not about capacity, on-demand pool would fail the same way
+			ctrl.logger.Errorw("spot nodegroup failed, not falling back to on-demand", "cluster", clusterName, "nodegroup", pool, "status", status)
+			return
+		}
+	}
+
+	ctrl.fallbackToOnDemand(session, cluster, nodeSpec, input, reason)
+}
+
+//waitForNodegroupSettled wait until the nodegroup is done creating or updating, EKS rejects deletion until then
+func waitForNodegroupSettled(ctx context.Context, client *eks.EKS, clusterName, nodegroup string) error {
+	for {
+		out, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+			ClusterName:   &clusterName,
+			NodegroupName: &nodegroup,
+		})
+		if err != nil {
+			return err
+		}
+
+		status := aws.StringValue(out.Nodegroup.Status)
+		if status != eks.NodegroupStatusCreating && status != eks.NodegroupStatusUpdating {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "nodegroup '%s' still %s", nodegroup, status)
+		case <-time.After(updatePollInterval):
+		}
+	}
+}
+
+//deleteSpotNodegroup delete the spot nodegroup replaced by on-demand one once it settles
+func (ctrl AWSController) deleteSpotNodegroup(session *Session, clusterName, pool string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), spotDeleteTimeout)
+	defer cancel()
+
+	if err := waitForNodegroupSettled(ctx, session.getEksClient(), clusterName, pool); err != nil {
+		return err
+	}
+	return ctrl.deleteNode(ctx, session, clusterName, pool)
+}
+
+//fallbackToOnDemand create on-demand nodegroup in place of spot one and report it as node pool event on cluster
+func (ctrl AWSController) fallbackToOnDemand(session *Session, cluster *eks.Cluster, nodeSpec *proto.NodeSpec, input *eks.CreateNodegroupInput, reason string) {
+
+	clusterName := *cluster.Name
+	pool := nodeSpec.Name
+	client := session.getEksClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+
+	ctrl.logger.Warnw("falling back to on-demand nodegroup", "cluster", clusterName, "nodegroup", pool, "reason", reason)
+
+	spec := onDemandSpec(nodeSpec)
+	odInput, err := ctrl.buildNodegroupInput(ctx, session, cluster.Name, spec, input.Subnets, input.NodeRole)
+	if err != nil {
+		ctrl.logger.Errorw("failed to build on-demand nodegroup request", "cluster", clusterName, "nodegroup", spec.Name, "error", err)
+		return
+	}
+
+	_, err = client.CreateNodegroupWithContext(ctx, odInput)
+	if err != nil {
+		ctrl.logger.Errorw("failed to create on-demand nodegroup", "cluster", clusterName, "nodegroup", spec.Name, "error", err)
+		if lterr := ctrl.rollbackLaunchTemplate(ctx, session, clusterName, odInput.LaunchTemplate); lterr != nil {
+			ctrl.logger.Errorw("failed to delete launch template of the nodegroup", "nodegroup", spec.Name, "error", lterr)
+		}
+		return
+	}
+
+	message := fmt.Sprintf("spot nodegroup '%s' replaced by on-demand nodegroup '%s': %s", pool, spec.Name, reason)
+	if nodeSpec.SpotFallback.DeleteSpotPool {
+		if err = ctrl.deleteSpotNodegroup(session, clusterName, pool); err != nil {
+			ctrl.logger.Errorw("failed to delete spot nodegroup", "cluster", clusterName, "nodegroup", pool, "error", err)
+		} else {
+			message = message + ", spot nodegroup deleted"
+		}
+	}
+
+	//waiting on spot nodegroup may outlive ctx, event is recorded with its own deadline
+	ctx, cancel = context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+
+	k8s, err := session.getK8sClient(cluster)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get kubernetes client, spot fallback event not recorded", "cluster", clusterName, "error", err)
+		return
+	}
+
+	if err = kube.RecordNodePoolEvent(ctx, k8s, pool, corev1.EventTypeWarning, spotFallbackReason, message); err != nil {
+		ctrl.logger.Errorw("failed to record spot fallback event", "cluster", clusterName, "nodegroup", pool, "error", err)
+		return
+	}
+	ctrl.logger.Infow(message, "cluster", clusterName)
+}
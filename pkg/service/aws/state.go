@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//describeNodegroups all node groups of the cluster keyed by name
+func describeNodegroups(ctx context.Context, client *eks.EKS, clusterName string) (map[string]*eks.Nodegroup, error) {
+	names := []*string{}
+	err := client.ListNodegroupsPagesWithContext(ctx, &eks.ListNodegroupsInput{ClusterName: &clusterName}, func(page *eks.ListNodegroupsOutput, last bool) bool {
+		names = append(names, page.Nodegroups...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nodegroups := map[string]*eks.Nodegroup{}
+	for _, name := range names {
+		out, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+			ClusterName:   &clusterName,
+			NodegroupName: name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		nodegroups[*name] = out.Nodegroup
+	}
+	return nodegroups, nil
+}
+
+//nodegroupState provider neutral state of the node group, active node group scaled to zero is stopped
+func nodegroupState(ng *eks.Nodegroup) proto.ClusterState {
+	switch aws.StringValue(ng.Status) {
+	case eks.NodegroupStatusCreating:
+		return proto.ClusterState_PROVISIONING
+	case eks.NodegroupStatusUpdating:
+		return proto.ClusterState_UPDATING
+	case eks.NodegroupStatusDeleting:
+		return proto.ClusterState_DELETING
+	case eks.NodegroupStatusCreateFailed, eks.NodegroupStatusDeleteFailed:
+		return proto.ClusterState_FAILED
+	case eks.NodegroupStatusDegraded:
+		return proto.ClusterState_DEGRADED
+	case eks.NodegroupStatusActive:
+		if ng.ScalingConfig != nil && aws.Int64Value(ng.ScalingConfig.DesiredSize) == 0 {
+			return proto.ClusterState_STOPPED
+		}
+		return proto.ClusterState_RUNNING
+	}
+	return proto.ClusterState_STATE_UNKNOWN
+}
+
+//clusterStateWithoutNodegroups state of the cluster when its node groups could not be read, active cluster may be
+//degraded or stopped so its state is unknown
+func clusterStateWithoutNodegroups(cluster *eks.Cluster) proto.ClusterState {
+	if aws.StringValue(cluster.Status) == eks.ClusterStatusActive {
+		return proto.ClusterState_STATE_UNKNOWN
+	}
+	return clusterState(cluster, nil)
+}
+
+//clusterState provider neutral state of the cluster, active cluster is degraded when any node group failed
+//and stopped when all of them are
+func clusterState(cluster *eks.Cluster, nodegroups map[string]*eks.Nodegroup) proto.ClusterState {
+	switch aws.StringValue(cluster.Status) {
+	case eks.ClusterStatusCreating, eks.ClusterStatusPending:
+		return proto.ClusterState_PROVISIONING
+	case eks.ClusterStatusUpdating:
+		return proto.ClusterState_UPDATING
+	case eks.ClusterStatusDeleting:
+		return proto.ClusterState_DELETING
+	case eks.ClusterStatusFailed:
+		return proto.ClusterState_FAILED
+	case eks.ClusterStatusActive:
+	default:
+		return proto.ClusterState_STATE_UNKNOWN
+	}
+
+	stopped := 0
+	for _, ng := range nodegroups {
+		switch nodegroupState(ng) {
+		case proto.ClusterState_DEGRADED, proto.ClusterState_FAILED:
+			return proto.ClusterState_DEGRADED
+		case proto.ClusterState_STOPPED:
+			stopped++
+		}
+	}
+	if len(nodegroups) > 0 && stopped == len(nodegroups) {
+		return proto.ClusterState_STOPPED
+	}
+	return proto.ClusterState_RUNNING
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+func nodegroup(status string, desired int64) *eks.Nodegroup {
+	return &eks.Nodegroup{
+		Status:        aws.String(status),
+		ScalingConfig: &eks.NodegroupScalingConfig{DesiredSize: aws.Int64(desired)},
+	}
+}
+
+func Test_nodegroupState(t *testing.T) {
+	tests := []struct {
+		name string
+		ng   *eks.Nodegroup
+		want proto.ClusterState
+	}{
+		{"creating", nodegroup(eks.NodegroupStatusCreating, 1), proto.ClusterState_PROVISIONING},
+		{"updating", nodegroup(eks.NodegroupStatusUpdating, 1), proto.ClusterState_UPDATING},
+		{"deleting", nodegroup(eks.NodegroupStatusDeleting, 1), proto.ClusterState_DELETING},
+		{"create failed", nodegroup(eks.NodegroupStatusCreateFailed, 1), proto.ClusterState_FAILED},
+		{"delete failed", nodegroup(eks.NodegroupStatusDeleteFailed, 1), proto.ClusterState_FAILED},
+		{"degraded", nodegroup(eks.NodegroupStatusDegraded, 1), proto.ClusterState_DEGRADED},
+		{"active", nodegroup(eks.NodegroupStatusActive, 1), proto.ClusterState_RUNNING},
+		{"active scaled to zero", nodegroup(eks.NodegroupStatusActive, 0), proto.ClusterState_STOPPED},
+		{"active without scaling config", &eks.Nodegroup{Status: aws.String(eks.NodegroupStatusActive)}, proto.ClusterState_RUNNING},
+		{"unknown status", nodegroup("SOMETHING_NEW", 1), proto.ClusterState_STATE_UNKNOWN},
+		{"no status", &eks.Nodegroup{}, proto.ClusterState_STATE_UNKNOWN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, nodegroupState(tt.ng))
+		})
+	}
+}
+
+func Test_clusterState(t *testing.T) {
+	running := map[string]*eks.Nodegroup{"a": nodegroup(eks.NodegroupStatusActive, 1)}
+	tests := []struct {
+		name       string
+		status     string
+		nodegroups map[string]*eks.Nodegroup
+		want       proto.ClusterState
+	}{
+		{"creating", eks.ClusterStatusCreating, running, proto.ClusterState_PROVISIONING},
+		{"pending", eks.ClusterStatusPending, running, proto.ClusterState_PROVISIONING},
+		{"updating", eks.ClusterStatusUpdating, running, proto.ClusterState_UPDATING},
+		{"deleting", eks.ClusterStatusDeleting, running, proto.ClusterState_DELETING},
+		{"failed", eks.ClusterStatusFailed, running, proto.ClusterState_FAILED},
+		{"unknown status", "SOMETHING_NEW", running, proto.ClusterState_STATE_UNKNOWN},
+		{"active", eks.ClusterStatusActive, running, proto.ClusterState_RUNNING},
+		{"active without node groups", eks.ClusterStatusActive, nil, proto.ClusterState_RUNNING},
+		{"active with failed node group", eks.ClusterStatusActive, map[string]*eks.Nodegroup{
+			"a": nodegroup(eks.NodegroupStatusActive, 1),
+			"b": nodegroup(eks.NodegroupStatusCreateFailed, 1),
+		}, proto.ClusterState_DEGRADED},
+		{"active with degraded node group", eks.ClusterStatusActive, map[string]*eks.Nodegroup{
+			"a": nodegroup(eks.NodegroupStatusDegraded, 1),
+		}, proto.ClusterState_DEGRADED},
+		{"active with all node groups stopped", eks.ClusterStatusActive, map[string]*eks.Nodegroup{
+			"a": nodegroup(eks.NodegroupStatusActive, 0),
+			"b": nodegroup(eks.NodegroupStatusActive, 0),
+		}, proto.ClusterState_STOPPED},
+		{"active with some node groups stopped", eks.ClusterStatusActive, map[string]*eks.Nodegroup{
+			"a": nodegroup(eks.NodegroupStatusActive, 0),
+			"b": nodegroup(eks.NodegroupStatusActive, 2),
+		}, proto.ClusterState_RUNNING},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &eks.Cluster{Status: aws.String(tt.status)}
+			assert.Equal(t, tt.want, clusterState(cluster, tt.nodegroups))
+		})
+	}
+}
+
+func Test_clusterStateWithoutNodegroups(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   proto.ClusterState
+	}{
+		{"active", eks.ClusterStatusActive, proto.ClusterState_STATE_UNKNOWN},
+		{"creating", eks.ClusterStatusCreating, proto.ClusterState_PROVISIONING},
+		{"deleting", eks.ClusterStatusDeleting, proto.ClusterState_DELETING},
+		{"failed", eks.ClusterStatusFailed, proto.ClusterState_FAILED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clusterStateWithoutNodegroups(&eks.Cluster{Status: aws.String(tt.status)}))
+		})
+	}
+}
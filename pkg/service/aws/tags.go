@@ -2,11 +2,17 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
 )
 
@@ -34,7 +40,186 @@ func asTags(label map[string]string) []*ec2.Tag {
 	return tags
 }
 
-func (a *AWSController) addTagToNodeInstances(ctx context.Context, region, clusterName, accountName, nodegroup string, label map[string]string) error {
+//createTagsBatch resources tagged in one CreateTags call
+const createTagsBatch = 500
+
+//nodegroupResources instances of the nodegroup in every reservation, along with their EBS volumes and network interfaces
+func nodegroupResources(ctx context.Context, client *ec2.EC2, clusterName, nodegroup string) ([]*string, error) {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("tag:eks:cluster-name"),
+				Values: []*string{&clusterName},
+			},
+			{
+				Name:   aws.String("tag:eks:nodegroup-name"),
+				Values: []*string{&nodegroup},
+			},
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: aws.StringSlice([]string{ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning, ec2.InstanceStateNameStopping, ec2.InstanceStateNameStopped}),
+			},
+		},
+	}
+
+	rids := []*string{}
+	err := client.DescribeInstancesPagesWithContext(ctx, input, func(page *ec2.DescribeInstancesOutput, last bool) bool {
+		for _, r := range page.Reservations {
+			for _, inst := range r.Instances {
+				rids = append(rids, inst.InstanceId)
+				for _, bd := range inst.BlockDeviceMappings {
+					if bd.Ebs != nil && bd.Ebs.VolumeId != nil {
+						rids = append(rids, bd.Ebs.VolumeId)
+					}
+				}
+				for _, ni := range inst.NetworkInterfaces {
+					rids = append(rids, ni.NetworkInterfaceId)
+				}
+			}
+		}
+		return true
+	})
+	return rids, err
+}
+
+//tagAutoScalingGroups tags propagated to the instances the node group launches later
+func tagAutoScalingGroups(ctx context.Context, client *autoscaling.AutoScaling, ng *eks.Nodegroup, tags []*ec2.Tag) error {
+	if ng.Resources == nil {
+		return nil
+	}
+
+	for _, asg := range ng.Resources.AutoScalingGroups {
+		asgTags := make([]*autoscaling.Tag, 0, len(tags))
+		for _, t := range tags {
+			asgTags = append(asgTags, &autoscaling.Tag{
+				Key:               t.Key,
+				Value:             t.Value,
+				PropagateAtLaunch: aws.Bool(true),
+				ResourceId:        asg.Name,
+				ResourceType:      aws.String("auto-scaling-group"),
+			})
+		}
+		_, err := client.CreateOrUpdateTagsWithContext(ctx, &autoscaling.CreateOrUpdateTagsInput{Tags: asgTags})
+		if err != nil {
+			return errors.Wrapf(err, "failed to tag auto scaling group '%s'", aws.StringValue(asg.Name))
+		}
+	}
+	return nil
+}
+
+//launchTagResources resources the launch template tags at launch
+var launchTagResources = []string{ec2.ResourceTypeInstance, ec2.ResourceTypeVolume, ec2.ResourceTypeNetworkInterface}
+
+//mergeTagSpecifications launch template tag specifications with the tags added to instances, volumes and network
+//interfaces, changed is false when the template has them already
+func mergeTagSpecifications(specs []*ec2.LaunchTemplateTagSpecification, tags []*ec2.Tag) ([]*ec2.LaunchTemplateTagSpecificationRequest, bool) {
+	byType := map[string]map[string]*string{}
+	ret := []*ec2.LaunchTemplateTagSpecificationRequest{}
+	for _, s := range specs {
+		rt := aws.StringValue(s.ResourceType)
+		byType[rt] = map[string]*string{}
+		for _, t := range s.Tags {
+			byType[rt][aws.StringValue(t.Key)] = t.Value
+		}
+		ret = append(ret, &ec2.LaunchTemplateTagSpecificationRequest{ResourceType: s.ResourceType, Tags: s.Tags})
+	}
+
+	changed := false
+	for _, rt := range launchTagResources {
+		current, ok := byType[rt]
+		if !ok {
+			current = map[string]*string{}
+			byType[rt] = current
+		}
+		for _, t := range tags {
+			if v, ok := current[aws.StringValue(t.Key)]; !ok || aws.StringValue(v) != aws.StringValue(t.Value) {
+				current[aws.StringValue(t.Key)] = t.Value
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return ret, false
+	}
+
+	ret = []*ec2.LaunchTemplateTagSpecificationRequest{}
+	for rt, current := range byType {
+		ret = append(ret, &ec2.LaunchTemplateTagSpecificationRequest{
+			ResourceType: aws.String(rt),
+			Tags:         ec2Tags(current),
+		})
+	}
+	return ret, true
+}
+
+//tagLaunchTemplate add the tags to the spawner launch template of the node group and roll the node group out to the
+//new version, so volumes and network interfaces of later scale ups are tagged as well. EKS replaces every node of the
+//node group during the rollout, so it is done only when the caller opts in. Node groups without spawner launch
+//template are left as is
+func (a *AWSController) tagLaunchTemplate(ctx context.Context, session *Session, clusterName string, ng *eks.Nodegroup, tags []*ec2.Tag) error {
+	lt := ng.LaunchTemplate
+	if lt == nil || lt.Id == nil {
+		a.logger.Warnw("nodegroup has no launch template, volumes and network interfaces of later scale ups are not tagged", "cluster", clusterName, "nodegroup", aws.StringValue(ng.NodegroupName))
+		return nil
+	}
+
+	ec := session.getEC2Client()
+	out, err := ec.DescribeLaunchTemplateVersionsWithContext(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: lt.Id,
+		Versions:         []*string{lt.Version},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get launch template '%s'", aws.StringValue(lt.Id))
+	}
+	if len(out.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("version %s of launch template '%s' not found", aws.StringValue(lt.Version), aws.StringValue(lt.Id))
+	}
+	current := out.LaunchTemplateVersions[0]
+	if !strings.HasPrefix(aws.StringValue(current.LaunchTemplateName), launchTemplatePrefix) {
+		a.logger.Warnw("nodegroup launch template is not created by spawner, not tagging it", "cluster", clusterName, "nodegroup", aws.StringValue(ng.NodegroupName))
+		return nil
+	}
+
+	var existing []*ec2.LaunchTemplateTagSpecification
+	if current.LaunchTemplateData != nil {
+		existing = current.LaunchTemplateData.TagSpecifications
+	}
+	specs, changed := mergeTagSpecifications(existing, tags)
+	if !changed {
+		return nil
+	}
+
+	//new version inherits everything else from the version in use
+	res, err := ec.CreateLaunchTemplateVersionWithContext(ctx, &ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateId:   lt.Id,
+		SourceVersion:      lt.Version,
+		LaunchTemplateData: &ec2.RequestLaunchTemplateData{TagSpecifications: specs},
+		VersionDescription: current.VersionDescription,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create launch template version")
+	}
+	version := strconv.FormatInt(aws.Int64Value(res.LaunchTemplateVersion.VersionNumber), 10)
+
+	_, err = session.getEksClient().UpdateNodegroupVersionWithContext(ctx, &eks.UpdateNodegroupVersionInput{
+		ClusterName:   &clusterName,
+		NodegroupName: ng.NodegroupName,
+		LaunchTemplate: &eks.LaunchTemplateSpecification{
+			Id:      lt.Id,
+			Version: &version,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to roll out version %s of launch template '%s'", version, aws.StringValue(lt.Id))
+	}
+	a.logger.Infow("rolling out tagged launch template", "cluster", clusterName, "nodegroup", aws.StringValue(ng.NodegroupName), "version", version)
+	return nil
+}
+
+//addTagToNodeInstances tag the instances, volumes and network interfaces of the node group. Tags are kept on the
+//node group and its auto scaling groups as well, so instances of later scale ups are tagged at launch. Their volumes
+//and network interfaces are tagged on the next call, or at launch when the launch template is rolled out
+func (a *AWSController) addTagToNodeInstances(ctx context.Context, region, clusterName, accountName, nodegroup string, label map[string]string, rolloutLaunchTemplate bool) error {
 	session, err := NewSession(ctx, region, accountName)
 
 	a.logger.Debugf("fetching cluster status for '%s', region '%s'", clusterName, region)
@@ -49,56 +234,64 @@ func (a *AWSController) addTagToNodeInstances(ctx context.Context, region, clust
 		return errors.Wrap(err, "addTag: ")
 	}
 
-	ec := session.getEC2Client()
-
-	clusterKey := "tag:eks:cluster-name"
-	nodeKey := "tag:eks:nodegroup-name"
-	res, err := ec.DescribeInstances(&ec2.DescribeInstancesInput{
-		Filters: []*ec2.Filter{
-			{
-				Name:   &clusterKey,
-				Values: []*string{&clusterName},
-			},
-			{
-				Name:   &nodeKey,
-				Values: []*string{&nodegroup},
-			},
-		}})
-
+	out, err := eksClient.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroup,
+	})
 	if err != nil {
-		a.logger.Errorw("get instance", "error", err)
-		return err
+		return errors.Wrapf(err, "addTag: failed to get nodegroup '%s'", nodegroup)
 	}
+	ng := out.Nodegroup
 
-	//NOTE: keep an eye out on this field, dono why this is a list.
+	tags := asTags(label)
 
-	if len(res.Reservations) == 0 {
-		a.logger.Infow("no instances in cluster to tag")
-		return errors.New("no instances in cluster to tag")
+	if len(label) > 0 {
+		_, err = eksClient.TagResourceWithContext(ctx, &eks.TagResourceInput{
+			ResourceArn: ng.NodegroupArn,
+			Tags:        aws.StringMap(label),
+		})
+		if err != nil {
+			return errors.Wrap(err, "addTag: failed to tag nodegroup")
+		}
 	}
 
-	rids := []*string{}
-	for _, r := range res.Reservations[0].Instances {
+	if err = tagAutoScalingGroups(ctx, session.getAutoscalingClient(), ng, tags); err != nil {
+		return errors.Wrap(err, "addTag")
+	}
 
-		id := *r.InstanceId
-		rids = append(rids, &id)
+	if rolloutLaunchTemplate {
+		if err = a.tagLaunchTemplate(ctx, session, clusterName, ng, tags); err != nil {
+			return errors.Wrap(err, "addTag")
+		}
 	}
 
-	if len(rids) == 0 {
-		//NOTE: cluster may be still creating and hasnt setup ec2 instance yet, wait for node to be created and then try adding again
-		return errors.New("no instances in cluster to tag")
+	ec := session.getEC2Client()
+	rids, err := nodegroupResources(ctx, ec, clusterName, nodegroup)
+	if err != nil {
+		a.logger.Errorw("get instance", "error", err)
+		return err
 	}
 
-	a.logger.Infow("adding tags to the following resources", "id", rids)
+	if len(rids) == 0 {
+		//NOTE: cluster may be still creating and hasnt setup ec2 instance yet, auto scaling group tags cover the instances once launched
+		a.logger.Infow("no instances in nodegroup to tag", "cluster", clusterName, "nodegroup", nodegroup)
+		return nil
+	}
 
-	tags := asTags(label)
-	_, err = ec.CreateTags(&ec2.CreateTagsInput{
-		Resources: rids,
-		Tags:      tags,
-	})
+	a.logger.Infow("adding tags to the following resources", "id", aws.StringValueSlice(rids))
 
-	if err != nil {
-		return errors.Wrap(err, "addTag")
+	for i := 0; i < len(rids); i += createTagsBatch {
+		end := i + createTagsBatch
+		if end > len(rids) {
+			end = len(rids)
+		}
+		_, err = ec.CreateTagsWithContext(ctx, &ec2.CreateTagsInput{
+			Resources: rids[i:end],
+			Tags:      tags,
+		})
+		if err != nil {
+			return errors.Wrap(err, "addTag")
+		}
 	}
 	return nil
 
@@ -106,7 +299,7 @@ func (a *AWSController) addTagToNodeInstances(ctx context.Context, region, clust
 
 //TagNodeInstance tag underlying ec2 instances of a cluster node
 func (a *AWSController) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
-	err := a.addTagToNodeInstances(ctx, req.Region, req.ClusterName, req.AccountName, req.NodeGroup, req.Labels)
+	err := a.addTagToNodeInstances(ctx, req.Region, req.ClusterName, req.AccountName, req.NodeGroup, req.Labels, req.RolloutLaunchTemplate)
 	if err != nil {
 		a.logger.Errorw("failed to add tag to node instances in a cluster ", "error", err, "clustere", req.ClusterName)
 		return nil, err
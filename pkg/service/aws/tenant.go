@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+//tenantCluster kube config of the cluster, tenants are managed only in clusters spawner of this env scope created
+func (ctrl AWSController) tenantCluster(ctx context.Context, region, account, clusterName string) (*rest.Config, error) {
+	session, err := NewSession(ctx, region, account)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := getClusterSpec(ctx, session.getEksClient(), clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("unable to get cluster, spec", "error", err, "cluster", clusterName, "region", region)
+		return nil, err
+	}
+	if aws.StringValue(cluster.Tags[constants.CreatorLabel]) != constants.SpawnerServiceLabel {
+		return nil, fmt.Errorf("cluster '%s' is not created by spawner", clusterName)
+	}
+	if err = checkClusterScope(cluster); err != nil {
+		return nil, err
+	}
+	if aws.StringValue(cluster.Status) != eks.ClusterStatusActive {
+		return nil, fmt.Errorf("cluster '%s' is %s", clusterName, aws.StringValue(cluster.Status))
+	}
+
+	config, err := session.getKubeConfig(cluster)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get k8s config", "error", err, "cluster", clusterName, "region", region)
+		return nil, err
+	}
+	return config, nil
+}
+
+//CreateTenant namespace of the workspace with its quota, and a kubeconfig limited to it
+func (ctrl AWSController) CreateTenant(ctx context.Context, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error) {
+	if err := kube.ValidateTenant(req); err != nil {
+		return nil, err
+	}
+
+	config, err := ctrl.tenantCluster(ctx, req.Region, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kube.CreateTenant(ctx, config, req)
+	if err != nil {
+		ctrl.logger.Errorw("failed to create tenant", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "error", err)
+		return nil, err
+	}
+	ctrl.logger.Infow("tenant created", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "namespace", resp.Tenant.Namespace)
+	return resp, nil
+}
+
+//DeleteTenant delete the namespaces of the workspace
+func (ctrl AWSController) DeleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error) {
+	config, err := ctrl.tenantCluster(ctx, req.Region, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := kube.DeleteTenant(ctx, client, req.WorkspaceId)
+	if err != nil {
+		ctrl.logger.Errorw("failed to delete tenant", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "error", err)
+		return nil, err
+	}
+	ctrl.logger.Infow("tenant deleted", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "namespaces", namespaces)
+	return &proto.DeleteTenantResponse{Namespaces: namespaces}, nil
+}
+
+//ListTenants tenant namespaces of the cluster
+func (ctrl AWSController) ListTenants(ctx context.Context, req *proto.ListTenantsRequest) (*proto.ListTenantsResponse, error) {
+	config, err := ctrl.tenantCluster(ctx, req.Region, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants, err := kube.ListTenants(ctx, client)
+	if err != nil {
+		ctrl.logger.Errorw("failed to list tenants", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+	return &proto.ListTenantsResponse{Tenants: tenants}, nil
+}
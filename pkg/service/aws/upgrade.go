@@ -0,0 +1,418 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//kube-proxy add-on is published for every supported EKS version, we use its compatibility list to find out supported cluster versions
+const versionDiscoveryAddon = "kube-proxy"
+
+//updatePollInterval interval between eks update status checks
+const updatePollInterval = time.Second * 30
+
+//ListKubernetesVersions list the kubernetes versions supported by EKS in the region
+func (ctrl AWSController) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	found := map[string]struct{}{}
+	err = client.DescribeAddonVersionsPagesWithContext(ctx, &eks.DescribeAddonVersionsInput{
+		AddonName: aws.String(versionDiscoveryAddon),
+	}, func(page *eks.DescribeAddonVersionsOutput, lastPage bool) bool {
+		for _, addon := range page.Addons {
+			for _, av := range addon.AddonVersions {
+				for _, c := range av.Compatibilities {
+					if c.ClusterVersion != nil {
+						found[*c.ClusterVersion] = struct{}{}
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	if err != nil {
+		ctrl.logger.Errorw("failed to list kubernetes versions", "error", err, "region", req.Region)
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(found))
+	for v := range found {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return common.CompareVersion(versions[i], versions[j]) > 0
+	})
+
+	//EKS does not advertise the default version, it is left empty
+	return &proto.ListKubernetesVersionsResponse{
+		Versions: versions,
+	}, nil
+}
+
+func updateStatus(status string) string {
+	switch status {
+	case eks.UpdateStatusInProgress:
+		return constants.UpgradeInProgress
+	case eks.UpdateStatusSuccessful:
+		return constants.UpgradeSuccessful
+	case eks.UpdateStatusFailed, eks.UpdateStatusCancelled:
+		return constants.UpgradeFailed
+	}
+	return constants.UpgradePending
+}
+
+func updateErrors(update *eks.Update) string {
+	msgs := make([]string, 0, len(update.Errors))
+	for _, e := range update.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", aws.StringValue(e.ErrorCode), aws.StringValue(e.ErrorMessage)))
+	}
+	return strings.Join(msgs, ", ")
+}
+
+//upgradeStep build the upgrade step from eks update, update can be nil when resource was never upgraded
+func upgradeStep(resource, resourceType, currentVersion string, update *eks.Update) *proto.UpgradeStep {
+	step := &proto.UpgradeStep{
+		Resource:       resource,
+		Type:           resourceType,
+		CurrentVersion: currentVersion,
+		TargetVersion:  currentVersion,
+		Status:         constants.UpgradeSuccessful,
+	}
+
+	if update == nil {
+		return step
+	}
+
+	step.UpdateId = aws.StringValue(update.Id)
+	step.Status = updateStatus(aws.StringValue(update.Status))
+	step.Error = updateErrors(update)
+	for _, p := range update.Params {
+		if aws.StringValue(p.Type) == eks.UpdateParamTypeVersion {
+			step.TargetVersion = aws.StringValue(p.Value)
+		}
+	}
+	return step
+}
+
+//waitForUpdate poll the update until it completes, returns error when update fails or gets cancelled
+func waitForUpdate(ctx context.Context, client *eks.EKS, clusterName string, nodegroup *string, updateId *string) error {
+	ticker := time.NewTicker(updatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		out, err := client.DescribeUpdateWithContext(ctx, &eks.DescribeUpdateInput{
+			Name:          &clusterName,
+			NodegroupName: nodegroup,
+			UpdateId:      updateId,
+		})
+		if err != nil {
+			return errors.Wrap(err, "waitForUpdate")
+		}
+
+		switch aws.StringValue(out.Update.Status) {
+		case eks.UpdateStatusSuccessful:
+			return nil
+		case eks.UpdateStatusFailed, eks.UpdateStatusCancelled:
+			return fmt.Errorf("update '%s' %s: %s", *updateId, *out.Update.Status, updateErrors(out.Update))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//latestVersionUpdate find the most recent version update of the cluster or nodegroup, nil if there is none
+func latestVersionUpdate(ctx context.Context, client *eks.EKS, clusterName string, nodegroup *string) (*eks.Update, error) {
+	res, err := client.ListUpdatesWithContext(ctx, &eks.ListUpdatesInput{
+		Name:          &clusterName,
+		NodegroupName: nodegroup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *eks.Update
+	for _, id := range res.UpdateIds {
+		out, err := client.DescribeUpdateWithContext(ctx, &eks.DescribeUpdateInput{
+			Name:          &clusterName,
+			NodegroupName: nodegroup,
+			UpdateId:      id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		u := out.Update
+		if aws.StringValue(u.Type) != eks.UpdateTypeVersionUpdate {
+			continue
+		}
+		if latest == nil || u.CreatedAt.After(*latest.CreatedAt) {
+			latest = u
+		}
+	}
+	return latest, nil
+}
+
+//upgradeNodegroup upgrade single nodegroup to the given version and wait for it complete, no op when already at version
+func (ctrl AWSController) upgradeNodegroup(ctx context.Context, client *eks.EKS, clusterName, nodegroup, version string) error {
+	ng, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroup,
+	})
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(ng.Nodegroup.Version) == version {
+		ctrl.logger.Infow("nodegroup already at requested version", "nodegroup", nodegroup, "version", version)
+		return nil
+	}
+
+	out, err := client.UpdateNodegroupVersionWithContext(ctx, &eks.UpdateNodegroupVersionInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodegroup,
+		Version:       &version,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctrl.logger.Infow("upgrading nodegroup", "cluster", clusterName, "nodegroup", nodegroup, "version", version, "update-id", *out.Update.Id)
+	return waitForUpdate(ctx, client, clusterName, &nodegroup, out.Update.Id)
+}
+
+//upgradeNodegroupsInBackground wait on control plane upgrade and then upgrade nodegroups one at a time.
+//
+// This runs detached from the request context, progress is tracked through GetUpgradeStatus which reads it from EKS.
+func (ctrl AWSController) upgradeNodegroupsInBackground(client *eks.EKS, clusterName string, clusterUpdateId *string, nodegroups []string, version string) {
+	ctx, cancel := context.WithTimeout(context.Background(), common.UpgradeTimeout())
+	defer cancel()
+
+	if clusterUpdateId != nil {
+		ctrl.logger.Infow("waiting on control plane upgrade", "cluster", clusterName, "update-id", *clusterUpdateId)
+		if err := waitForUpdate(ctx, client, clusterName, nil, clusterUpdateId); err != nil {
+			ctrl.logger.Errorw("control plane upgrade failed, skipping nodegroups upgrade", "cluster", clusterName, "error", err)
+			return
+		}
+		ctrl.logger.Infow("control plane upgraded", "cluster", clusterName, "version", version)
+	}
+
+	for _, ng := range nodegroups {
+		if err := ctrl.upgradeNodegroup(ctx, client, clusterName, ng, version); err != nil {
+			//carry on with rest of the nodegroups, failed one can be retried with UpgradeNodePool
+			ctrl.logger.Errorw("failed to upgrade nodegroup", "cluster", clusterName, "nodegroup", ng, "error", err)
+			continue
+		}
+		ctrl.logger.Infow("nodegroup upgraded", "cluster", clusterName, "nodegroup", ng, "version", version)
+	}
+}
+
+//UpgradeCluster upgrade the control plane to given version, once its done, upgrade all nodegroups in the cluster
+func (ctrl AWSController) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+
+	clusterName := req.ClusterName
+	version := req.KubernetesVersion
+
+	if version == "" {
+		return nil, errors.New("kubernetesVersion must be provided")
+	}
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get cluster spec", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	if scope, ok := cluster.Tags[constants.Scope]; !ok || *scope != labels.ScopeTag() {
+		return nil, fmt.Errorf("cluster doesnt not available in '%s'", labels.ScopeTag())
+	}
+
+	if *cluster.Status != eks.ClusterStatusActive {
+		return nil, fmt.Errorf("cluster '%s' must be %s to upgrade, current status '%s'", clusterName, eks.ClusterStatusActive, *cluster.Status)
+	}
+
+	currentVersion := aws.StringValue(cluster.Version)
+	if common.CompareVersion(version, currentVersion) < 0 {
+		return nil, fmt.Errorf("cannot downgrade cluster from '%s' to '%s'", currentVersion, version)
+	}
+
+	clusterStep := &proto.UpgradeStep{
+		Resource:       clusterName,
+		Type:           constants.UpgradeTypeCluster,
+		CurrentVersion: currentVersion,
+		TargetVersion:  version,
+		Status:         constants.UpgradeSuccessful,
+	}
+
+	var clusterUpdateId *string
+	if currentVersion != version {
+		out, err := client.UpdateClusterVersionWithContext(ctx, &eks.UpdateClusterVersionInput{
+			Name:    &clusterName,
+			Version: &version,
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to upgrade cluster", "error", err, "cluster", clusterName, "version", version)
+			return nil, err
+		}
+		clusterUpdateId = out.Update.Id
+		clusterStep.UpdateId = *out.Update.Id
+		clusterStep.Status = constants.UpgradeInProgress
+	}
+
+	steps := []*proto.UpgradeStep{clusterStep}
+	nodegroups := []string{}
+
+	if !req.SkipNodePools {
+		nodeGroupList, err := client.ListNodegroupsWithContext(ctx, &eks.ListNodegroupsInput{ClusterName: &clusterName})
+		if err != nil {
+			ctrl.logger.Errorw("failed to list nodegroups, only control plane will be upgraded", "error", err, "cluster", clusterName)
+		} else {
+			for _, ng := range nodeGroupList.Nodegroups {
+				nodegroups = append(nodegroups, *ng)
+				steps = append(steps, &proto.UpgradeStep{
+					Resource:      *ng,
+					Type:          constants.UpgradeTypeNodePool,
+					TargetVersion: version,
+					Status:        constants.UpgradePending,
+				})
+			}
+		}
+	}
+
+	if clusterUpdateId != nil || len(nodegroups) > 0 {
+		go ctrl.upgradeNodegroupsInBackground(client, clusterName, clusterUpdateId, nodegroups, version)
+	}
+
+	ctrl.logger.Infow("cluster upgrade requested, it might take some time, check GetUpgradeStatus for progress", "cluster", clusterName, "version", version)
+	return &proto.UpgradeClusterResponse{Steps: steps}, nil
+}
+
+//UpgradeNodePool upgrade given nodegroup, defaults to cluster version when version is not specified
+func (ctrl AWSController) UpgradeNodePool(ctx context.Context, req *proto.UpgradeNodePoolRequest) (*proto.UpgradeNodePoolResponse, error) {
+
+	clusterName := req.ClusterName
+	nodeName := req.NodeGroupName
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get cluster spec", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	nodeGroup, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodeName,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("failed to get nodegroup details", "error", err)
+		return nil, err
+	}
+
+	if scope, ok := nodeGroup.Nodegroup.Tags[constants.Scope]; !ok || *scope != labels.ScopeTag() {
+		return nil, fmt.Errorf("nodegroup '%s' not available in scope '%s'", nodeName, labels.ScopeTag())
+	}
+
+	version := req.KubernetesVersion
+	if version == "" {
+		version = aws.StringValue(cluster.Version)
+	}
+
+	if common.CompareVersion(version, aws.StringValue(cluster.Version)) > 0 {
+		return nil, fmt.Errorf("nodegroup version '%s' cannot be newer than control plane version '%s'", version, *cluster.Version)
+	}
+
+	out, err := client.UpdateNodegroupVersionWithContext(ctx, &eks.UpdateNodegroupVersionInput{
+		ClusterName:   &clusterName,
+		NodegroupName: &nodeName,
+		Version:       &version,
+	})
+	if err != nil {
+		ctrl.logger.Errorw("failed to upgrade nodegroup", "error", err, "nodegroup", nodeName, "version", version)
+		return nil, err
+	}
+
+	step := upgradeStep(nodeName, constants.UpgradeTypeNodePool, aws.StringValue(nodeGroup.Nodegroup.Version), out.Update)
+	return &proto.UpgradeNodePoolResponse{Step: step}, nil
+}
+
+//GetUpgradeStatus get the latest version update of the cluster and each of its nodegroups
+func (ctrl AWSController) GetUpgradeStatus(ctx context.Context, req *proto.GetUpgradeStatusRequest) (*proto.GetUpgradeStatusResponse, error) {
+
+	clusterName := req.ClusterName
+
+	session, err := NewSession(ctx, req.Region, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	client := session.getEksClient()
+
+	cluster, err := getClusterSpec(ctx, client, clusterName)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get cluster spec", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	update, err := latestVersionUpdate(ctx, client, clusterName, nil)
+	if err != nil {
+		ctrl.logger.Errorw("failed to get cluster updates", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+	steps := []*proto.UpgradeStep{upgradeStep(clusterName, constants.UpgradeTypeCluster, aws.StringValue(cluster.Version), update)}
+
+	nodeGroupList, err := client.ListNodegroupsWithContext(ctx, &eks.ListNodegroupsInput{ClusterName: &clusterName})
+	if err != nil {
+		ctrl.logger.Errorw("failed to list nodegroups", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	for _, ng := range nodeGroupList.Nodegroups {
+		details, err := client.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+			ClusterName:   &clusterName,
+			NodegroupName: ng,
+		})
+		if err != nil {
+			ctrl.logger.Errorw("failed to get nodegroup details", "error", err, "nodegroup", *ng)
+			continue
+		}
+
+		update, err := latestVersionUpdate(ctx, client, clusterName, ng)
+		if err != nil {
+			ctrl.logger.Errorw("failed to get nodegroup updates", "error", err, "nodegroup", *ng)
+			continue
+		}
+		steps = append(steps, upgradeStep(*ng, constants.UpgradeTypeNodePool, aws.StringValue(details.Nodegroup.Version), update))
+	}
+
+	return &proto.GetUpgradeStatusResponse{Steps: steps}, nil
+}
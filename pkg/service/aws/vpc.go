@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//subnet role tags used by kubernetes to place public and internal load balancers
+const (
+	elbRoleTag         = "kubernetes.io/role/elb"
+	internalElbRoleTag = "kubernetes.io/role/internal-elb"
+)
+
+//isCustomNetwork cluster is placed in user provided VPC instead of region network stack
+func isCustomNetwork(req *proto.ClusterRequest) bool {
+	return req.VpcId != "" || len(req.SubnetIds) > 0
+}
+
+func hasTag(tags []*ec2.Tag, keys ...string) bool {
+	for _, t := range tags {
+		for _, k := range keys {
+			if aws.StringValue(t.Key) == k {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//validateSubnets subnets must exist in the same VPC, span two zones and carry load balancer role tag
+func validateSubnets(ctx context.Context, client *ec2.EC2, vpcId string, subnetIds []string) (string, error) {
+	if len(subnetIds) == 0 {
+		return "", errors.New("subnetIds must be provided with vpcId")
+	}
+
+	res, err := client.DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIds),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get subnets")
+	}
+
+	zones := map[string]struct{}{}
+	untagged := []string{}
+	for _, s := range res.Subnets {
+		subnetVpc := aws.StringValue(s.VpcId)
+		if vpcId == "" {
+			vpcId = subnetVpc
+		}
+		if subnetVpc != vpcId {
+			return "", fmt.Errorf("subnet '%s' is in vpc '%s', expected vpc '%s'", *s.SubnetId, subnetVpc, vpcId)
+		}
+		if aws.StringValue(s.State) != ec2.SubnetStateAvailable {
+			return "", fmt.Errorf("subnet '%s' is not available", *s.SubnetId)
+		}
+		if !hasTag(s.Tags, elbRoleTag, internalElbRoleTag) {
+			untagged = append(untagged, *s.SubnetId)
+		}
+		zones[aws.StringValue(s.AvailabilityZone)] = struct{}{}
+	}
+
+	if len(untagged) > 0 {
+		return "", fmt.Errorf("subnets %s must be tagged with '%s' or '%s'", strings.Join(untagged, ", "), elbRoleTag, internalElbRoleTag)
+	}
+
+	if len(zones) < 2 {
+		return "", errors.New("subnets must span at least two availability zones")
+	}
+	return vpcId, nil
+}
+
+//validateSecurityGroups security groups must exist in the cluster VPC
+func validateSecurityGroups(ctx context.Context, client *ec2.EC2, vpcId string, groupIds []string) error {
+	if len(groupIds) == 0 {
+		return nil
+	}
+
+	res, err := client.DescribeSecurityGroupsWithContext(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(groupIds),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get security groups")
+	}
+
+	for _, g := range res.SecurityGroups {
+		if aws.StringValue(g.VpcId) != vpcId {
+			return fmt.Errorf("security group '%s' is not in vpc '%s'", *g.GroupId, vpcId)
+		}
+	}
+	return nil
+}
+
+//validateVpcDNS private endpoint is resolved through VPC DNS, both dns support and hostnames are required
+func validateVpcDNS(ctx context.Context, client *ec2.EC2, vpcId string) error {
+	for _, attr := range []string{ec2.VpcAttributeNameEnableDnsSupport, ec2.VpcAttributeNameEnableDnsHostnames} {
+		res, err := client.DescribeVpcAttributeWithContext(ctx, &ec2.DescribeVpcAttributeInput{
+			VpcId:     &vpcId,
+			Attribute: aws.String(attr),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get vpc attribute '%s'", attr)
+		}
+
+		enabled := res.EnableDnsSupport
+		if attr == ec2.VpcAttributeNameEnableDnsHostnames {
+			enabled = res.EnableDnsHostnames
+		}
+		if enabled == nil || !aws.BoolValue(enabled.Value) {
+			return fmt.Errorf("vpc '%s' must have '%s' enabled for private endpoint access", vpcId, attr)
+		}
+	}
+	return nil
+}
+
+//enableVpcDNS enable dns support and hostnames on spawner managed VPC
+func enableVpcDNS(ctx context.Context, client *ec2.EC2, vpcId string) error {
+	inputs := []*ec2.ModifyVpcAttributeInput{
+		{VpcId: &vpcId, EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+		{VpcId: &vpcId, EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}},
+	}
+	for _, input := range inputs {
+		if _, err := client.ModifyVpcAttributeWithContext(ctx, input); err != nil {
+			return errors.Wrapf(err, "failed to enable dns on vpc '%s'", vpcId)
+		}
+	}
+	return nil
+}
+
+//endpointAccess public and private access of the API server endpoint
+func endpointAccess(access proto.EndpointAccess) (bool, bool) {
+	switch access {
+	case proto.EndpointAccess_PRIVATE:
+		return false, true
+	case proto.EndpointAccess_PUBLIC_AND_PRIVATE:
+		return true, true
+	}
+	return true, false
+}
+
+//vpcConfig control plane network config, vpcId and subnetIds of region network stack are replaced by user provided network after validating it
+func vpcConfig(ctx context.Context, session *Session, req *proto.ClusterRequest, vpcId string, subnetIds []*string) (*eks.VpcConfigRequest, error) {
+	client := session.getEC2Client()
+	public, private := endpointAccess(req.EndpointAccess)
+
+	if !public && len(req.PublicAccessCidrs) > 0 {
+		return nil, errors.New("publicAccessCidrs cannot be set with private endpoint access")
+	}
+
+	if isCustomNetwork(req) {
+		var err error
+		vpcId, err = validateSubnets(ctx, client, req.VpcId, req.SubnetIds)
+		if err != nil {
+			return nil, err
+		}
+		subnetIds = aws.StringSlice(req.SubnetIds)
+	}
+
+	if err := validateSecurityGroups(ctx, client, vpcId, req.SecurityGroupIds); err != nil {
+		return nil, err
+	}
+
+	if private {
+		dns := validateVpcDNS
+		if !isCustomNetwork(req) {
+			dns = enableVpcDNS
+		}
+		if err := dns(ctx, client, vpcId); err != nil {
+			return nil, err
+		}
+	}
+
+	config := &eks.VpcConfigRequest{
+		SubnetIds:             subnetIds,
+		EndpointPublicAccess:  aws.Bool(public),
+		EndpointPrivateAccess: aws.Bool(private),
+	}
+	if len(req.SecurityGroupIds) > 0 {
+		config.SecurityGroupIds = aws.StringSlice(req.SecurityGroupIds)
+	}
+	if len(req.PublicAccessCidrs) > 0 {
+		config.PublicAccessCidrs = aws.StringSlice(req.PublicAccessCidrs)
+	}
+	return config, nil
+}
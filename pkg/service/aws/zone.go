@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+//validateZones requested zones must be available zones of the session region
+func validateZones(ctx context.Context, session *Session, zones []string) error {
+	if len(zones) == 0 {
+		return nil
+	}
+
+	res, err := session.getEC2Client().DescribeAvailabilityZonesWithContext(ctx, &ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.AvailabilityZoneStateAvailable}),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	available := map[string]struct{}{}
+	for _, z := range res.AvailabilityZones {
+		available[*z.ZoneName] = struct{}{}
+	}
+
+	invalid := []string{}
+	for _, z := range zones {
+		if _, ok := available[z]; !ok {
+			invalid = append(invalid, z)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid availability zones %s for region '%s'", strings.Join(invalid, ", "), session.Region)
+	}
+	return nil
+}
+
+//isPrivateSubnet subnets of private tier or tagged for internal load balancers
+func isPrivateSubnet(subnet *ec2.Subnet) bool {
+	return subnetTier(subnet) == subnetTierPrivate || hasTag(subnet.Tags, internalElbRoleTag)
+}
+
+//nodeSubnets subnets for the worker nodes, private subnets are preferred when the network has them,
+//they are further narrowed down to the requested zones
+func nodeSubnets(ctx context.Context, session *Session, subnetIds []*string, zones []string) ([]*string, error) {
+	if err := validateZones(ctx, session, zones); err != nil {
+		return nil, err
+	}
+
+	res, err := session.getEC2Client().DescribeSubnetsWithContext(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: subnetIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []*ec2.Subnet{}
+	for _, s := range res.Subnets {
+		if isPrivateSubnet(s) {
+			candidates = append(candidates, s)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = res.Subnets
+	}
+
+	if len(zones) == 0 {
+		subnets := []*string{}
+		for _, s := range candidates {
+			subnets = append(subnets, s.SubnetId)
+		}
+		return subnets, nil
+	}
+
+	requested := map[string]bool{}
+	for _, z := range zones {
+		requested[z] = false
+	}
+
+	subnets := []*string{}
+	for _, s := range candidates {
+		az := aws.StringValue(s.AvailabilityZone)
+		if _, ok := requested[az]; ok {
+			requested[az] = true
+			subnets = append(subnets, s.SubnetId)
+		}
+	}
+
+	missing := []string{}
+	for _, z := range zones {
+		if !requested[z] {
+			missing = append(missing, z)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("cluster network has no subnet in availability zones %s", strings.Join(missing, ", "))
+	}
+	return subnets, nil
+}
@@ -0,0 +1,232 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	addonEnabled  = "ACTIVE"
+	addonDisabled = "DISABLED"
+)
+
+//aksAddons add-on profiles supported by AKS
+var aksAddons = []string{
+	"aciConnectorLinux",
+	"azureKeyvaultSecretsProvider",
+	"azurepolicy",
+	"confcom",
+	"httpApplicationRouting",
+	"ingressApplicationGateway",
+	"omsagent",
+	"openServiceMesh",
+}
+
+func isAKSAddon(name string) bool {
+	for _, a := range aksAddons {
+		if a == name {
+			return true
+		}
+	}
+	return false
+}
+
+func toAddon(name string, profile *containerservice.ManagedClusterAddonProfile) *proto.Addon {
+	addon := &proto.Addon{
+		Name:   name,
+		Status: addonDisabled,
+		Config: map[string]string{},
+	}
+	if profile == nil {
+		return addon
+	}
+	if to.Bool(profile.Enabled) {
+		addon.Status = addonEnabled
+	}
+	for k, v := range profile.Config {
+		addon.Config[k] = to.String(v)
+	}
+	return addon
+}
+
+func addonProfile(addon *proto.Addon) *containerservice.ManagedClusterAddonProfile {
+	config := map[string]*string{}
+	for k, v := range addon.Config {
+		config[k] = to.StringPtr(v)
+	}
+	return &containerservice.ManagedClusterAddonProfile{
+		Enabled: to.BoolPtr(true),
+		Config:  config,
+	}
+}
+
+//addonProfiles add-on profiles of the add-ons requested with the cluster
+func addonProfiles(addons []*proto.Addon) (map[string]*containerservice.ManagedClusterAddonProfile, error) {
+	if len(addons) == 0 {
+		return nil, nil
+	}
+
+	profiles := map[string]*containerservice.ManagedClusterAddonProfile{}
+	for _, a := range addons {
+		if !isAKSAddon(a.GetName()) {
+			return nil, fmt.Errorf("add-on '%s' is not supported by AKS", a.GetName())
+		}
+		profiles[a.Name] = addonProfile(a)
+	}
+	return profiles, nil
+}
+
+//updateAddonProfile apply the add-on profile change on the cluster and wait for it to complete
+func (a AzureController) updateAddonProfile(ctx context.Context, accountName, clusterName, name string, update func(*containerservice.ManagedClusterAddonProfile) (*containerservice.ManagedClusterAddonProfile, error)) (*proto.Addon, error) {
+	if !isAKSAddon(name) {
+		return nil, fmt.Errorf("add-on '%s' is not supported by AKS", name)
+	}
+
+	cred, err := a.clusterCredentials(ctx, accountName, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "updateAddonProfile: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+	if !isSpawnerTagged(clstr.Tags) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", clusterName, labels.ScopeTag())
+	}
+
+	if clstr.AddonProfiles == nil {
+		clstr.AddonProfiles = map[string]*containerservice.ManagedClusterAddonProfile{}
+	}
+
+	profile, err := update(clstr.AddonProfiles[name])
+	if err != nil {
+		return nil, err
+	}
+	clstr.AddonProfiles[name] = profile
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/managed-clusters/create-or-update
+	future, err := aksClient.CreateOrUpdate(ctx, cred.ResourceGroup, clusterName, clstr)
+	if err != nil {
+		a.logger.Errorw("failed to update add-on profile", "error", err, "cluster", clusterName, "addon", name)
+		return nil, err
+	}
+
+	if err = future.WaitForCompletionRef(ctx, aksClient.Client); err != nil {
+		a.logger.Errorw("failed to update add-on profile", "error", err, "cluster", clusterName, "addon", name)
+		return nil, err
+	}
+	return toAddon(name, profile), nil
+}
+
+func (a AzureController) listAddons(ctx context.Context, req *proto.ListAddonsRequest) (*proto.ListAddonsResponse, error) {
+	cred, err := a.clusterCredentials(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "listAddons: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, req.ClusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", req.ClusterName)
+		return nil, err
+	}
+
+	resp := &proto.ListAddonsResponse{
+		Addons:    []*proto.Addon{},
+		Available: []*proto.Addon{},
+	}
+
+	for name, profile := range clstr.AddonProfiles {
+		if profile == nil || !to.Bool(profile.Enabled) {
+			continue
+		}
+		resp.Addons = append(resp.Addons, toAddon(name, profile))
+	}
+	sort.Slice(resp.Addons, func(i, j int) bool {
+		return resp.Addons[i].Name < resp.Addons[j].Name
+	})
+
+	for _, name := range aksAddons {
+		if profile, ok := clstr.AddonProfiles[name]; ok && profile != nil && to.Bool(profile.Enabled) {
+			continue
+		}
+		resp.Available = append(resp.Available, &proto.Addon{Name: name, Status: addonDisabled})
+	}
+	return resp, nil
+}
+
+func (a AzureController) installAddon(ctx context.Context, req *proto.InstallAddonRequest) (*proto.InstallAddonResponse, error) {
+	if req.Addon.GetName() == "" {
+		return nil, errors.New("add-on name must be provided")
+	}
+
+	addon, err := a.updateAddonProfile(ctx, req.AccountName, req.ClusterName, req.Addon.Name, func(p *containerservice.ManagedClusterAddonProfile) (*containerservice.ManagedClusterAddonProfile, error) {
+		if p != nil && to.Bool(p.Enabled) {
+			return nil, fmt.Errorf("add-on '%s' is already enabled", req.Addon.Name)
+		}
+		return addonProfile(req.Addon), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Infow("add-on enabled", "cluster", req.ClusterName, "addon", req.Addon.Name)
+	return &proto.InstallAddonResponse{Addon: addon}, nil
+}
+
+func (a AzureController) updateAddon(ctx context.Context, req *proto.UpdateAddonRequest) (*proto.UpdateAddonResponse, error) {
+	if req.Addon.GetName() == "" {
+		return nil, errors.New("add-on name must be provided")
+	}
+
+	addon, err := a.updateAddonProfile(ctx, req.AccountName, req.ClusterName, req.Addon.Name, func(p *containerservice.ManagedClusterAddonProfile) (*containerservice.ManagedClusterAddonProfile, error) {
+		if p == nil || !to.Bool(p.Enabled) {
+			return nil, fmt.Errorf("add-on '%s' is not enabled", req.Addon.Name)
+		}
+		return addonProfile(req.Addon), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Infow("add-on updated", "cluster", req.ClusterName, "addon", req.Addon.Name)
+	return &proto.UpdateAddonResponse{Addon: addon}, nil
+}
+
+//removeAddon AKS add-ons are removed by disabling the profile, preserve is not supported
+func (a AzureController) removeAddon(ctx context.Context, req *proto.RemoveAddonRequest) (*proto.RemoveAddonResponse, error) {
+	if req.Name == "" {
+		return nil, errors.New("add-on name must be provided")
+	}
+
+	_, err := a.updateAddonProfile(ctx, req.AccountName, req.ClusterName, req.Name, func(p *containerservice.ManagedClusterAddonProfile) (*containerservice.ManagedClusterAddonProfile, error) {
+		if p == nil || !to.Bool(p.Enabled) {
+			return nil, fmt.Errorf("add-on '%s' is not enabled", req.Name)
+		}
+		return &containerservice.ManagedClusterAddonProfile{Enabled: to.BoolPtr(false)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Infow("add-on disabled", "cluster", req.ClusterName, "addon", req.Name)
+	return &proto.RemoveAddonResponse{}, nil
+}
@@ -0,0 +1,323 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//maxDataDiskLun luns go up to 63, the number of data disks the VM size allows is enforced by Azure
+const maxDataDiskLun = 63
+
+//scaleSetVM scale set instance the disk is attached to, AKS nodes are scale set instances
+type scaleSetVM struct {
+	group      string
+	scaleSet   string
+	instanceID string
+}
+
+//scaleSetVMOf resource group, scale set and instance id of the scale set instance resource id
+func scaleSetVMOf(id string) (scaleSetVM, error) {
+	parts := strings.Split(strings.TrimSuffix(id, "/"), "/")
+	vm := scaleSetVM{group: resourceGroupOf(id)}
+	for i := 0; i < len(parts)-1; i++ {
+		switch {
+		case strings.EqualFold(parts[i], "virtualMachineScaleSets"):
+			vm.scaleSet = parts[i+1]
+		case strings.EqualFold(parts[i], "virtualMachines"):
+			vm.instanceID = parts[i+1]
+		}
+	}
+	if vm.group == "" || vm.scaleSet == "" || vm.instanceID == "" {
+		return vm, fmt.Errorf("invalid scale set instance id '%s'", id)
+	}
+	return vm, nil
+}
+
+//nodeScaleSetVM scale set instance of the cluster node, kubernetes node name is the computer name of the instance
+func (a *AzureController) nodeScaleSetVM(ctx context.Context, account string, node *proto.NodeReference) (string, error) {
+	if node.GetClusterName() == "" || node.GetNodeName() == "" {
+		return "", errors.New("instanceId or node cluster and name are required")
+	}
+
+	cred, err := a.clusterCredentials(ctx, account, node.ClusterName)
+	if err != nil {
+		return "", err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return "", errors.Wrap(err, "nodeScaleSetVM: cannot get AKS client")
+	}
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, node.ClusterName)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get cluster '%s'", node.ClusterName)
+	}
+	if clstr.ManagedClusterProperties == nil || clstr.NodeResourceGroup == nil {
+		return "", fmt.Errorf("cluster '%s' has no node resource group", node.ClusterName)
+	}
+	nodeGroup := *clstr.NodeResourceGroup
+
+	ssClient, err := getScaleSetsClient(cred)
+	if err != nil {
+		return "", errors.Wrap(err, "nodeScaleSetVM: cannot get scale sets client")
+	}
+	vmClient, err := getScaleSetVMsClient(cred)
+	if err != nil {
+		return "", errors.Wrap(err, "nodeScaleSetVM: cannot get scale set instances client")
+	}
+
+	it, err := ssClient.ListComplete(ctx, nodeGroup)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list scale sets of cluster '%s'", node.ClusterName)
+	}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to list scale sets of cluster '%s'", node.ClusterName)
+		}
+		ss := it.Value()
+		if node.NodeGroup != "" && to.String(ss.Tags[aksPoolNameTag]) != node.NodeGroup {
+			continue
+		}
+
+		vms, err := vmClient.ListComplete(ctx, nodeGroup, to.String(ss.Name), "", "", "")
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to list instances of scale set '%s'", to.String(ss.Name))
+		}
+		for ; vms.NotDone(); err = vms.NextWithContext(ctx) {
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to list instances of scale set '%s'", to.String(ss.Name))
+			}
+			vm := vms.Value()
+			if vm.VirtualMachineScaleSetVMProperties == nil || vm.OsProfile == nil {
+				continue
+			}
+			if strings.EqualFold(to.String(vm.OsProfile.ComputerName), node.NodeName) {
+				return to.String(vm.ID), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("node '%s' not found in cluster '%s'", node.NodeName, node.ClusterName)
+}
+
+//diskAndVM disk and the scale set instance it is attached to or detached from
+func (a *AzureController) diskAndVM(ctx context.Context, cred *system.AzureCredential, account, diskName, instanceID string, node *proto.NodeReference) (compute.Disk, scaleSetVM, compute.VirtualMachineScaleSetVM, error) {
+	var disk compute.Disk
+	var vm compute.VirtualMachineScaleSetVM
+
+	if instanceID == "" {
+		id, err := a.nodeScaleSetVM(ctx, account, node)
+		if err != nil {
+			return disk, scaleSetVM{}, vm, err
+		}
+		instanceID = id
+	}
+	ref, err := scaleSetVMOf(instanceID)
+	if err != nil {
+		return disk, ref, vm, err
+	}
+
+	disksClient, err := getDisksClient(cred)
+	if err != nil {
+		return disk, ref, vm, errors.Wrap(err, "diskAndVM: cannot get disks client")
+	}
+	disk, err = disksClient.Get(ctx, cred.ResourceGroup, diskName)
+	if err != nil {
+		return disk, ref, vm, errors.Wrapf(err, "failed to get disk '%s'", diskName)
+	}
+
+	vmClient, err := getScaleSetVMsClient(cred)
+	if err != nil {
+		return disk, ref, vm, errors.Wrap(err, "diskAndVM: cannot get scale set instances client")
+	}
+	vm, err = vmClient.Get(ctx, ref.group, ref.scaleSet, ref.instanceID, "")
+	if err != nil {
+		return disk, ref, vm, errors.Wrapf(err, "failed to get scale set instance '%s'", instanceID)
+	}
+	if vm.VirtualMachineScaleSetVMProperties == nil || vm.StorageProfile == nil {
+		return disk, ref, vm, fmt.Errorf("scale set instance '%s' has no storage profile", instanceID)
+	}
+	return disk, ref, vm, nil
+}
+
+//dataDiskLun lun of the disk on the instance, -1 when it is not attached
+func dataDiskLun(vm compute.VirtualMachineScaleSetVM, diskID string) int32 {
+	if vm.StorageProfile.DataDisks == nil {
+		return -1
+	}
+	for _, d := range *vm.StorageProfile.DataDisks {
+		if d.ManagedDisk != nil && strings.EqualFold(to.String(d.ManagedDisk.ID), diskID) {
+			return to.Int32(d.Lun)
+		}
+	}
+	return -1
+}
+
+//freeLun lowest lun not used by the data disks of the instance
+func freeLun(vm compute.VirtualMachineScaleSetVM) (int32, error) {
+	used := map[int32]bool{}
+	if vm.VirtualMachineScaleSetVMProperties != nil && vm.StorageProfile != nil && vm.StorageProfile.DataDisks != nil {
+		for _, d := range *vm.StorageProfile.DataDisks {
+			used[to.Int32(d.Lun)] = true
+		}
+	}
+	for lun := int32(0); lun <= maxDataDiskLun; lun++ {
+		if !used[lun] {
+			return lun, nil
+		}
+	}
+	return 0, fmt.Errorf("scale set instance '%s' has no free lun for the disk", to.String(vm.ID))
+}
+
+//checkZone zonal disk can be attached only to an instance of the same zone, regional disk to any instance of the region
+func checkZone(disk compute.Disk, vm compute.VirtualMachineScaleSetVM) error {
+	if !strings.EqualFold(to.String(disk.Location), to.String(vm.Location)) {
+		return fmt.Errorf("disk '%s' is in %s and instance '%s' in %s", to.String(disk.Name), to.String(disk.Location), to.String(vm.ID), to.String(vm.Location))
+	}
+	if disk.Zones == nil || len(*disk.Zones) == 0 {
+		return nil
+	}
+
+	diskZone := (*disk.Zones)[0]
+	vmZone := "no zone"
+	if vm.Zones != nil && len(*vm.Zones) > 0 {
+		vmZone = (*vm.Zones)[0]
+	}
+	if diskZone != vmZone {
+		return fmt.Errorf("disk '%s' is in zone %s and instance '%s' in %s, disk can be attached only to instances of its availability zone",
+			to.String(disk.Name), diskZone, to.String(vm.ID), vmZone)
+	}
+	return nil
+}
+
+//scaleSetVMUpdate body of the instance update with the data disks of vm. Update is a PUT which requires the location,
+//the rest of the model read from the instance has read only and instance view fields the update rejects
+func scaleSetVMUpdate(vm compute.VirtualMachineScaleSetVM) compute.VirtualMachineScaleSetVM {
+	update := compute.VirtualMachineScaleSetVM{
+		Location:                           vm.Location,
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{},
+	}
+	if vm.VirtualMachineScaleSetVMProperties == nil {
+		return update
+	}
+	update.HardwareProfile = vm.HardwareProfile
+	if vm.StorageProfile != nil {
+		update.StorageProfile = &compute.StorageProfile{DataDisks: vm.StorageProfile.DataDisks}
+	}
+	return update
+}
+
+//updateScaleSetVM apply the data disks of the instance and wait until it is updated
+func updateScaleSetVM(ctx context.Context, cred *system.AzureCredential, ref scaleSetVM, vm compute.VirtualMachineScaleSetVM) error {
+	vmClient, err := getScaleSetVMsClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "updateScaleSetVM: cannot get scale set instances client")
+	}
+
+	update := scaleSetVMUpdate(vm)
+	//Doc : https://docs.microsoft.com/en-us/rest/api/compute/virtual-machine-scale-set-vms/update
+	future, err := vmClient.Update(ctx, ref.group, ref.scaleSet, ref.instanceID, update)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update scale set instance '%s'", to.String(vm.ID))
+	}
+	if err = future.WaitForCompletionRef(ctx, vmClient.Client); err != nil {
+		return errors.Wrapf(err, "failed to update scale set instance '%s'", to.String(vm.ID))
+	}
+	return nil
+}
+
+func (a *AzureController) attachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	cred = withResourceGroup(cred, req.ResourceGroup)
+
+	disk, ref, vm, err := a.diskAndVM(ctx, cred, req.AccountName, req.Volumeid, req.InstanceId, req.Node)
+	if err != nil {
+		a.logger.Errorw("failed to get disk and instance", "disk", req.Volumeid, "error", err)
+		return nil, err
+	}
+	vmID := to.String(vm.ID)
+	diskID := to.String(disk.ID)
+
+	if lun := dataDiskLun(vm, diskID); lun >= 0 {
+		return &proto.AttachVolumeResponse{InstanceId: vmID, Lun: lun}, nil
+	}
+	if disk.ManagedBy != nil && *disk.ManagedBy != "" {
+		return nil, fmt.Errorf("disk '%s' is attached to '%s'", req.Volumeid, *disk.ManagedBy)
+	}
+	if err = checkZone(disk, vm); err != nil {
+		return nil, err
+	}
+
+	lun, err := freeLun(vm)
+	if err != nil {
+		return nil, err
+	}
+
+	dataDisks := []compute.DataDisk{}
+	if vm.StorageProfile.DataDisks != nil {
+		dataDisks = *vm.StorageProfile.DataDisks
+	}
+	dataDisks = append(dataDisks, compute.DataDisk{
+		Lun:          to.Int32Ptr(lun),
+		CreateOption: compute.DiskCreateOptionTypesAttach,
+		ManagedDisk:  &compute.ManagedDiskParameters{ID: &diskID},
+	})
+	vm.StorageProfile.DataDisks = &dataDisks
+
+	if err = updateScaleSetVM(ctx, cred, ref, vm); err != nil {
+		a.logger.Errorw("failed to attach disk", "disk", req.Volumeid, "instance", vmID, "error", err)
+		return nil, err
+	}
+	a.logger.Infow("disk attached", "disk", req.Volumeid, "instance", vmID, "lun", lun)
+	return &proto.AttachVolumeResponse{InstanceId: vmID, Lun: lun}, nil
+}
+
+func (a *AzureController) detachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	if req.Force {
+		return nil, errors.New("force detach is not supported for scale set instances")
+	}
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	cred = withResourceGroup(cred, req.ResourceGroup)
+
+	disk, ref, vm, err := a.diskAndVM(ctx, cred, req.AccountName, req.Volumeid, req.InstanceId, req.Node)
+	if err != nil {
+		a.logger.Errorw("failed to get disk and instance", "disk", req.Volumeid, "error", err)
+		return nil, err
+	}
+	vmID := to.String(vm.ID)
+	diskID := to.String(disk.ID)
+
+	if dataDiskLun(vm, diskID) < 0 {
+		a.logger.Infow("disk is not attached to the instance", "disk", req.Volumeid, "instance", vmID)
+		return &proto.DetachVolumeResponse{InstanceId: vmID}, nil
+	}
+
+	dataDisks := []compute.DataDisk{}
+	for _, d := range *vm.StorageProfile.DataDisks {
+		if d.ManagedDisk != nil && strings.EqualFold(to.String(d.ManagedDisk.ID), diskID) {
+			continue
+		}
+		dataDisks = append(dataDisks, d)
+	}
+	vm.StorageProfile.DataDisks = &dataDisks
+
+	if err = updateScaleSetVM(ctx, cred, ref, vm); err != nil {
+		a.logger.Errorw("failed to detach disk", "disk", req.Volumeid, "instance", vmID, "error", err)
+		return nil, err
+	}
+	a.logger.Infow("disk detached", "disk", req.Volumeid, "instance", vmID)
+	return &proto.DetachVolumeResponse{InstanceId: vmID}, nil
+}
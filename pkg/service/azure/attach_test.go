@@ -0,0 +1,176 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_scaleSetVMOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    scaleSetVM
+		wantErr bool
+	}{
+		{
+			"scale set instance",
+			"/subscriptions/sub/resourceGroups/MC_rg_cluster_eastus/providers/Microsoft.Compute/virtualMachineScaleSets/aks-pool-123-vmss/virtualMachines/3",
+			scaleSetVM{group: "MC_rg_cluster_eastus", scaleSet: "aks-pool-123-vmss", instanceID: "3"},
+			false,
+		},
+		{
+			"trailing slash and lower case segments",
+			"/subscriptions/sub/resourcegroups/mc_rg/providers/Microsoft.Compute/virtualmachinescalesets/vmss/virtualmachines/0/",
+			scaleSetVM{group: "mc_rg", scaleSet: "vmss", instanceID: "0"},
+			false,
+		},
+		{
+			"standalone virtual machine",
+			"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm1",
+			scaleSetVM{},
+			true,
+		},
+		{
+			"scale set without instance",
+			"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachineScaleSets/vmss",
+			scaleSetVM{},
+			true,
+		},
+		{"empty", "", scaleSetVM{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scaleSetVMOf(tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func vmWithLuns(luns ...int32) compute.VirtualMachineScaleSetVM {
+	disks := []compute.DataDisk{}
+	for _, l := range luns {
+		disks = append(disks, compute.DataDisk{Lun: to.Int32Ptr(l)})
+	}
+	return compute.VirtualMachineScaleSetVM{
+		ID: to.StringPtr("vm"),
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+			StorageProfile: &compute.StorageProfile{DataDisks: &disks},
+		},
+	}
+}
+
+func Test_freeLun(t *testing.T) {
+	allLuns := []int32{}
+	for l := int32(0); l <= maxDataDiskLun; l++ {
+		allLuns = append(allLuns, l)
+	}
+
+	tests := []struct {
+		name    string
+		vm      compute.VirtualMachineScaleSetVM
+		want    int32
+		wantErr bool
+	}{
+		{"no data disks", vmWithLuns(), 0, false},
+		{"no storage profile", compute.VirtualMachineScaleSetVM{VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{}}, 0, false},
+		{"next lun", vmWithLuns(0, 1), 2, false},
+		{"gap is reused", vmWithLuns(0, 2, 3), 1, false},
+		{"all luns used", vmWithLuns(allLuns...), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := freeLun(tt.vm)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkZone(t *testing.T) {
+	disk := func(location string, zones ...string) compute.Disk {
+		d := compute.Disk{Name: to.StringPtr("disk"), Location: to.StringPtr(location)}
+		if len(zones) > 0 {
+			d.Zones = &zones
+		}
+		return d
+	}
+	vm := func(location string, zones ...string) compute.VirtualMachineScaleSetVM {
+		v := compute.VirtualMachineScaleSetVM{ID: to.StringPtr("vm"), Location: to.StringPtr(location)}
+		if len(zones) > 0 {
+			v.Zones = &zones
+		}
+		return v
+	}
+
+	tests := []struct {
+		name    string
+		disk    compute.Disk
+		vm      compute.VirtualMachineScaleSetVM
+		wantErr bool
+	}{
+		{"regional disk to zonal vm", disk("eastus"), vm("eastus", "1"), false},
+		{"regional disk to zoneless vm", disk("eastus"), vm("eastus"), false},
+		{"zonal disk to vm of same zone", disk("eastus", "2"), vm("eastus", "2"), false},
+		{"zonal disk to vm of other zone", disk("eastus", "1"), vm("eastus", "2"), true},
+		{"zonal disk to zoneless vm", disk("eastus", "1"), vm("eastus"), true},
+		{"location is case insensitive", disk("EastUS"), vm("eastus"), false},
+		{"other region", disk("westus"), vm("eastus"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkZone(tt.disk, tt.vm)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_scaleSetVMUpdate(t *testing.T) {
+	disks := []compute.DataDisk{{Lun: to.Int32Ptr(0), Name: to.StringPtr("data")}}
+	hardware := &compute.HardwareProfile{VMSize: compute.VirtualMachineSizeTypesStandardD2sV3}
+	vm := compute.VirtualMachineScaleSetVM{
+		ID:         to.StringPtr("vm"),
+		Name:       to.StringPtr("vmss_3"),
+		Location:   to.StringPtr("eastus"),
+		InstanceID: to.StringPtr("3"),
+		Zones:      &[]string{"1"},
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+			HardwareProfile:   hardware,
+			ProvisioningState: to.StringPtr("Succeeded"),
+			StorageProfile: &compute.StorageProfile{
+				DataDisks: &disks,
+				OsDisk:    &compute.OSDisk{Name: to.StringPtr("os")},
+			},
+			InstanceView: &compute.VirtualMachineScaleSetVMInstanceView{},
+		},
+	}
+
+	want := compute.VirtualMachineScaleSetVM{
+		Location: to.StringPtr("eastus"),
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+			HardwareProfile: hardware,
+			StorageProfile:  &compute.StorageProfile{DataDisks: &disks},
+		},
+	}
+	assert.Equal(t, want, scaleSetVMUpdate(vm), "location, hardware profile and data disks only")
+
+	assert.Equal(t, compute.VirtualMachineScaleSetVM{
+		Location:                           to.StringPtr("eastus"),
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{},
+	}, scaleSetVMUpdate(compute.VirtualMachineScaleSetVM{Location: to.StringPtr("eastus")}), "instance without properties")
+}
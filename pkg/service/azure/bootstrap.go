@@ -0,0 +1,52 @@
+package azure
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//bootstrapTimeout AKS cluster is created with its system pool, this covers the nodes becoming ready and the charts installed
+const bootstrapTimeout = time.Minute * 60
+
+//bootstrapCluster apply the bootstrap bundle requested with the cluster, cluster creation is already complete
+func (a *AzureController) bootstrapCluster(account, clusterName string, bundle *proto.Bootstrap) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), bootstrapTimeout)
+	defer cancel()
+
+	k8s, err := a.getK8sClient(ctx, account, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get kubernetes client, bootstrap not applied", "cluster", clusterName, "error", err)
+		return
+	}
+	dynamicClient, err := a.getK8sDynamicClient(ctx, account, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get kubernetes dynamic client, bootstrap not applied", "cluster", clusterName, "error", err)
+		return
+	}
+
+	if err = kube.Bootstrap(ctx, k8s, dynamicClient, bundle, a.logger.With("cluster", clusterName)); err != nil {
+		a.logger.Errorw("failed to bootstrap cluster", "cluster", clusterName, "error", err)
+		return
+	}
+	a.logger.Infow("cluster bootstrap completed, check GetBootstrapStatus for result of each step", "cluster", clusterName)
+}
+
+//getBootstrapStatus result of each step of the bootstrap, read from the cluster
+func (a *AzureController) getBootstrapStatus(ctx context.Context, req *proto.GetBootstrapStatusRequest) (*proto.GetBootstrapStatusResponse, error) {
+	k8s, err := a.getK8sClient(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get kubernetes client", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+
+	steps, err := kube.BootstrapStatus(ctx, k8s)
+	if err != nil {
+		a.logger.Errorw("failed to get bootstrap status", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+	return &proto.GetBootstrapStatusResponse{Steps: steps}, nil
+}
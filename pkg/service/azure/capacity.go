@@ -0,0 +1,216 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//totalCoresUsage usage name of total regional vCPUs
+const totalCoresUsage = "cores"
+
+//findSku virtual machine sku of the size in the region, nil if region does not offer it
+func findSku(ctx context.Context, cred *system.AzureCredential, region, size string) (*compute.ResourceSku, error) {
+	client, err := getResourceSkusClient(cred)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := client.ListComplete(ctx, fmt.Sprintf("location eq '%s'", region), "")
+	if err != nil {
+		return nil, err
+	}
+
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		sku := it.Value()
+		if sku.ResourceType != nil && *sku.ResourceType == "virtualMachines" && sku.Name != nil && strings.EqualFold(*sku.Name, size) {
+			return &sku, nil
+		}
+	}
+	return nil, nil
+}
+
+//checkRestrictions sku restricted in the region fails, restricted in some of the zones warns
+func checkRestrictions(sku *compute.ResourceSku, region, size string) *proto.CapacityCheck {
+	if sku == nil {
+		return common.FailedCheck(constants.CheckSKURestriction, fmt.Sprintf("'%s' is not offered in %s", size, region))
+	}
+
+	if sku.Restrictions != nil {
+		for _, r := range *sku.Restrictions {
+			switch r.Type {
+			case compute.ResourceSkuRestrictionsTypeLocation:
+				return common.FailedCheck(constants.CheckSKURestriction, fmt.Sprintf("'%s' is restricted in %s: %s", size, region, r.ReasonCode))
+			case compute.ResourceSkuRestrictionsTypeZone:
+				zones := []string{}
+				if r.RestrictionInfo != nil && r.RestrictionInfo.Zones != nil {
+					zones = *r.RestrictionInfo.Zones
+				}
+				return common.WarningCheck(constants.CheckSKURestriction, fmt.Sprintf("'%s' is restricted in zones %s: %s", size, strings.Join(zones, ", "), r.ReasonCode))
+			}
+		}
+	}
+	return common.PassedCheck(constants.CheckSKURestriction, fmt.Sprintf("'%s' is offered in %s", size, region))
+}
+
+//skuVCPUs vCPUs capability of the sku
+func skuVCPUs(sku *compute.ResourceSku) (int64, error) {
+	if sku.Capabilities != nil {
+		for _, c := range *sku.Capabilities {
+			if c.Name != nil && *c.Name == "vCPUs" && c.Value != nil {
+				return strconv.ParseInt(*c.Value, 10, 64)
+			}
+		}
+	}
+	return 0, fmt.Errorf("vCPUs of '%s' not known", *sku.Name)
+}
+
+//checkQuota required vCPUs must fit in the remaining family and total regional vCPU quota
+func checkQuota(ctx context.Context, cred *system.AzureCredential, sku *compute.ResourceSku, region string, count int32) *proto.CapacityCheck {
+	vcpus, err := skuVCPUs(sku)
+	if err != nil {
+		return common.WarningCheck(constants.CheckVCPUQuota, err.Error())
+	}
+	required := vcpus * int64(count)
+
+	client, err := getUsageClient(cred)
+	if err != nil {
+		return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("could not get usage client: %s", err))
+	}
+
+	it, err := client.ListComplete(ctx, region)
+	if err != nil {
+		return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("could not get vCPU usage: %s", err))
+	}
+
+	family := ""
+	if sku.Family != nil {
+		family = *sku.Family
+	}
+
+	checked := []string{}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("could not get vCPU usage: %s", err))
+		}
+		u := it.Value()
+		if u.Name == nil || u.Name.Value == nil || u.CurrentValue == nil || u.Limit == nil {
+			continue
+		}
+
+		name := *u.Name.Value
+		if name != totalCoresUsage && name != family {
+			continue
+		}
+
+		used := int64(*u.CurrentValue)
+		if used+required > *u.Limit {
+			return common.FailedCheck(constants.CheckVCPUQuota, fmt.Sprintf("requires %d vCPUs, %d of %d '%s' vCPU quota is in use", required, used, *u.Limit, name))
+		}
+		checked = append(checked, fmt.Sprintf("%d of %d '%s'", used, *u.Limit, name))
+	}
+
+	if len(checked) == 0 {
+		return common.WarningCheck(constants.CheckVCPUQuota, fmt.Sprintf("vCPU quota of '%s' is not checked", *sku.Name))
+	}
+	return common.PassedCheck(constants.CheckVCPUQuota, fmt.Sprintf("requires %d vCPUs, %s vCPU quota is in use", required, strings.Join(checked, ", ")))
+}
+
+//nodeInstance vm size and count of the node pool
+func nodeInstance(spec *proto.NodeSpec) (string, int32, error) {
+	count := int32(1)
+	if spec.Count != 0 {
+		count = int32(spec.Count)
+	}
+
+	instance := spec.Instance
+	if spec.MachineType != "" {
+		instance = common.GetInstance(constants.AzureLabel, spec.MachineType)
+	}
+
+	if instance == "" {
+		return "", 0, errors.New(constants.InvalidInstanceOrMachineType)
+	}
+	return instance, count, nil
+}
+
+//preflight check sku availability and vCPU quota in the region for the node spec
+func (a AzureController) preflight(ctx context.Context, cred *system.AzureCredential, region string, spec *proto.NodeSpec) (*proto.CheckCapacityResponse, error) {
+	instance, count, err := nodeInstance(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	sku, err := findSku(ctx, cred, region, instance)
+	if err != nil {
+		a.logger.Warnw("failed to get resource skus, capacity is not checked", "region", region, "error", err)
+		return common.CapacityResponse([]*proto.CapacityCheck{
+			common.WarningCheck(constants.CheckSKURestriction, fmt.Sprintf("could not get resource skus: %s", err)),
+		}), nil
+	}
+
+	checks := []*proto.CapacityCheck{checkRestrictions(sku, region, instance)}
+	if sku != nil {
+		checks = append(checks, checkQuota(ctx, cred, sku, region, count))
+	}
+	return common.CapacityResponse(checks), nil
+}
+
+//ensureCapacity run preflight, rejects the request on failed checks and returns the warnings for the response
+func (a AzureController) ensureCapacity(ctx context.Context, cred *system.AzureCredential, region string, spec *proto.NodeSpec) ([]string, error) {
+	capacity, err := a.preflight(ctx, cred, region, spec)
+	if err != nil {
+		return nil, err
+	}
+	if err = common.CapacityError(capacity); err != nil {
+		a.logger.Errorw("capacity preflight failed", "region", region, "node", spec.Name, "error", err)
+		return nil, err
+	}
+	warnings := common.CapacityWarnings(capacity)
+	for _, w := range warnings {
+		a.logger.Warnw("capacity preflight warning", "region", region, "node", spec.Name, "warning", w)
+	}
+	return warnings, nil
+}
+
+func (a AzureController) checkCapacity(ctx context.Context, req *proto.CheckCapacityRequest) (*proto.CheckCapacityResponse, error) {
+	if req.NodeSpec == nil {
+		return nil, errors.New("nodeSpec must be provided")
+	}
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	region := req.Region
+	if req.ClusterName != "" {
+		cred, err = a.clusterCredentials(ctx, req.AccountName, req.ClusterName)
+		if err != nil {
+			return nil, err
+		}
+		aksClient, err := getAKSClient(cred)
+		if err != nil {
+			return nil, err
+		}
+		clstr, err := aksClient.Get(ctx, cred.ResourceGroup, req.ClusterName)
+		if err != nil {
+			a.logger.Errorw("failed to get cluster", "cluster", req.ClusterName, "error", err)
+			return nil, err
+		}
+		region = *clstr.Location
+	}
+
+	return a.preflight(ctx, cred, region, req.NodeSpec)
+}
@@ -3,9 +3,16 @@ package azure
 import (
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/authorization/mgmt/authorization"
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/msi/mgmt/msi"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	orchestrators "github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2020-09-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2019-11-01/costmanagement"
+	aksPreview "github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-01-02-preview/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure/iam"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
@@ -25,6 +32,19 @@ func getAKSClient(c *system.AzureCredential) (*containerservice.ManagedClustersC
 	return &aksClient, nil
 }
 
+//getContainerServicesClient client for listing orchestrator versions, not available in recent api versions
+func getContainerServicesClient(c *system.AzureCredential) (*orchestrators.ContainerServicesClient, error) {
+
+	csClient := orchestrators.NewContainerServicesClient(c.SubscriptionID)
+	auth, err := iam.GetResourceManagementAuthorizer(c)
+	if err != nil {
+		return nil, err
+	}
+	csClient.Authorizer = auth
+	csClient.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &csClient, nil
+}
+
 func getCostManagementClient(c *system.AzureCredential) (*costmanagement.QueryClient, error) {
 
 	costmgmtClient := costmanagement.NewQueryClient(c.SubscriptionID)
@@ -75,3 +95,162 @@ func getSnapshotClient(c *system.AzureCredential) (*compute.SnapshotsClient, err
 	sc.AddToUserAgent(constants.SpawnerServiceLabel)
 	return &sc, nil
 }
+
+func getResourceSkusClient(c *system.AzureCredential) (*compute.ResourceSkusClient, error) {
+	rc := compute.NewResourceSkusClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	rc.Authorizer = a
+	rc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &rc, nil
+}
+
+func getUsageClient(c *system.AzureCredential) (*compute.UsageClient, error) {
+	uc := compute.NewUsageClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	uc.Authorizer = a
+	uc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &uc, nil
+}
+
+func getVirtualNetworksClient(c *system.AzureCredential) (*network.VirtualNetworksClient, error) {
+	vc := network.NewVirtualNetworksClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	vc.Authorizer = a
+	vc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &vc, nil
+}
+
+func getSubnetsClient(c *system.AzureCredential) (*network.SubnetsClient, error) {
+	sc := network.NewSubnetsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	sc.Authorizer = a
+	sc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &sc, nil
+}
+
+//getAKSPreviewClient client for the cluster features not available in stable api versions yet i.e OIDC issuer
+func getAKSPreviewClient(c *system.AzureCredential) (*aksPreview.ManagedClustersClient, error) {
+
+	aksClient := aksPreview.NewManagedClustersClient(c.SubscriptionID)
+	auth, err := iam.GetResourceManagementAuthorizer(c)
+	if err != nil {
+		return nil, err
+	}
+	aksClient.Authorizer = auth
+	aksClient.AddToUserAgent(constants.SpawnerServiceLabel)
+	aksClient.PollingDuration = time.Hour * 1
+	return &aksClient, nil
+}
+
+func getUserAssignedIdentitiesClient(c *system.AzureCredential) (*msi.UserAssignedIdentitiesClient, error) {
+	ic := msi.NewUserAssignedIdentitiesClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	ic.Authorizer = a
+	ic.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &ic, nil
+}
+
+func getRoleAssignmentsClient(c *system.AzureCredential) (*authorization.RoleAssignmentsClient, error) {
+	rc := authorization.NewRoleAssignmentsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	rc.Authorizer = a
+	rc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &rc, nil
+}
+
+//getDiagnosticSettingsClient diagnostic settings are not part of the latest monitor profile
+func getDiagnosticSettingsClient(c *system.AzureCredential) (*insights.DiagnosticSettingsClient, error) {
+	dc := insights.NewDiagnosticSettingsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	dc.Authorizer = a
+	dc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &dc, nil
+}
+
+func getGroupsClient(c *system.AzureCredential) (*resources.GroupsClient, error) {
+	gc := resources.NewGroupsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	gc.Authorizer = a
+	gc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &gc, nil
+}
+
+func getResourcesClient(c *system.AzureCredential) (*resources.Client, error) {
+	rc := resources.NewClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	rc.Authorizer = a
+	rc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &rc, nil
+}
+
+func getTagsClient(c *system.AzureCredential) (*resources.TagsClient, error) {
+	tc := resources.NewTagsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	tc.Authorizer = a
+	tc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &tc, nil
+}
+
+func getScaleSetsClient(c *system.AzureCredential) (*compute.VirtualMachineScaleSetsClient, error) {
+	sc := compute.NewVirtualMachineScaleSetsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	sc.Authorizer = a
+	sc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &sc, nil
+}
+
+func getScaleSetVMsClient(c *system.AzureCredential) (*compute.VirtualMachineScaleSetVMsClient, error) {
+	vc := compute.NewVirtualMachineScaleSetVMsClient(c.SubscriptionID)
+	a, err := iam.GetResourceManagementAuthorizer(c)
+
+	if err != nil {
+		return nil, err
+	}
+	vc.Authorizer = a
+	vc.AddToUserAgent(constants.SpawnerServiceLabel)
+	return &vc, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
@@ -24,13 +25,11 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 	if err != nil {
 		return nil, err
 	}
+	cred = withResourceGroup(cred, req.ResourceGroup)
 
 	groupName := cred.ResourceGroup
 	region := req.Region
 
-	clientID := cred.ClientID
-	clientSecret := cred.ClientSecret
-
 	aksClient, err := getAKSClient(cred)
 	if err != nil {
 		return nil, errors.Wrap(err, "creaetAKSCluster: cannot to get AKS client")
@@ -63,6 +62,64 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 		return nil, errors.New(constants.InvalidInstanceOrMachineType)
 	}
 
+	zones, err := poolZones(ctx, cred, region, req.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	addons, err := addonProfiles(req.Addons)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Logging != nil {
+		if err = validateLogging(req.Logging); err != nil {
+			return nil, err
+		}
+	}
+
+	//KMS plugin needs cluster managed identity
+	if req.Encryption.GetEnabled() && req.Identity.GetType() == proto.ClusterIdentityType_SERVICE_PRINCIPAL {
+		return nil, errors.New("secrets encryption requires managed identity, cluster is requested with service principal")
+	}
+	if req.Encryption.GetEnabled() && req.Encryption.KeyId == "" {
+		return nil, errors.New("key vault keyId is required for secrets encryption")
+	}
+
+	if err = kube.ValidateBootstrap(req.Bootstrap); err != nil {
+		return nil, err
+	}
+	if err = validateNetwork(req.AzureNetwork); err != nil {
+		return nil, err
+	}
+
+	identity, err := a.clusterIdentitySpec(ctx, cred, req.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetID := req.AzureNetwork.GetSubnetId()
+	if subnetID != "" {
+		if err = ensureSubnet(ctx, cred, subnetID); err != nil {
+			return nil, err
+		}
+		//user assigned identity is granted up front, system assigned one once the cluster is created
+		if identity.principalID != "" {
+			if err = a.grantSubnetAccess(ctx, cred, subnetID, identity.principalID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	warnings, err := a.ensureCapacity(ctx, cred, region, req.Node)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.ensureResourceGroup(ctx, cred, region); err != nil {
+		return nil, err
+	}
+
 	mc := containerservice.ManagedCluster{
 		Tags:     tags,
 		Name:     &clusterName,
@@ -71,21 +128,34 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 			DNSPrefix: &clusterName,
 			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
 				{
-					Count:        &count,
-					Name:         to.StringPtr(req.Node.Name),
-					VMSize:       &instance,
-					OsDiskSizeGB: to.Int32Ptr(req.Node.DiskSize),
-					NodeLabels:   nodeTags,
-					Tags:         nodeTags,
-					Mode:         containerservice.AgentPoolModeSystem,
-					//					OrchestratorVersion: &constants.AzureKubeVersion,
+					Count:             &count,
+					Name:              to.StringPtr(req.Node.Name),
+					VMSize:            &instance,
+					OsDiskSizeGB:      to.Int32Ptr(req.Node.DiskSize),
+					NodeLabels:        nodeTags,
+					Tags:              nodeTags,
+					Mode:              containerservice.AgentPoolModeSystem,
+					AvailabilityZones: zones,
 				},
 			},
-			ServicePrincipalProfile: &containerservice.ManagedClusterServicePrincipalProfile{
-				ClientID: to.StringPtr(clientID),
-				Secret:   to.StringPtr(clientSecret),
-			},
+			ServicePrincipalProfile: identity.servicePrincipal,
+			IdentityProfile:         identity.identityProfile,
+			AddonProfiles:           addons,
+			NetworkProfile:          networkProfile(req.AzureNetwork),
+			APIServerAccessProfile:  apiServerAccessProfile(req.AzureNetwork),
 		},
+		Identity: identity.identity,
+	}
+
+	//provider default version is used when not specified
+	if req.KubernetesVersion != "" {
+		mc.KubernetesVersion = &req.KubernetesVersion
+	}
+	if req.Node.KubernetesVersion != "" {
+		(*mc.AgentPoolProfiles)[0].OrchestratorVersion = &req.Node.KubernetesVersion
+	}
+	if subnetID != "" {
+		(*mc.AgentPoolProfiles)[0].VnetSubnetID = &subnetID
 	}
 
 	future, err := aksClient.CreateOrUpdate(
@@ -106,9 +176,39 @@ func (a *AzureController) createCluster(ctx context.Context, req *proto.ClusterR
 		return nil, fmt.Errorf("cannot get the AKS cluster create or update future response: %v", err)
 	}
 
+	if subnetID != "" && identity.identity != nil && identity.principalID == "" {
+		clstr, err := aksClient.Get(ctx, groupName, clusterName)
+		if err != nil || clstr.Identity == nil || clstr.Identity.PrincipalID == nil {
+			a.logger.Errorw("failed to get cluster identity", "cluster", clusterName, "error", err)
+			return nil, fmt.Errorf("cluster '%s' created, identity could not be granted access to subnet '%s'", clusterName, subnetID)
+		}
+		if err = a.grantSubnetAccess(ctx, cred, subnetID, *clstr.Identity.PrincipalID); err != nil {
+			a.logger.Errorw("failed to grant cluster identity access to subnet", "cluster", clusterName, "subnet", subnetID, "error", err)
+			return nil, errors.Wrapf(err, "cluster '%s' created, identity could not be granted access to subnet", clusterName)
+		}
+	}
+
+	if len(req.Logging.GetTypes()) > 0 {
+		if err = a.setLogging(ctx, cred, clusterName, req.Logging); err != nil {
+			//cluster is up, logging can be set again with UpdateClusterConfig
+			a.logger.Errorw("failed to set control plane logging", "cluster", clusterName, "error", err)
+		}
+	}
+
+	if req.Encryption.GetEnabled() {
+		if err = a.setSecretsEncryption(ctx, cred, clusterName, req.Encryption); err != nil {
+			a.logger.Errorw("failed to enable secrets encryption", "cluster", clusterName, "error", err)
+			return nil, errors.Wrapf(err, "cluster '%s' created, secrets encryption not enabled", clusterName)
+		}
+	}
+
+	if req.Bootstrap != nil {
+		go a.bootstrapCluster(req.AccountName, clusterName, req.Bootstrap)
+	}
+
 	//return future.Result(aksClient)
 
-	return &proto.ClusterResponse{ClusterName: clusterName}, nil
+	return &proto.ClusterResponse{ClusterName: clusterName, Warnings: warnings}, nil
 }
 
 func (a *AzureController) getCluster(ctx context.Context, req *proto.GetClusterRequest) (*proto.ClusterSpec, error) {
@@ -116,7 +216,7 @@ func (a *AzureController) getCluster(ctx context.Context, req *proto.GetClusterR
 	clusterName := req.ClusterName
 	account := req.AccountName
 
-	cred, err := getCredentials(ctx, account)
+	cred, err := a.clusterCredentials(ctx, account, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -136,13 +236,17 @@ func (a *AzureController) getCluster(ctx context.Context, req *proto.GetClusterR
 	}
 
 	response := &proto.ClusterSpec{
-		Name: clusterName,
+		Name:              clusterName,
+		KubernetesVersion: to.String(clstr.KubernetesVersion),
+		State:             managedClusterState(clstr),
+		ProviderStatus:    managedClusterStatus(clstr),
 	}
 	var nodeSpecList []*proto.NodeSpec
 
+	health := a.agentPoolsHealth(ctx, account, clusterName, *clstr.AgentPoolProfiles)
 	for _, node := range *clstr.AgentPoolProfiles {
 		state := constants.Inactive
-		if node.PowerState.Code == containerservice.CodeRunning {
+		if node.PowerState != nil && node.PowerState.Code == containerservice.CodeRunning {
 			state = constants.Active
 		}
 
@@ -152,6 +256,15 @@ func (a *AzureController) getCluster(ctx context.Context, req *proto.GetClusterR
 			Labels:   aws.StringValueMap(node.NodeLabels),
 			DiskSize: *node.OsDiskSizeGB,
 			State:    state,
+			Health:   health[*node.Name],
+
+			PoolState:      agentPoolState(node),
+			ProviderStatus: providerStatus(node.ProvisioningState, node.PowerState),
+
+			KubernetesVersion: to.String(node.OrchestratorVersion),
+		}
+		if node.AvailabilityZones != nil {
+			nodeSpec.AvailabilityZones = *node.AvailabilityZones
 		}
 		nodeSpecList = append(nodeSpecList, &nodeSpec)
 	}
@@ -167,7 +280,7 @@ func (a *AzureController) deleteCluster(ctx context.Context, req *proto.ClusterD
 
 	account := req.AccountName
 
-	cred, err := getCredentials(ctx, account)
+	cred, err := a.clusterCredentials(ctx, account, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -178,6 +291,22 @@ func (a *AzureController) deleteCluster(ctx context.Context, req *proto.ClusterD
 	}
 
 	groupName := cred.ResourceGroup
+
+	clstr, err := aksClient.Get(ctx, groupName, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+	if !isSpawnerTagged(clstr.Tags) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", clusterName, labels.ScopeTag())
+	}
+
+	//force delete does not wait for the pods, nodes go away with the cluster
+	if req.Drain && !req.ForceDelete {
+		//AKS deletes the agent pools along with cluster, drain nodes of every spawner node pool first
+		a.drainNodes(ctx, account, clusterName, constants.NodeNameLabel, req.DrainTimeoutSeconds)
+	}
+
 	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/managed-clusters/delete
 	future, err := aksClient.Delete(ctx, groupName, clusterName)
 
@@ -198,6 +327,10 @@ func (a *AzureController) deleteCluster(ctx context.Context, req *proto.ClusterD
 
 	a.logger.Infow("cluster deleted successfully", "cluster", clusterName, "response", future.Status())
 
+	if err = a.releaseResourceGroup(ctx, cred); err != nil {
+		a.logger.Warnw("failed to release resource group of the cluster", "cluster", clusterName, "resource-group", groupName, "error", err)
+	}
+
 	return &proto.ClusterDeleteResponse{}, nil
 
 }
@@ -223,44 +356,68 @@ func (a *AzureController) getClusters(ctx context.Context, req *proto.GetCluster
 		return nil, err
 	}
 
-	clusters := make([]*proto.ClusterSpec, 0, len(result.Values()))
+	groups, err := spawnerResourceGroups(ctx, cred)
+	if err != nil {
+		a.logger.Errorw("failed to list resource groups", "error", err)
+		return nil, err
+	}
+
+	spawnerClusters := []containerservice.ManagedCluster{}
 	for _, cl := range result.Values() {
-		if cl.PowerState.Code != containerservice.CodeRunning {
+		if cl.ManagedClusterProperties == nil || cl.PowerState == nil || !inResourceGroups(to.String(cl.ID), groups) {
 			continue
 		}
+		spawnerClusters = append(spawnerClusters, cl)
+	}
+	clustersHealth := a.clustersHealth(ctx, account, spawnerClusters)
 
+	clusters := make([]*proto.ClusterSpec, 0, len(spawnerClusters))
+	for _, cl := range spawnerClusters {
 		mcapp := cl.AgentPoolProfiles
+		if mcapp == nil {
+			mcapp = &[]containerservice.ManagedClusterAgentPoolProfile{}
+		}
 		nodes := make([]*proto.NodeSpec, 0, len(*mcapp))
+		health := clustersHealth[*cl.ID]
 
 		for _, app := range *mcapp {
 			state := constants.Inactive
-			if app.PowerState.Code == containerservice.CodeRunning {
+			if app.PowerState != nil && app.PowerState.Code == containerservice.CodeRunning {
 				state = constants.Active
 			}
 			zones := ""
-			if app.AvailabilityZones != nil {
+			allZones := []string{}
+			if app.AvailabilityZones != nil && len(*app.AvailabilityZones) > 0 {
 				zones = (*app.AvailabilityZones)[0]
+				allZones = *app.AvailabilityZones
 			}
 			node := &proto.NodeSpec{
-				Name:             *app.Name,
-				Instance:         *app.VMSize,
-				DiskSize:         *app.OsDiskSizeGB,
-				State:            state,
-				IpAddr:           "",
-				Availabilityzone: zones,
-				ClusterId:        *cl.ID,
-				Labels:           aws.StringValueMap(app.Tags),
-				GpuEnabled:       false,
-				//TODO: get health
-				Health: &proto.Health{},
+				Name:              *app.Name,
+				Instance:          *app.VMSize,
+				DiskSize:          *app.OsDiskSizeGB,
+				State:             state,
+				IpAddr:            "",
+				Availabilityzone:  zones,
+				AvailabilityZones: allZones,
+				ClusterId:         *cl.ID,
+				Labels:            aws.StringValueMap(app.Tags),
+				GpuEnabled:        false,
+				Health:            health[*app.Name],
+				PoolState:         agentPoolState(app),
+				ProviderStatus:    providerStatus(app.ProvisioningState, app.PowerState),
+
+				KubernetesVersion: to.String(app.OrchestratorVersion),
 			}
 			nodes = append(nodes, node)
 		}
 
 		spec := &proto.ClusterSpec{
-			Name:      *cl.Name,
-			ClusterId: *cl.ID,
-			NodeSpec:  nodes,
+			Name:              *cl.Name,
+			ClusterId:         *cl.ID,
+			NodeSpec:          nodes,
+			KubernetesVersion: to.String(cl.KubernetesVersion),
+			State:             managedClusterState(cl),
+			ProviderStatus:    managedClusterStatus(cl),
 		}
 		clusters = append(clusters, spec)
 	}
@@ -274,7 +431,7 @@ func (a *AzureController) clusterStatus(ctx context.Context, req *proto.ClusterS
 
 	account := req.AccountName
 
-	cred, err := getCredentials(ctx, account)
+	cred, err := a.clusterCredentials(ctx, account, clusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -293,10 +450,12 @@ func (a *AzureController) clusterStatus(ctx context.Context, req *proto.ClusterS
 	}
 
 	state := constants.Inactive
-	if clstr.PowerState.Code == containerservice.CodeRunning {
+	if clstr.ManagedClusterProperties != nil && clstr.PowerState != nil && clstr.PowerState.Code == containerservice.CodeRunning {
 		state = constants.Active
 	}
 	return &proto.ClusterStatusResponse{
-		Status: state,
+		Status:         state,
+		State:          managedClusterState(clstr),
+		ProviderStatus: managedClusterStatus(clstr),
 	}, nil
 }
@@ -0,0 +1,209 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	diagnosticSettingName = "spawner-control-plane"
+
+	//kmsAPIVersion Key Vault KMS is not in the containerservice sdk yet, cluster is updated over REST
+	kmsAPIVersion = "2022-03-02-preview"
+)
+
+//logCategories AKS diagnostic log categories of the control plane log types
+var logCategories = map[string][]string{
+	"api":               {"kube-apiserver"},
+	"audit":             {"kube-audit", "kube-audit-admin"},
+	"authenticator":     {"guard"},
+	"controllerManager": {"kube-controller-manager"},
+	"scheduler":         {"kube-scheduler"},
+}
+
+func clusterResourceID(cred *system.AzureCredential, clusterName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s", cred.SubscriptionID, cred.ResourceGroup, clusterName)
+}
+
+//diagnosticLogs log settings enabling the categories of the requested types
+func diagnosticLogs(logging *proto.ClusterLogging) (*[]insights.LogSettings, error) {
+	categories := []string{}
+	invalid := []string{}
+	for _, t := range logging.GetTypes() {
+		c, ok := logCategories[t]
+		if !ok {
+			invalid = append(invalid, t)
+			continue
+		}
+		categories = append(categories, c...)
+	}
+
+	if len(invalid) > 0 {
+		supported := []string{}
+		for t := range logCategories {
+			supported = append(supported, t)
+		}
+		sort.Strings(supported)
+		return nil, fmt.Errorf("invalid log types %s, supported types are %s", strings.Join(invalid, ", "), strings.Join(supported, ", "))
+	}
+
+	logs := []insights.LogSettings{}
+	for _, c := range categories {
+		logs = append(logs, insights.LogSettings{
+			Category: to.StringPtr(c),
+			Enabled:  to.BoolPtr(true),
+		})
+	}
+	return &logs, nil
+}
+
+//validateLogging logging on Azure needs a Log Analytics workspace to ship the logs to
+func validateLogging(logging *proto.ClusterLogging) error {
+	if _, err := diagnosticLogs(logging); err != nil {
+		return err
+	}
+	if len(logging.GetTypes()) > 0 && logging.WorkspaceId == "" {
+		return errors.New("log analytics workspaceId is required for control plane logging")
+	}
+	return nil
+}
+
+//setLogging diagnostic setting of the cluster shipping control plane logs to the workspace, setting is removed when no types are requested
+func (a AzureController) setLogging(ctx context.Context, cred *system.AzureCredential, clusterName string, logging *proto.ClusterLogging) error {
+	if err := validateLogging(logging); err != nil {
+		return err
+	}
+
+	client, err := getDiagnosticSettingsClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "setLogging: cannot get diagnostic settings client")
+	}
+
+	resourceID := clusterResourceID(cred, clusterName)
+	if len(logging.Types) == 0 {
+		if _, err = client.Delete(ctx, resourceID, diagnosticSettingName); err != nil {
+			return errors.Wrapf(err, "failed to remove diagnostic setting of cluster '%s'", clusterName)
+		}
+		a.logger.Infow("control plane logging turned off", "cluster", clusterName)
+		return nil
+	}
+
+	logs, err := diagnosticLogs(logging)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, resourceID, insights.DiagnosticSettingsResource{
+		DiagnosticSettings: &insights.DiagnosticSettings{
+			WorkspaceID: &logging.WorkspaceId,
+			Logs:        logs,
+		},
+	}, diagnosticSettingName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to set diagnostic setting of cluster '%s'", clusterName)
+	}
+
+	a.logger.Infow("control plane logging updated", "cluster", clusterName, "types", logging.Types)
+	return nil
+}
+
+//hasManagedIdentity KMS plugin authenticates to Key Vault with the cluster managed identity
+func hasManagedIdentity(clstr containerservice.ManagedCluster) bool {
+	return clstr.Identity != nil && clstr.Identity.Type != containerservice.ResourceIdentityTypeNone && clstr.Identity.Type != ""
+}
+
+//setSecretsEncryption turn Key Vault KMS etcd encryption on or off, identity of the cluster needs key access in the vault
+func (a AzureController) setSecretsEncryption(ctx context.Context, cred *system.AzureCredential, clusterName string, encryption *proto.SecretsEncryption) error {
+	if encryption.Enabled && encryption.KeyId == "" {
+		return errors.New("key vault keyId is required for secrets encryption")
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "setSecretsEncryption: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", clusterName)
+		return err
+	}
+
+	if encryption.Enabled && !hasManagedIdentity(clstr) {
+		return fmt.Errorf("secrets encryption requires managed identity, cluster '%s' uses service principal", clusterName)
+	}
+
+	resourceID := clusterResourceID(cred, clusterName)
+	resource, err := getResource(ctx, aksClient.Client, aksClient.BaseURI, resourceID, kmsAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cluster '%s' has no properties", clusterName)
+	}
+	securityProfile, ok := properties["securityProfile"].(map[string]interface{})
+	if !ok {
+		securityProfile = map[string]interface{}{}
+	}
+
+	kms := map[string]interface{}{"enabled": encryption.Enabled}
+	if encryption.Enabled {
+		kms["keyId"] = encryption.KeyId
+	}
+	securityProfile["azureKeyVaultKms"] = kms
+	properties["securityProfile"] = securityProfile
+
+	if err = putResource(ctx, aksClient.Client, aksClient.BaseURI, resourceID, kmsAPIVersion, resource, true); err != nil {
+		a.logger.Errorw("failed to update secrets encryption", "cluster", clusterName, "error", err)
+		return err
+	}
+
+	a.logger.Infow("secrets encryption updated", "cluster", clusterName, "enabled", encryption.Enabled)
+	return nil
+}
+
+func (a AzureController) updateClusterConfig(ctx context.Context, req *proto.UpdateClusterConfigRequest) (*proto.UpdateClusterConfigResponse, error) {
+	cred, err := a.clusterCredentials(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "updateClusterConfig: cannot get AKS client")
+	}
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, req.ClusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", req.ClusterName)
+		return nil, err
+	}
+	if !isSpawnerTagged(clstr.Tags) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", req.ClusterName, labels.ScopeTag())
+	}
+
+	if req.Logging != nil {
+		if err = a.setLogging(ctx, cred, req.ClusterName, req.Logging); err != nil {
+			a.logger.Errorw("failed to update control plane logging", "cluster", req.ClusterName, "error", err)
+			return nil, err
+		}
+	}
+
+	if req.Encryption != nil {
+		if err = a.setSecretsEncryption(ctx, cred, req.ClusterName, req.Encryption); err != nil {
+			return nil, err
+		}
+	}
+	return &proto.UpdateClusterConfigResponse{UpdateIds: []string{}}, nil
+}
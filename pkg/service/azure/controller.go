@@ -82,9 +82,97 @@ func (a *AzureController) GetKubeConfig(ctx context.Context, req *proto.GetKubeC
 }
 
 func (a *AzureController) TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
-	return &proto.TagNodeInstanceResponse{}, nil
+	return a.tagNodeInstance(ctx, req)
 }
 
 func (a *AzureController) GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error) {
 	return a.getCostByTime(ctx, req)
 }
+
+func (a *AzureController) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	return a.listKubernetesVersions(ctx, req)
+}
+
+func (a *AzureController) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	return a.upgradeCluster(ctx, req)
+}
+
+func (a *AzureController) UpgradeNodePool(ctx context.Context, req *proto.UpgradeNodePoolRequest) (*proto.UpgradeNodePoolResponse, error) {
+	return a.upgradeNodePool(ctx, req)
+}
+
+func (a *AzureController) GetUpgradeStatus(ctx context.Context, req *proto.GetUpgradeStatusRequest) (*proto.GetUpgradeStatusResponse, error) {
+	return a.getUpgradeStatus(ctx, req)
+}
+
+func (a *AzureController) CheckCapacity(ctx context.Context, req *proto.CheckCapacityRequest) (*proto.CheckCapacityResponse, error) {
+	return a.checkCapacity(ctx, req)
+}
+
+func (a *AzureController) GetNetworkStack(ctx context.Context, req *proto.GetNetworkStackRequest) (*proto.GetNetworkStackResponse, error) {
+	return a.getNetworkStack(ctx, req)
+}
+
+func (a *AzureController) ListNetworkStacks(ctx context.Context, req *proto.ListNetworkStacksRequest) (*proto.ListNetworkStacksResponse, error) {
+	return a.listNetworkStacks(ctx, req)
+}
+
+func (a *AzureController) DeleteNetworkStack(ctx context.Context, req *proto.DeleteNetworkStackRequest) (*proto.DeleteNetworkStackResponse, error) {
+	return a.deleteNetworkStack(ctx, req)
+}
+
+func (a *AzureController) ListAddons(ctx context.Context, req *proto.ListAddonsRequest) (*proto.ListAddonsResponse, error) {
+	return a.listAddons(ctx, req)
+}
+
+func (a *AzureController) InstallAddon(ctx context.Context, req *proto.InstallAddonRequest) (*proto.InstallAddonResponse, error) {
+	return a.installAddon(ctx, req)
+}
+
+func (a *AzureController) UpdateAddon(ctx context.Context, req *proto.UpdateAddonRequest) (*proto.UpdateAddonResponse, error) {
+	return a.updateAddon(ctx, req)
+}
+
+func (a *AzureController) RemoveAddon(ctx context.Context, req *proto.RemoveAddonRequest) (*proto.RemoveAddonResponse, error) {
+	return a.removeAddon(ctx, req)
+}
+
+func (a *AzureController) BindServiceAccountRole(ctx context.Context, req *proto.BindServiceAccountRoleRequest) (*proto.BindServiceAccountRoleResponse, error) {
+	return a.bindServiceAccountRole(ctx, req)
+}
+
+func (a *AzureController) UpdateClusterConfig(ctx context.Context, req *proto.UpdateClusterConfigRequest) (*proto.UpdateClusterConfigResponse, error) {
+	return a.updateClusterConfig(ctx, req)
+}
+
+func (a *AzureController) StopCluster(ctx context.Context, req *proto.StopClusterRequest) (*proto.StopClusterResponse, error) {
+	return a.stopCluster(ctx, req)
+}
+
+func (a *AzureController) StartCluster(ctx context.Context, req *proto.StartClusterRequest) (*proto.StartClusterResponse, error) {
+	return a.startCluster(ctx, req)
+}
+
+func (a *AzureController) GetBootstrapStatus(ctx context.Context, req *proto.GetBootstrapStatusRequest) (*proto.GetBootstrapStatusResponse, error) {
+	return a.getBootstrapStatus(ctx, req)
+}
+
+func (a *AzureController) CreateTenant(ctx context.Context, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error) {
+	return a.createTenant(ctx, req)
+}
+
+func (a *AzureController) DeleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error) {
+	return a.deleteTenant(ctx, req)
+}
+
+func (a *AzureController) ListTenants(ctx context.Context, req *proto.ListTenantsRequest) (*proto.ListTenantsResponse, error) {
+	return a.listTenants(ctx, req)
+}
+
+func (a *AzureController) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	return a.attachVolume(ctx, req)
+}
+
+func (a *AzureController) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	return a.detachVolume(ctx, req)
+}
@@ -0,0 +1,202 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	//agentPoolLabel AKS labels every node with the name of its agent pool
+	agentPoolLabel = "agentpool"
+
+	provisioningSucceeded = "Succeeded"
+	provisioningFailed    = "Failed"
+
+	//poolHealthTimeout time given to the cluster to list its nodes, health is reported unknown after that
+	poolHealthTimeout = time.Second * 20
+	//poolHealthWorkers clusters queried at a time for their node pool health
+	poolHealthWorkers = 8
+)
+
+//pressureConditions node conditions which are a problem when true
+var pressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+	corev1.NodeNetworkUnavailable,
+}
+
+//poolNodes kubernetes nodes of the cluster grouped by agent pool
+func poolNodes(ctx context.Context, client kubernetes.Interface) (map[string][]corev1.Node, error) {
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: agentPoolLabel})
+	if err != nil {
+		return nil, err
+	}
+
+	pools := map[string][]corev1.Node{}
+	for _, n := range nodes.Items {
+		pool := n.Labels[agentPoolLabel]
+		pools[pool] = append(pools[pool], n)
+	}
+	return pools, nil
+}
+
+func nodeReady(node corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+//poolHealth issues of the agent pool, issue codes are the ones EKS reports for node groups so clients
+//handle both providers the same way. Node conditions are looked at only for running pools, unreachable tells
+//why nodes could not be read, empty when they were
+func poolHealth(pool containerservice.ManagedClusterAgentPoolProfile, nodes []corev1.Node, unreachable string) *proto.Health {
+	name := to.String(pool.Name)
+	issues := []*proto.Issue{}
+
+	provisioning := to.String(pool.ProvisioningState)
+	if provisioning == provisioningFailed {
+		issues = append(issues, &proto.Issue{
+			Code:        eks.NodegroupIssueCodeNodeCreationFailure,
+			Description: fmt.Sprintf("provisioning of agent pool '%s' failed", name),
+			ResourceIds: []string{name},
+		})
+	}
+
+	if pool.PowerState == nil || pool.PowerState.Code != containerservice.CodeRunning {
+		return &proto.Health{Issue: issues}
+	}
+
+	if unreachable != "" {
+		issues = append(issues, &proto.Issue{
+			Code:        eks.NodegroupIssueCodeClusterUnreachable,
+			Description: unreachable,
+			ResourceIds: []string{name},
+		})
+		return &proto.Health{Issue: issues}
+	}
+
+	count := int(to.Int32(pool.Count))
+	if provisioning == provisioningSucceeded && len(nodes) < count {
+		issues = append(issues, &proto.Issue{
+			Code:        eks.NodegroupIssueCodeNodeCreationFailure,
+			Description: fmt.Sprintf("%d of %d nodes joined the cluster", len(nodes), count),
+			ResourceIds: []string{name},
+		})
+	}
+
+	notReady := []string{}
+	pressure := map[corev1.NodeConditionType][]string{}
+	for _, n := range nodes {
+		if !nodeReady(n) {
+			notReady = append(notReady, n.Name)
+		}
+		for _, c := range n.Status.Conditions {
+			for _, p := range pressureConditions {
+				if c.Type == p && c.Status == corev1.ConditionTrue {
+					pressure[p] = append(pressure[p], n.Name)
+				}
+			}
+		}
+	}
+
+	if len(notReady) > 0 {
+		sort.Strings(notReady)
+		issues = append(issues, &proto.Issue{
+			Code:        eks.NodegroupIssueCodeNodeCreationFailure,
+			Description: fmt.Sprintf("nodes are not ready: %s", strings.Join(notReady, ", ")),
+			ResourceIds: notReady,
+		})
+	}
+
+	for _, p := range pressureConditions {
+		names, ok := pressure[p]
+		if !ok {
+			continue
+		}
+		sort.Strings(names)
+		issues = append(issues, &proto.Issue{
+			Code:        eks.NodegroupIssueCodeInternalFailure,
+			Description: fmt.Sprintf("nodes report %s", p),
+			ResourceIds: names,
+		})
+	}
+	return &proto.Health{Issue: issues}
+}
+
+//agentPoolsHealth health of every agent pool of the cluster keyed by pool name, unreachable cluster is reported on running pools
+func (a *AzureController) agentPoolsHealth(ctx context.Context, account, clusterName string, pools []containerservice.ManagedClusterAgentPoolProfile) map[string]*proto.Health {
+	ctx, cancel := context.WithTimeout(ctx, poolHealthTimeout)
+	defer cancel()
+
+	unreachable := ""
+	nodes := map[string][]corev1.Node{}
+
+	client, err := a.getK8sClient(ctx, account, clusterName)
+	if err == nil {
+		nodes, err = poolNodes(ctx, client)
+	}
+	if err != nil {
+		a.logger.Warnw("failed to get nodes of the cluster", "cluster", clusterName, "error", err)
+		unreachable = "nodes of the agent pool could not be read from the cluster"
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			unreachable = fmt.Sprintf("health unknown, cluster did not respond within %s", poolHealthTimeout)
+		}
+	}
+
+	health := map[string]*proto.Health{}
+	for _, pool := range pools {
+		name := to.String(pool.Name)
+		health[name] = poolHealth(pool, nodes[name], unreachable)
+	}
+	return health
+}
+
+//clustersHealth agent pool health of the running clusters keyed by cluster id, a few clusters are queried at a time so
+//an unreachable cluster does not hold back the rest
+func (a *AzureController) clustersHealth(ctx context.Context, account string, clusters []containerservice.ManagedCluster) map[string]map[string]*proto.Health {
+	health := make(map[string]map[string]*proto.Health, len(clusters))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, poolHealthWorkers)
+
+	for _, cl := range clusters {
+		if cl.ManagedClusterProperties == nil || cl.AgentPoolProfiles == nil {
+			continue
+		}
+		//stopped cluster has no api server to ask
+		if cl.PowerState == nil || cl.PowerState.Code != containerservice.CodeRunning {
+			continue
+		}
+
+		wg.Add(1)
+		go func(id, name string, pools []containerservice.ManagedClusterAgentPoolProfile) {
+			defer wg.Done()
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			h := a.agentPoolsHealth(ctx, account, name, pools)
+			mu.Lock()
+			health[id] = h
+			mu.Unlock()
+		}(to.String(cl.ID), to.String(cl.Name), *cl.AgentPoolProfiles)
+	}
+	wg.Wait()
+	return health
+}
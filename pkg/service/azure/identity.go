@@ -0,0 +1,132 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/msi/mgmt/msi"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+const (
+	kubeletIdentityKey = "kubeletidentity"
+	//managedIdentityOperatorRole control plane identity has to be operator of the kubelet identity to assign it to the nodes
+	managedIdentityOperatorRole = "f1a07417-d97a-45cb-824c-7a7467783830"
+)
+
+//clusterIdentity AKS cluster identity settings, exactly one of managed identity or service principal profile is set
+type clusterIdentity struct {
+	identity         *containerservice.ManagedClusterIdentity
+	identityProfile  map[string]*containerservice.UserAssignedIdentity
+	servicePrincipal *containerservice.ManagedClusterServicePrincipalProfile
+	//principalID of the user assigned control plane identity, system assigned one is known only after cluster is created
+	principalID string
+}
+
+//resourceName name segment of azure resource id
+func resourceName(id string) string {
+	parts := strings.Split(strings.TrimSuffix(id, "/"), "/")
+	return parts[len(parts)-1]
+}
+
+func getUserAssignedIdentity(ctx context.Context, client *msi.UserAssignedIdentitiesClient, id string) (*msi.Identity, error) {
+	identity, err := client.Get(ctx, resourceGroupOf(id), resourceName(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get managed identity '%s'", id)
+	}
+	if identity.UserAssignedIdentityProperties == nil || identity.ClientID == nil || identity.PrincipalID == nil {
+		return nil, fmt.Errorf("managed identity '%s' has no client id", id)
+	}
+	return &identity, nil
+}
+
+//validateIdentity custom kubelet identity is supported only with user assigned control plane identity
+func validateIdentity(identity *proto.ClusterIdentity) error {
+	switch identity.GetType() {
+	case proto.ClusterIdentityType_USER_ASSIGNED:
+		if identity.IdentityId == "" {
+			return errors.New("identityId is required for user assigned cluster identity")
+		}
+	case proto.ClusterIdentityType_SYSTEM_ASSIGNED, proto.ClusterIdentityType_SERVICE_PRINCIPAL:
+		if identity.GetKubeletIdentityId() != "" {
+			return errors.New("kubeletIdentityId requires user assigned cluster identity")
+		}
+	}
+	return nil
+}
+
+//clusterIdentitySpec identity of the new cluster, system assigned managed identity unless asked otherwise
+func (a AzureController) clusterIdentitySpec(ctx context.Context, cred *system.AzureCredential, identity *proto.ClusterIdentity) (*clusterIdentity, error) {
+	if err := validateIdentity(identity); err != nil {
+		return nil, err
+	}
+
+	switch identity.GetType() {
+	case proto.ClusterIdentityType_SERVICE_PRINCIPAL:
+		return &clusterIdentity{
+			servicePrincipal: &containerservice.ManagedClusterServicePrincipalProfile{
+				ClientID: to.StringPtr(cred.ClientID),
+				Secret:   to.StringPtr(cred.ClientSecret),
+			},
+		}, nil
+	case proto.ClusterIdentityType_SYSTEM_ASSIGNED:
+		return &clusterIdentity{
+			identity: &containerservice.ManagedClusterIdentity{
+				Type: containerservice.ResourceIdentityTypeSystemAssigned,
+			},
+		}, nil
+	}
+
+	client, err := getUserAssignedIdentitiesClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "clusterIdentitySpec: cannot get managed identity client")
+	}
+
+	controlPlane, err := getUserAssignedIdentity(ctx, client, identity.IdentityId)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &clusterIdentity{
+		identity: &containerservice.ManagedClusterIdentity{
+			Type: containerservice.ResourceIdentityTypeUserAssigned,
+			UserAssignedIdentities: map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
+				to.String(controlPlane.ID): {},
+			},
+		},
+		principalID: controlPlane.PrincipalID.String(),
+	}
+
+	if identity.KubeletIdentityId == "" {
+		return spec, nil
+	}
+
+	kubelet, err := getUserAssignedIdentity(ctx, client, identity.KubeletIdentityId)
+	if err != nil {
+		return nil, err
+	}
+
+	roleClient, err := getRoleAssignmentsClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "clusterIdentitySpec: cannot get role assignments client")
+	}
+
+	if err = a.assignRoles(ctx, roleClient, to.String(kubelet.ID), spec.principalID, []string{managedIdentityOperatorRole}); err != nil {
+		a.logger.Errorw("failed to grant control plane identity access to kubelet identity", "identity", identity.IdentityId, "kubelet-identity", identity.KubeletIdentityId, "error", err)
+		return nil, err
+	}
+
+	spec.identityProfile = map[string]*containerservice.UserAssignedIdentity{
+		kubeletIdentityKey: {
+			ResourceID: kubelet.ID,
+			ClientID:   to.StringPtr(kubelet.ClientID.String()),
+			ObjectID:   to.StringPtr(kubelet.PrincipalID.String()),
+		},
+	}
+	return spec, nil
+}
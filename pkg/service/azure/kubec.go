@@ -6,8 +6,14 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2022-01-01/containerservice"
 	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/kops/pkg/kubeconfig"
 )
 
@@ -15,7 +21,7 @@ func (a *AzureController) kubeConfig(ctx context.Context, req *proto.GetKubeConf
 
 	account := req.AccountName
 
-	cred, err := getCredentials(ctx, account)
+	cred, err := a.clusterCredentials(ctx, account, req.ClusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +87,77 @@ func (a *AzureController) getKubeConfig(ctx context.Context, req *proto.GetKubeC
 		return nil, errors.Wrap(err, "getKubeConfig: failed to get kube config")
 	}
 
+	if req.SpawnerExec {
+		kc, err = spawnerExecKubeConfig(kc, req)
+		if err != nil {
+			a.logger.Errorw("failed to set credential plugin in kube config", "error", err)
+			return nil, errors.Wrap(err, "getKubeConfig: ")
+		}
+	}
+
 	return &proto.GetKubeConfigResponse{
 		ClusterName: req.ClusterName,
 		Config:      kc,
 	}, nil
 }
+
+//spawnerExecKubeConfig replace the cluster user credentials with the spawner credential plugin,
+//credentials are then not stored on the client
+func spawnerExecKubeConfig(kc []byte, req *proto.GetKubeConfigRequest) ([]byte, error) {
+	config, err := clientcmd.Load(kc)
+	if err != nil {
+		return nil, err
+	}
+
+	exec := kube.SpawnerExecConfig(req.SpawnerAddr, req.Provider, req.Region, req.AccountName, req.ClusterName)
+	for name := range config.AuthInfos {
+		config.AuthInfos[name] = &clientcmdapi.AuthInfo{Exec: exec}
+	}
+	return clientcmd.Write(*config)
+}
+
+//restConfig kubernetes client config for the cluster built from cluster user credentials
+func (a *AzureController) restConfig(ctx context.Context, account, clusterName string) (*rest.Config, error) {
+	kc, err := a.kubeConfig(ctx, &proto.GetKubeConfigRequest{
+		AccountName: account,
+		ClusterName: clusterName,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get kube config")
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse kube config")
+	}
+	return config, nil
+}
+
+//getK8sClient kubernetes client for the cluster built from cluster user credentials
+func (a *AzureController) getK8sClient(ctx context.Context, account, clusterName string) (*kubernetes.Clientset, error) {
+	config, err := a.restConfig(ctx, account, clusterName)
+	if err != nil {
+		return nil, errors.Wrap(err, "getK8sClient")
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+//getK8sDynamicClient dynamic client for the cluster, used to apply arbitrary manifests
+func (a *AzureController) getK8sDynamicClient(ctx context.Context, account, clusterName string) (dynamic.Interface, error) {
+	config, err := a.restConfig(ctx, account, clusterName)
+	if err != nil {
+		return nil, errors.Wrap(err, "getK8sDynamicClient")
+	}
+	return dynamic.NewForConfig(config)
+}
+
+//drainNodes cordon and evict pods from the nodes matching selector, waits until timeout
+func (a *AzureController) drainNodes(ctx context.Context, account, clusterName, selector string, timeout int32) {
+	k8s, err := a.getK8sClient(ctx, account, clusterName)
+	if err != nil {
+		a.logger.Warnw("failed to get kubernetes client, skipping drain", "cluster", clusterName, "error", err)
+		return
+	}
+
+	kube.DrainBeforeDelete(ctx, k8s, selector, timeout, a.logger.With("cluster", clusterName))
+}
@@ -0,0 +1,191 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//networkContributorRole cluster identity manages load balancers and route tables in the subnet it is given
+const networkContributorRole = "4d97b98b-1d4f-4787-a291-c67834d212e7"
+
+var networkPlugins = map[proto.NetworkPlugin]containerservice.NetworkPlugin{
+	proto.NetworkPlugin_KUBENET:   containerservice.NetworkPluginKubenet,
+	proto.NetworkPlugin_AZURE_CNI: containerservice.NetworkPluginAzure,
+}
+
+var outboundTypes = map[proto.OutboundType]containerservice.OutboundType{
+	proto.OutboundType_LOAD_BALANCER:             containerservice.OutboundTypeLoadBalancer,
+	proto.OutboundType_USER_DEFINED_ROUTING:      containerservice.OutboundTypeUserDefinedRouting,
+	proto.OutboundType_MANAGED_NAT_GATEWAY:       containerservice.OutboundTypeManagedNATGateway,
+	proto.OutboundType_USER_ASSIGNED_NAT_GATEWAY: containerservice.OutboundTypeUserAssignedNATGateway,
+}
+
+//subnetOf resource group, vnet and subnet names of the subnet resource id
+func subnetOf(id string) (string, string, string, error) {
+	parts := strings.Split(strings.TrimSuffix(id, "/"), "/")
+	vnet := ""
+	for i := 0; i < len(parts)-1; i++ {
+		if strings.EqualFold(parts[i], "virtualNetworks") {
+			vnet = parts[i+1]
+		}
+	}
+
+	group := resourceGroupOf(id)
+	if group == "" || vnet == "" || len(parts) < 2 || !strings.EqualFold(parts[len(parts)-2], "subnets") {
+		return "", "", "", fmt.Errorf("invalid subnet id '%s'", id)
+	}
+	return group, vnet, parts[len(parts)-1], nil
+}
+
+func parseCIDR(name, cidr string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s '%s'", name, cidr)
+	}
+	return ipNet, nil
+}
+
+//validateNetwork options AKS would reject, checked before anything is created
+func validateNetwork(opts *proto.AzureNetworkOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	if opts.SubnetId != "" {
+		if _, _, _, err := subnetOf(opts.SubnetId); err != nil {
+			return err
+		}
+	}
+
+	if opts.PodCidr != "" {
+		if opts.NetworkPlugin != proto.NetworkPlugin_KUBENET {
+			return errors.New("podCidr is supported only with kubenet, pods get subnet addresses with azure cni")
+		}
+		if _, err := parseCIDR("podCidr", opts.PodCidr); err != nil {
+			return err
+		}
+	}
+
+	if (opts.ServiceCidr == "") != (opts.DnsServiceIp == "") {
+		return errors.New("serviceCidr and dnsServiceIp must be set together")
+	}
+	if opts.ServiceCidr != "" {
+		serviceNet, err := parseCIDR("serviceCidr", opts.ServiceCidr)
+		if err != nil {
+			return err
+		}
+		ip := net.ParseIP(opts.DnsServiceIp)
+		if ip == nil || !serviceNet.Contains(ip) {
+			return fmt.Errorf("dnsServiceIp '%s' must be within serviceCidr '%s'", opts.DnsServiceIp, opts.ServiceCidr)
+		}
+	}
+
+	switch opts.OutboundType {
+	case proto.OutboundType_USER_DEFINED_ROUTING, proto.OutboundType_USER_ASSIGNED_NAT_GATEWAY:
+		if opts.SubnetId == "" {
+			return fmt.Errorf("outbound type %s requires subnetId", opts.OutboundType)
+		}
+	}
+
+	if opts.PrivateCluster && len(opts.AuthorizedIpRanges) > 0 {
+		return errors.New("authorizedIpRanges are not supported on private cluster")
+	}
+	for _, r := range opts.AuthorizedIpRanges {
+		if net.ParseIP(r) != nil {
+			continue
+		}
+		if _, err := parseCIDR("authorized ip range", r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//networkProfile network profile of the cluster, AKS defaults are used for the options not set
+func networkProfile(opts *proto.AzureNetworkOptions) *containerservice.NetworkProfile {
+	if opts == nil {
+		return nil
+	}
+
+	profile := &containerservice.NetworkProfile{
+		NetworkPlugin:   networkPlugins[opts.NetworkPlugin],
+		OutboundType:    outboundTypes[opts.OutboundType],
+		LoadBalancerSku: containerservice.LoadBalancerSkuStandard,
+	}
+	if opts.PodCidr != "" {
+		profile.PodCidr = to.StringPtr(opts.PodCidr)
+	}
+	if opts.ServiceCidr != "" {
+		profile.ServiceCidr = to.StringPtr(opts.ServiceCidr)
+		profile.DNSServiceIP = to.StringPtr(opts.DnsServiceIp)
+	}
+	return profile
+}
+
+func apiServerAccessProfile(opts *proto.AzureNetworkOptions) *containerservice.ManagedClusterAPIServerAccessProfile {
+	if !opts.GetPrivateCluster() && len(opts.GetAuthorizedIpRanges()) == 0 {
+		return nil
+	}
+
+	profile := &containerservice.ManagedClusterAPIServerAccessProfile{
+		EnablePrivateCluster: to.BoolPtr(opts.PrivateCluster),
+	}
+	if len(opts.AuthorizedIpRanges) > 0 {
+		ranges := append([]string{}, opts.AuthorizedIpRanges...)
+		profile.AuthorizedIPRanges = &ranges
+	}
+	return profile
+}
+
+//ensureSubnet subnet has to exist before the cluster is placed in it
+func ensureSubnet(ctx context.Context, cred *system.AzureCredential, subnetID string) error {
+	group, vnet, subnet, err := subnetOf(subnetID)
+	if err != nil {
+		return err
+	}
+
+	client, err := getSubnetsClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "ensureSubnet: cannot get subnets client")
+	}
+
+	if _, err = client.Get(ctx, group, vnet, subnet, ""); err != nil {
+		return errors.Wrapf(err, "failed to get subnet '%s'", subnetID)
+	}
+	return nil
+}
+
+//grantSubnetAccess let the cluster identity manage the network resources in the subnet
+func (a AzureController) grantSubnetAccess(ctx context.Context, cred *system.AzureCredential, subnetID, principalID string) error {
+	client, err := getRoleAssignmentsClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "grantSubnetAccess: cannot get role assignments client")
+	}
+
+	if err = a.assignRoles(ctx, client, subnetID, principalID, []string{networkContributorRole}); err != nil {
+		return err
+	}
+	a.logger.Infow("granted cluster identity access to subnet", "subnet", subnetID, "principal", principalID)
+	return nil
+}
+
+//clusterSubnet subnet of the cluster node pools, nil when AKS manages the vnet
+func clusterSubnet(clstr containerservice.ManagedCluster) *string {
+	if clstr.ManagedClusterProperties == nil || clstr.AgentPoolProfiles == nil {
+		return nil
+	}
+	for _, app := range *clstr.AgentPoolProfiles {
+		if app.VnetSubnetID != nil {
+			return app.VnetSubnetID
+		}
+	}
+	return nil
+}
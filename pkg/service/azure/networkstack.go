@@ -0,0 +1,335 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//clusterNetwork network placement of a cluster, managed vnet lives in node resource group
+type clusterNetwork struct {
+	name              string
+	nodeResourceGroup string
+	subnetIDs         []string
+}
+
+//virtualNetwork vnet along with the resource group it is in
+type virtualNetwork struct {
+	vnet          network.VirtualNetwork
+	resourceGroup string
+	managed       bool
+}
+
+//resourceGroupOf resource group segment of azure resource id
+func resourceGroupOf(id string) string {
+	parts := strings.Split(id, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if strings.EqualFold(parts[i], "resourceGroups") {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+//clusterNetworks network placement of all the clusters in the subscription
+func clusterNetworks(ctx context.Context, cred *system.AzureCredential) ([]clusterNetwork, error) {
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := aksClient.ListComplete(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list clusters")
+	}
+
+	clusters := []clusterNetwork{}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list clusters")
+		}
+		cl := it.Value()
+		cn := clusterNetwork{name: to.String(cl.Name)}
+		if cl.ManagedClusterProperties != nil {
+			cn.nodeResourceGroup = to.String(cl.NodeResourceGroup)
+			if cl.AgentPoolProfiles != nil {
+				for _, app := range *cl.AgentPoolProfiles {
+					if app.VnetSubnetID != nil {
+						cn.subnetIDs = append(cn.subnetIDs, *app.VnetSubnetID)
+					}
+				}
+			}
+		}
+		clusters = append(clusters, cn)
+	}
+	return clusters, nil
+}
+
+//vnetClusters clusters with node pools in the vnet
+func vnetClusters(vn virtualNetwork, clusters []clusterNetwork) []string {
+	names := []string{}
+	prefix := strings.ToLower(to.String(vn.vnet.ID) + "/subnets/")
+	for _, cl := range clusters {
+		used := vn.managed && strings.EqualFold(cl.nodeResourceGroup, vn.resourceGroup)
+		for _, id := range cl.subnetIDs {
+			if strings.HasPrefix(strings.ToLower(id), prefix) {
+				used = true
+			}
+		}
+		if used {
+			names = append(names, cl.name)
+		}
+	}
+	return names
+}
+
+func listVirtualNetworks(ctx context.Context, client *network.VirtualNetworksClient, resourceGroup string, managed bool) ([]virtualNetwork, error) {
+	it, err := client.ListComplete(ctx, resourceGroup)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list virtual networks in resource group '%s'", resourceGroup)
+	}
+
+	vnets := []virtualNetwork{}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list virtual networks in resource group '%s'", resourceGroup)
+		}
+		vnets = append(vnets, virtualNetwork{vnet: it.Value(), resourceGroup: resourceGroup, managed: managed})
+	}
+	return vnets, nil
+}
+
+//virtualNetworks vnets of the spawner resource groups and the ones AKS manages in cluster node resource groups
+func virtualNetworks(ctx context.Context, cred *system.AzureCredential, clusters []clusterNetwork) ([]virtualNetwork, error) {
+	client, err := getVirtualNetworksClient(cred)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := spawnerResourceGroups(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	vnets := []virtualNetwork{}
+	for _, group := range groups {
+		groupVnets, err := listVirtualNetworks(ctx, client, group, false)
+		if err != nil {
+			return nil, err
+		}
+		vnets = append(vnets, groupVnets...)
+	}
+
+	for _, cl := range clusters {
+		if cl.nodeResourceGroup == "" {
+			continue
+		}
+		managed, err := listVirtualNetworks(ctx, client, cl.nodeResourceGroup, true)
+		if err != nil {
+			return nil, err
+		}
+		vnets = append(vnets, managed...)
+	}
+	return vnets, nil
+}
+
+func toNetworkStack(vn virtualNetwork, clusters []string) *proto.NetworkStack {
+	vnet := vn.vnet
+	ns := &proto.NetworkStack{
+		Id:       to.String(vnet.ID),
+		Name:     to.String(vnet.Name),
+		Region:   to.String(vnet.Location),
+		Subnets:  []*proto.NetworkSubnet{},
+		Clusters: clusters,
+		Managed:  vn.managed,
+	}
+
+	if vnet.VirtualNetworkPropertiesFormat == nil {
+		return ns
+	}
+
+	if vnet.AddressSpace != nil && vnet.AddressSpace.AddressPrefixes != nil {
+		ns.Cidrs = *vnet.AddressSpace.AddressPrefixes
+	}
+
+	if vnet.Subnets != nil {
+		for _, s := range *vnet.Subnets {
+			subnet := &proto.NetworkSubnet{
+				Id:   to.String(s.ID),
+				Name: to.String(s.Name),
+			}
+			if s.SubnetPropertiesFormat != nil {
+				subnet.Cidr = to.String(s.AddressPrefix)
+				if s.NatGateway != nil {
+					ns.NatGateways = append(ns.NatGateways, to.String(s.NatGateway.ID))
+				}
+			}
+			ns.Subnets = append(ns.Subnets, subnet)
+		}
+	}
+	return ns
+}
+
+//findVirtualNetwork vnet by name, region narrows down the search when set
+func findVirtualNetwork(vnets []virtualNetwork, name, region string) (*virtualNetwork, error) {
+	if name == "" {
+		return nil, errors.New("virtual network name must be provided")
+	}
+
+	for i, vn := range vnets {
+		if !strings.EqualFold(to.String(vn.vnet.Name), name) {
+			continue
+		}
+		if region != "" && !strings.EqualFold(to.String(vn.vnet.Location), region) {
+			continue
+		}
+		return &vnets[i], nil
+	}
+	return nil, fmt.Errorf("virtual network '%s' not found", name)
+}
+
+func (a AzureController) getNetworkStack(ctx context.Context, req *proto.GetNetworkStackRequest) (*proto.GetNetworkStackResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := clusterNetworks(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	vnets, err := virtualNetworks(ctx, cred, clusters)
+	if err != nil {
+		a.logger.Errorw("failed to list virtual networks", "error", err)
+		return nil, err
+	}
+
+	vn, err := findVirtualNetwork(vnets, req.Name, req.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.GetNetworkStackResponse{NetworkStack: toNetworkStack(*vn, vnetClusters(*vn, clusters))}, nil
+}
+
+func (a AzureController) listNetworkStacks(ctx context.Context, req *proto.ListNetworkStacksRequest) (*proto.ListNetworkStacksResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := clusterNetworks(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	vnets, err := virtualNetworks(ctx, cred, clusters)
+	if err != nil {
+		a.logger.Errorw("failed to list virtual networks", "error", err)
+		return nil, err
+	}
+
+	regions := map[string]struct{}{}
+	for _, r := range req.Regions {
+		regions[strings.ToLower(r)] = struct{}{}
+	}
+
+	resp := &proto.ListNetworkStacksResponse{NetworkStacks: []*proto.NetworkStack{}}
+	for _, vn := range vnets {
+		if _, ok := regions[strings.ToLower(to.String(vn.vnet.Location))]; len(regions) > 0 && !ok {
+			continue
+		}
+		resp.NetworkStacks = append(resp.NetworkStacks, toNetworkStack(vn, vnetClusters(vn, clusters)))
+	}
+	return resp, nil
+}
+
+//attachedInterfaces ip configurations still attached to the subnets of the vnet
+func attachedInterfaces(vnet network.VirtualNetwork) []string {
+	attached := []string{}
+	if vnet.VirtualNetworkPropertiesFormat == nil || vnet.Subnets == nil {
+		return attached
+	}
+
+	for _, s := range *vnet.Subnets {
+		if s.SubnetPropertiesFormat == nil || s.IPConfigurations == nil {
+			continue
+		}
+		for _, ip := range *s.IPConfigurations {
+			attached = append(attached, to.String(ip.ID))
+		}
+	}
+	return attached
+}
+
+func (a AzureController) deleteNetworkStack(ctx context.Context, req *proto.DeleteNetworkStackRequest) (*proto.DeleteNetworkStackResponse, error) {
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := clusterNetworks(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	vnets, err := virtualNetworks(ctx, cred, clusters)
+	if err != nil {
+		a.logger.Errorw("failed to list virtual networks", "error", err)
+		return nil, err
+	}
+
+	vn, err := findVirtualNetwork(vnets, req.Name, req.Region)
+	if err != nil {
+		return nil, err
+	}
+	name := to.String(vn.vnet.Name)
+
+	if users := vnetClusters(*vn, clusters); len(users) > 0 {
+		return nil, fmt.Errorf("virtual network '%s' is used by clusters %s", name, strings.Join(users, ", "))
+	}
+
+	if vn.managed {
+		return nil, fmt.Errorf("virtual network '%s' is managed by AKS in resource group '%s'", name, vn.resourceGroup)
+	}
+
+	client, err := getVirtualNetworksClient(cred)
+	if err != nil {
+		return nil, err
+	}
+
+	//resource groups can be shared with networks spawner did not create, only spawner tagged ones are deleted
+	vnet, err := client.Get(ctx, vn.resourceGroup, name, "")
+	if err != nil {
+		a.logger.Errorw("failed to get virtual network", "name", name, "error", err)
+		return nil, err
+	}
+	if !isSpawnerTagged(vnet.Tags) {
+		return nil, fmt.Errorf("virtual network '%s' is not created by spawner", name)
+	}
+
+	if attached := attachedInterfaces(vnet); len(attached) > 0 {
+		return nil, fmt.Errorf("virtual network '%s' has network interfaces attached %s", name, strings.Join(attached, ", "))
+	}
+
+	a.logger.Infow("deleting virtual network", "name", name, "resource-group", vn.resourceGroup)
+	future, err := client.Delete(ctx, vn.resourceGroup, name)
+	if err != nil {
+		a.logger.Errorw("failed to delete virtual network", "name", name, "error", err)
+		return nil, err
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		a.logger.Errorw("failed to delete virtual network", "name", name, "error", err)
+		return nil, err
+	}
+
+	a.logger.Infow("deleted virtual network", "name", name, "resource-group", vn.resourceGroup)
+	return &proto.DeleteNetworkStackResponse{}, nil
+}
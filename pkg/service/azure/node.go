@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
 	"github.com/pkg/errors"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
 	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
 )
 
+const gpuPluginTimeout = time.Minute * 30
+
 func getGPUProfile(profile proto.MIGProfile) containerservice.GPUInstanceProfile {
 	switch profile {
 	case proto.MIGProfile_MIG1g:
@@ -33,7 +37,7 @@ func (a AzureController) addNode(ctx context.Context, req *proto.NodeSpawnReques
 
 	account := req.AccountName
 
-	cred, err := getCredentials(ctx, account)
+	cred, err := a.clusterCredentials(ctx, account, req.ClusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +56,16 @@ func (a AzureController) addNode(ctx context.Context, req *proto.NodeSpawnReques
 		return nil, err
 	}
 
+	zones, err := poolZones(ctx, cred, *clstr.Location, req.NodeSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := a.ensureCapacity(ctx, cred, *clstr.Location, req.NodeSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	apc, err := getAgentPoolClient(cred)
 	if err != nil {
 		a.logger.Errorw("failed to get agent pool client", "error", err)
@@ -82,13 +96,20 @@ func (a AzureController) addNode(ctx context.Context, req *proto.NodeSpawnReques
 
 	mcappp := containerservice.ManagedClusterAgentPoolProfileProperties{
 
-		Count:      &count,
-		VMSize:     &instance,
-		NodeLabels: nodeTags,
-		Tags:       nodeTags,
-		Mode:       containerservice.AgentPoolModeUser,
-		//	OrchestratorVersion: &constants.AzureKubeVersion,
-		OsDiskSizeGB: &req.NodeSpec.DiskSize,
+		Count:             &count,
+		VMSize:            &instance,
+		NodeLabels:        nodeTags,
+		Tags:              nodeTags,
+		Mode:              containerservice.AgentPoolModeUser,
+		OsDiskSizeGB:      &req.NodeSpec.DiskSize,
+		AvailabilityZones: zones,
+		//pools of cluster in custom vnet are placed in the same subnet
+		VnetSubnetID: clusterSubnet(clstr),
+	}
+
+	//node pool defaults to control plane version when not specified
+	if req.NodeSpec.KubernetesVersion != "" {
+		mcappp.OrchestratorVersion = &req.NodeSpec.KubernetesVersion
 	}
 
 	isGpu := common.IsGPU(req.NodeSpec.MachineType) || req.NodeSpec.GpuEnabled
@@ -118,14 +139,43 @@ func (a AzureController) addNode(ctx context.Context, req *proto.NodeSpawnReques
 		return nil, errors.Wrapf(err, "failed to add node to the cluster")
 	}
 
-	return &proto.NodeSpawnResponse{}, nil
+	if req.NodeSpec.GpuPlugin != proto.GPUPlugin_PluginNONE {
+		go a.installGPUPlugin(account, clusterName, req.NodeSpec)
+	}
+
+	return &proto.NodeSpawnResponse{Warnings: warnings}, nil
+}
+
+//installGPUPlugin install the GPU plugin into the cluster, AKS partitions the GPUs as per GpuInstanceProfile,
+//so GPU operator mig-manager is not enabled.
+func (a *AzureController) installGPUPlugin(account, clusterName string, nodeSpec *proto.NodeSpec) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), gpuPluginTimeout)
+	defer cancel()
+
+	k8s, err := a.getK8sClient(ctx, account, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get kubernetes client, gpu plugin not installed", "cluster", clusterName, "error", err)
+		return
+	}
+
+	opts := kube.GPUPluginOptions{MIGStrategy: kube.MIGStrategyNone}
+	if nodeSpec.MigProfile != proto.MIGProfile_UNKNOWN {
+		opts.MIGStrategy = kube.MIGStrategySingle
+	}
+
+	if err = kube.InstallGPUPlugin(ctx, k8s, nodeSpec.GpuPlugin, opts); err != nil {
+		a.logger.Errorw("failed to install gpu plugin", "cluster", clusterName, "plugin", nodeSpec.GpuPlugin.String(), "error", err)
+		return
+	}
+	a.logger.Infow("gpu plugin installed", "cluster", clusterName, "nodepool", nodeSpec.Name, "plugin", nodeSpec.GpuPlugin.String())
 }
 
 func (a *AzureController) deleteNode(ctx context.Context, req *proto.NodeDeleteRequest) (*proto.NodeDeleteResponse, error) {
 
 	account := req.AccountName
 
-	cred, err := getCredentials(ctx, account)
+	cred, err := a.clusterCredentials(ctx, account, req.ClusterName)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +189,10 @@ func (a *AzureController) deleteNode(ctx context.Context, req *proto.NodeDeleteR
 	cluster := req.GetClusterName()
 	node := req.GetNodeGroupName()
 
+	if req.Drain {
+		a.drainNodes(ctx, account, cluster, kube.NodePoolSelector(constants.NodeNameLabel, node), req.DrainTimeoutSeconds)
+	}
+
 	// Doc : https://docs.microsoft.com/en-us/rest/api/aks/agent-pools/delete
 	future, err := apc.Delete(ctx, groupName, cluster, node)
 
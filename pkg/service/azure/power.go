@@ -0,0 +1,85 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//stopCluster stop the control plane and all the agent pools, AKS keeps the cluster configuration and node pool sizes
+func (a AzureController) stopCluster(ctx context.Context, req *proto.StopClusterRequest) (*proto.StopClusterResponse, error) {
+	clusterName := req.ClusterName
+
+	cred, err := a.clusterCredentials(ctx, req.AccountName, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "stopCluster: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "cluster", clusterName, "error", err)
+		return nil, err
+	}
+	if !isSpawnerTagged(clstr.Tags) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", clusterName, labels.ScopeTag())
+	}
+	if clstr.ManagedClusterProperties != nil && clstr.PowerState != nil && clstr.PowerState.Code == containerservice.CodeStopped {
+		a.logger.Infow("cluster is already stopped", "cluster", clusterName)
+		return &proto.StopClusterResponse{State: proto.ClusterState_STOPPED}, nil
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/managed-clusters/stop
+	_, err = aksClient.Stop(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to stop cluster", "cluster", clusterName, "error", err)
+		return nil, err
+	}
+	a.logger.Infow("stopping cluster", "cluster", clusterName)
+	return &proto.StopClusterResponse{State: proto.ClusterState_UPDATING}, nil
+}
+
+//startCluster start the stopped cluster, agent pools come back with the node count they had before stop
+func (a AzureController) startCluster(ctx context.Context, req *proto.StartClusterRequest) (*proto.StartClusterResponse, error) {
+	clusterName := req.ClusterName
+
+	cred, err := a.clusterCredentials(ctx, req.AccountName, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "startCluster: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "cluster", clusterName, "error", err)
+		return nil, err
+	}
+	if !isSpawnerTagged(clstr.Tags) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", clusterName, labels.ScopeTag())
+	}
+	if clstr.ManagedClusterProperties != nil && clstr.PowerState != nil && clstr.PowerState.Code == containerservice.CodeRunning {
+		a.logger.Infow("cluster is already running", "cluster", clusterName)
+		return &proto.StartClusterResponse{State: managedClusterState(clstr)}, nil
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/managed-clusters/start
+	_, err = aksClient.Start(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to start cluster", "cluster", clusterName, "error", err)
+		return nil, err
+	}
+	a.logger.Infow("starting cluster", "cluster", clusterName)
+	return &proto.StartClusterResponse{State: proto.ClusterState_UPDATING}, nil
+}
@@ -0,0 +1,177 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+)
+
+//isSpawnerTagged resource is created by spawner of this env scope
+func isSpawnerTagged(tags map[string]*string) bool {
+	return to.String(tags[constants.CreatorLabel]) == constants.SpawnerServiceLabel && to.String(tags[constants.Scope]) == labels.ScopeTag()
+}
+
+//withResourceGroup credential working in the given resource group, account resource group is kept when empty
+func withResourceGroup(cred *system.AzureCredential, group string) *system.AzureCredential {
+	if group == "" || strings.EqualFold(group, cred.ResourceGroup) {
+		return cred
+	}
+	c := *cred
+	c.ResourceGroup = group
+	return &c
+}
+
+//inResourceGroups resource id belongs to one of the groups
+func inResourceGroups(id string, groups []string) bool {
+	group := resourceGroupOf(id)
+	for _, g := range groups {
+		if strings.EqualFold(g, group) {
+			return true
+		}
+	}
+	return false
+}
+
+//ensureResourceGroup create the resource group of the credential with spawner tags when it does not exist
+func (a AzureController) ensureResourceGroup(ctx context.Context, cred *system.AzureCredential, location string) error {
+	client, err := getGroupsClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "ensureResourceGroup: cannot get resource groups client")
+	}
+
+	resp, err := client.CheckExistence(ctx, cred.ResourceGroup)
+	if err != nil && resp.StatusCode != http.StatusNotFound {
+		return errors.Wrapf(err, "failed to check resource group '%s'", cred.ResourceGroup)
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/resources/resource-groups/create-or-update
+	_, err = client.CreateOrUpdate(ctx, cred.ResourceGroup, resources.Group{
+		Location: &location,
+		Tags:     labels.DefaultTags(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create resource group '%s'", cred.ResourceGroup)
+	}
+	a.logger.Infow("created resource group", "resource-group", cred.ResourceGroup, "location", location)
+	return nil
+}
+
+//releaseResourceGroup delete the resource group of the credential once nothing is left in it, only groups spawner created are deleted
+func (a AzureController) releaseResourceGroup(ctx context.Context, cred *system.AzureCredential) error {
+	client, err := getGroupsClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "releaseResourceGroup: cannot get resource groups client")
+	}
+
+	group, err := client.Get(ctx, cred.ResourceGroup)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get resource group '%s'", cred.ResourceGroup)
+	}
+	if !isSpawnerTagged(group.Tags) {
+		return nil
+	}
+
+	rc, err := getResourcesClient(cred)
+	if err != nil {
+		return errors.Wrap(err, "releaseResourceGroup: cannot get resources client")
+	}
+
+	page, err := rc.ListByResourceGroup(ctx, cred.ResourceGroup, "", "", to.Int32Ptr(1))
+	if err != nil {
+		return errors.Wrapf(err, "failed to list resources of resource group '%s'", cred.ResourceGroup)
+	}
+	if len(page.Values()) > 0 {
+		return nil
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/resources/resource-groups/delete
+	future, err := client.Delete(ctx, cred.ResourceGroup)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete resource group '%s'", cred.ResourceGroup)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return errors.Wrapf(err, "failed to delete resource group '%s'", cred.ResourceGroup)
+	}
+	a.logger.Infow("deleted empty resource group", "resource-group", cred.ResourceGroup)
+	return nil
+}
+
+//spawnerResourceGroups account resource group followed by the groups spawner created
+func spawnerResourceGroups(ctx context.Context, cred *system.AzureCredential) ([]string, error) {
+	client, err := getGroupsClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "spawnerResourceGroups: cannot get resource groups client")
+	}
+
+	filter := fmt.Sprintf("tagName eq '%s' and tagValue eq '%s'", constants.CreatorLabel, constants.SpawnerServiceLabel)
+	it, err := client.ListComplete(ctx, filter, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list resource groups")
+	}
+
+	groups := []string{cred.ResourceGroup}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list resource groups")
+		}
+		g := it.Value()
+		if isSpawnerTagged(g.Tags) && !strings.EqualFold(to.String(g.Name), cred.ResourceGroup) {
+			groups = append(groups, to.String(g.Name))
+		}
+	}
+	return groups, nil
+}
+
+//clusterCredentials credential working in the resource group of the cluster. Account resource group is looked at first,
+//account credential is returned when cluster is not found so callers fail with the usual not found
+func (a AzureController) clusterCredentials(ctx context.Context, account, clusterName string) (*system.AzureCredential, error) {
+	cred, err := getCredentials(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "clusterCredentials: cannot get AKS client")
+	}
+
+	found := func(group string) (bool, error) {
+		clstr, err := aksClient.Get(ctx, group, clusterName)
+		if err != nil && clstr.StatusCode != http.StatusNotFound {
+			return false, errors.Wrapf(err, "failed to get cluster '%s'", clusterName)
+		}
+		return err == nil, nil
+	}
+
+	//clusters created before per cluster resource groups are all in the account group
+	if ok, err := found(cred.ResourceGroup); ok || err != nil {
+		return cred, err
+	}
+
+	groups, err := spawnerResourceGroups(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups[1:] {
+		ok, err := found(group)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return withResourceGroup(cred, group), nil
+		}
+	}
+	return cred, nil
+}
@@ -0,0 +1,67 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+)
+
+//getResource get the resource as plain json, used for api versions the sdk does not carry yet
+func getResource(ctx context.Context, client autorest.Client, baseURI, resourceID, apiVersion string) (map[string]interface{}, error) {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(resourceID),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": apiVersion}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to prepare request for '%s'", resourceID)
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get '%s'", resourceID)
+	}
+
+	resource := map[string]interface{}{}
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&resource),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get '%s'", resourceID)
+	}
+	return resource, nil
+}
+
+//putResource create or update the resource from plain json, waits for the long running operation when wait is set
+func putResource(ctx context.Context, client autorest.Client, baseURI, resourceID, apiVersion string, body interface{}, wait bool) error {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(resourceID),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": apiVersion}))
+	if err != nil {
+		return errors.Wrapf(err, "failed to prepare request for '%s'", resourceID)
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+	if err != nil {
+		return errors.Wrapf(err, "failed to update '%s'", resourceID)
+	}
+
+	if !wait {
+		err = autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated), autorest.ByClosing())
+		return errors.Wrapf(err, "failed to update '%s'", resourceID)
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update '%s'", resourceID)
+	}
+	return errors.Wrapf(future.WaitForCompletionRef(ctx, client), "failed to update '%s'", resourceID)
+}
@@ -70,7 +70,7 @@ func (a *AzureController) createSnapshot(ctx context.Context, req *proto.CreateS
 	if err != nil {
 		return nil, err
 	}
-	groupName := cred.ResourceGroup
+	groupName := withResourceGroup(cred, req.ResourceGroup).ResourceGroup
 
 	for k, v := range req.Labels {
 		v := v
@@ -113,6 +113,7 @@ func (a *AzureController) createSnapshotAndDelete(ctx context.Context, req *prot
 	if err != nil {
 		return nil, err
 	}
+	cred = withResourceGroup(cred, req.ResourceGroup)
 	tags := labels.DefaultTags()
 
 	for k, v := range req.Labels {
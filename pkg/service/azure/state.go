@@ -0,0 +1,76 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//provisioningStates provider neutral state of the AKS provisioning states, Succeeded depends on the power state
+var provisioningStates = map[string]proto.ClusterState{
+	"Creating":            proto.ClusterState_PROVISIONING,
+	"Updating":            proto.ClusterState_UPDATING,
+	"Upgrading":           proto.ClusterState_UPDATING,
+	"Scaling":             proto.ClusterState_UPDATING,
+	"Starting":            proto.ClusterState_UPDATING,
+	"Stopping":            proto.ClusterState_UPDATING,
+	"Migrating":           proto.ClusterState_UPDATING,
+	"Deleting":            proto.ClusterState_DELETING,
+	provisioningFailed:    proto.ClusterState_FAILED,
+	"Canceled":            proto.ClusterState_DEGRADED,
+	provisioningSucceeded: proto.ClusterState_RUNNING,
+}
+
+func resourceState(provisioning *string, power *containerservice.PowerState) proto.ClusterState {
+	state, ok := provisioningStates[to.String(provisioning)]
+	if !ok {
+		return proto.ClusterState_STATE_UNKNOWN
+	}
+	if state == proto.ClusterState_RUNNING && power != nil && power.Code == containerservice.CodeStopped {
+		return proto.ClusterState_STOPPED
+	}
+	return state
+}
+
+//providerStatus raw provisioning and power state of the resource
+func providerStatus(provisioning *string, power *containerservice.PowerState) string {
+	code := ""
+	if power != nil {
+		code = string(power.Code)
+	}
+	return fmt.Sprintf("%s/%s", to.String(provisioning), code)
+}
+
+//agentPoolState provider neutral state of the agent pool
+func agentPoolState(pool containerservice.ManagedClusterAgentPoolProfile) proto.ClusterState {
+	return resourceState(pool.ProvisioningState, pool.PowerState)
+}
+
+//managedClusterState provider neutral state of the cluster, running cluster is degraded when any agent pool failed
+func managedClusterState(clstr containerservice.ManagedCluster) proto.ClusterState {
+	if clstr.ManagedClusterProperties == nil {
+		return proto.ClusterState_STATE_UNKNOWN
+	}
+
+	state := resourceState(clstr.ProvisioningState, clstr.PowerState)
+	if state != proto.ClusterState_RUNNING || clstr.AgentPoolProfiles == nil {
+		return state
+	}
+	for _, pool := range *clstr.AgentPoolProfiles {
+		switch agentPoolState(pool) {
+		case proto.ClusterState_DEGRADED, proto.ClusterState_FAILED:
+			return proto.ClusterState_DEGRADED
+		}
+	}
+	return state
+}
+
+//managedClusterStatus raw provisioning and power state of the cluster
+func managedClusterStatus(clstr containerservice.ManagedCluster) string {
+	if clstr.ManagedClusterProperties == nil {
+		return ""
+	}
+	return providerStatus(clstr.ProvisioningState, clstr.PowerState)
+}
@@ -0,0 +1,110 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/stretchr/testify/assert"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+var (
+	powerRunning = &containerservice.PowerState{Code: containerservice.CodeRunning}
+	powerStopped = &containerservice.PowerState{Code: containerservice.CodeStopped}
+)
+
+func Test_resourceState(t *testing.T) {
+	tests := []struct {
+		name         string
+		provisioning *string
+		power        *containerservice.PowerState
+		want         proto.ClusterState
+	}{
+		{"creating", to.StringPtr("Creating"), powerRunning, proto.ClusterState_PROVISIONING},
+		{"updating", to.StringPtr("Updating"), powerRunning, proto.ClusterState_UPDATING},
+		{"upgrading", to.StringPtr("Upgrading"), powerRunning, proto.ClusterState_UPDATING},
+		{"scaling", to.StringPtr("Scaling"), powerRunning, proto.ClusterState_UPDATING},
+		{"starting", to.StringPtr("Starting"), powerStopped, proto.ClusterState_UPDATING},
+		{"stopping", to.StringPtr("Stopping"), powerRunning, proto.ClusterState_UPDATING},
+		{"migrating", to.StringPtr("Migrating"), powerRunning, proto.ClusterState_UPDATING},
+		{"deleting", to.StringPtr("Deleting"), powerRunning, proto.ClusterState_DELETING},
+		{"failed", to.StringPtr(provisioningFailed), powerRunning, proto.ClusterState_FAILED},
+		{"canceled", to.StringPtr("Canceled"), powerRunning, proto.ClusterState_DEGRADED},
+		{"succeeded running", to.StringPtr(provisioningSucceeded), powerRunning, proto.ClusterState_RUNNING},
+		{"succeeded stopped", to.StringPtr(provisioningSucceeded), powerStopped, proto.ClusterState_STOPPED},
+		{"succeeded without power state", to.StringPtr(provisioningSucceeded), nil, proto.ClusterState_RUNNING},
+		{"unknown provisioning state", to.StringPtr("SomethingNew"), powerRunning, proto.ClusterState_STATE_UNKNOWN},
+		{"no provisioning state", nil, nil, proto.ClusterState_STATE_UNKNOWN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resourceState(tt.provisioning, tt.power))
+			assert.Equal(t, tt.want, agentPoolState(containerservice.ManagedClusterAgentPoolProfile{
+				ProvisioningState: tt.provisioning,
+				PowerState:        tt.power,
+			}))
+		})
+	}
+}
+
+func pool(provisioning string, power *containerservice.PowerState) containerservice.ManagedClusterAgentPoolProfile {
+	return containerservice.ManagedClusterAgentPoolProfile{ProvisioningState: to.StringPtr(provisioning), PowerState: power}
+}
+
+func Test_managedClusterState(t *testing.T) {
+	tests := []struct {
+		name  string
+		clstr containerservice.ManagedCluster
+		want  proto.ClusterState
+	}{
+		{"no properties", containerservice.ManagedCluster{}, proto.ClusterState_STATE_UNKNOWN},
+		{"running without pools", containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			ProvisioningState: to.StringPtr(provisioningSucceeded),
+			PowerState:        powerRunning,
+		}}, proto.ClusterState_RUNNING},
+		{"running with healthy pools", containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			ProvisioningState: to.StringPtr(provisioningSucceeded),
+			PowerState:        powerRunning,
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{pool(provisioningSucceeded, powerRunning), pool("Scaling", powerRunning)},
+		}}, proto.ClusterState_RUNNING},
+		{"running with failed pool", containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			ProvisioningState: to.StringPtr(provisioningSucceeded),
+			PowerState:        powerRunning,
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{pool(provisioningSucceeded, powerRunning), pool(provisioningFailed, powerRunning)},
+		}}, proto.ClusterState_DEGRADED},
+		{"running with canceled pool", containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			ProvisioningState: to.StringPtr(provisioningSucceeded),
+			PowerState:        powerRunning,
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{pool("Canceled", powerRunning)},
+		}}, proto.ClusterState_DEGRADED},
+		{"stopped ignores pools", containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			ProvisioningState: to.StringPtr(provisioningSucceeded),
+			PowerState:        powerStopped,
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{pool(provisioningFailed, powerStopped)},
+		}}, proto.ClusterState_STOPPED},
+		{"deleting", containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			ProvisioningState: to.StringPtr("Deleting"),
+			PowerState:        powerRunning,
+		}}, proto.ClusterState_DELETING},
+		{"unknown provisioning state", containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			ProvisioningState: to.StringPtr("SomethingNew"),
+		}}, proto.ClusterState_STATE_UNKNOWN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, managedClusterState(tt.clstr))
+		})
+	}
+}
+
+func Test_managedClusterStatus(t *testing.T) {
+	assert.Equal(t, "", managedClusterStatus(containerservice.ManagedCluster{}))
+	assert.Equal(t, "Succeeded/Stopped", managedClusterStatus(containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+		ProvisioningState: to.StringPtr(provisioningSucceeded),
+		PowerState:        powerStopped,
+	}}))
+	assert.Equal(t, "Creating/", managedClusterStatus(containerservice.ManagedCluster{ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+		ProvisioningState: to.StringPtr("Creating"),
+	}}))
+}
@@ -0,0 +1,158 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//aksPoolNameTag AKS tags the scale set of every agent pool with the pool name
+const aksPoolNameTag = "aks-managed-poolName"
+
+//asTags labels along with spawner default tags
+func asTags(label map[string]string) map[string]*string {
+	tags := labels.DefaultTags()
+	for k, v := range label {
+		v := v
+		tags[k] = &v
+	}
+	return tags
+}
+
+//mergeTags add the tags to the resource, tags already on it are kept
+func mergeTags(ctx context.Context, client *resources.TagsClient, resourceID string, tags map[string]*string) error {
+	_, err := client.UpdateAtScope(ctx, resourceID, resources.TagsPatchResource{
+		Operation:  resources.TagsPatchOperationMerge,
+		Properties: &resources.Tags{Tags: tags},
+	})
+	return errors.Wrapf(err, "failed to tag '%s'", resourceID)
+}
+
+//tagNodeInstance tag the agent pool, its scale set and the disks of every scale set instance
+func (a *AzureController) tagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error) {
+	clusterName := req.ClusterName
+	pool := req.NodeGroup
+
+	cred, err := a.clusterCredentials(ctx, req.AccountName, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagNodeInstance: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "cluster", clusterName, "error", err)
+		return nil, err
+	}
+	if clstr.ManagedClusterProperties == nil || clstr.NodeResourceGroup == nil {
+		return nil, fmt.Errorf("cluster '%s' has no node resource group", clusterName)
+	}
+	nodeGroup := *clstr.NodeResourceGroup
+
+	apc, err := getAgentPoolClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagNodeInstance: cannot get agent pool client")
+	}
+
+	ap, err := apc.Get(ctx, cred.ResourceGroup, clusterName, pool)
+	if err != nil {
+		a.logger.Errorw("failed to get agent pool", "cluster", clusterName, "pool", pool, "error", err)
+		return nil, err
+	}
+	if ap.ManagedClusterAgentPoolProfileProperties == nil {
+		return nil, fmt.Errorf("agent pool '%s' has no properties", pool)
+	}
+
+	tags := asTags(req.Labels)
+
+	//pool tags are applied by AKS to the scale set and the nodes of later scale ups
+	if ap.ManagedClusterAgentPoolProfileProperties.Tags == nil {
+		ap.ManagedClusterAgentPoolProfileProperties.Tags = map[string]*string{}
+	}
+	for k, v := range tags {
+		ap.ManagedClusterAgentPoolProfileProperties.Tags[k] = v
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/agent-pools/create-or-update
+	future, err := apc.CreateOrUpdate(ctx, cred.ResourceGroup, clusterName, pool, ap)
+	if err != nil {
+		a.logger.Errorw("failed to tag agent pool", "cluster", clusterName, "pool", pool, "error", err)
+		return nil, err
+	}
+	if err = future.WaitForCompletionRef(ctx, apc.Client); err != nil {
+		a.logger.Errorw("failed to tag agent pool", "cluster", clusterName, "pool", pool, "error", err)
+		return nil, err
+	}
+
+	tagsClient, err := getTagsClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagNodeInstance: cannot get tags client")
+	}
+	ssClient, err := getScaleSetsClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagNodeInstance: cannot get scale sets client")
+	}
+	vmClient, err := getScaleSetVMsClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "tagNodeInstance: cannot get scale set instances client")
+	}
+
+	it, err := ssClient.ListComplete(ctx, nodeGroup)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list scale sets of cluster '%s'", clusterName)
+	}
+
+	rids := []string{}
+	for ; it.NotDone(); err = it.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list scale sets of cluster '%s'", clusterName)
+		}
+		ss := it.Value()
+		if to.String(ss.Tags[aksPoolNameTag]) != pool {
+			continue
+		}
+		rids = append(rids, to.String(ss.ID))
+
+		vms, err := vmClient.ListComplete(ctx, nodeGroup, to.String(ss.Name), "", "", "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list instances of scale set '%s'", to.String(ss.Name))
+		}
+		for ; vms.NotDone(); err = vms.NextWithContext(ctx) {
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list instances of scale set '%s'", to.String(ss.Name))
+			}
+			vm := vms.Value()
+			if vm.VirtualMachineScaleSetVMProperties == nil || vm.StorageProfile == nil {
+				continue
+			}
+			if d := vm.StorageProfile.OsDisk; d != nil && d.ManagedDisk != nil && d.ManagedDisk.ID != nil {
+				rids = append(rids, *d.ManagedDisk.ID)
+			}
+			if vm.StorageProfile.DataDisks != nil {
+				for _, d := range *vm.StorageProfile.DataDisks {
+					if d.ManagedDisk != nil && d.ManagedDisk.ID != nil {
+						rids = append(rids, *d.ManagedDisk.ID)
+					}
+				}
+			}
+		}
+	}
+
+	a.logger.Infow("adding tags to the following resources", "id", rids)
+	for _, id := range rids {
+		if err = mergeTags(ctx, tagsClient, id, tags); err != nil {
+			a.logger.Errorw("failed to tag node resource", "cluster", clusterName, "pool", pool, "error", err)
+			return nil, err
+		}
+	}
+	return &proto.TagNodeInstanceResponse{}, nil
+}
@@ -0,0 +1,92 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+//tenantCluster kube config of the cluster, tenants are managed only in clusters spawner created
+func (a *AzureController) tenantCluster(ctx context.Context, account, clusterName string) (*rest.Config, error) {
+	cred, err := a.clusterCredentials(ctx, account, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "tenantCluster: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "cluster", clusterName, "error", err)
+		return nil, err
+	}
+	if !isSpawnerTagged(clstr.Tags) {
+		return nil, fmt.Errorf("cluster '%s' is not created by spawner", clusterName)
+	}
+
+	return a.restConfig(ctx, account, clusterName)
+}
+
+func (a *AzureController) createTenant(ctx context.Context, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error) {
+	if err := kube.ValidateTenant(req); err != nil {
+		return nil, err
+	}
+
+	config, err := a.tenantCluster(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kube.CreateTenant(ctx, config, req)
+	if err != nil {
+		a.logger.Errorw("failed to create tenant", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "error", err)
+		return nil, err
+	}
+	a.logger.Infow("tenant created", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "namespace", resp.Tenant.Namespace)
+	return resp, nil
+}
+
+func (a *AzureController) deleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error) {
+	config, err := a.tenantCluster(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := kube.DeleteTenant(ctx, client, req.WorkspaceId)
+	if err != nil {
+		a.logger.Errorw("failed to delete tenant", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "error", err)
+		return nil, err
+	}
+	a.logger.Infow("tenant deleted", "cluster", req.ClusterName, "workspace", req.WorkspaceId, "namespaces", namespaces)
+	return &proto.DeleteTenantResponse{Namespaces: namespaces}, nil
+}
+
+func (a *AzureController) listTenants(ctx context.Context, req *proto.ListTenantsRequest) (*proto.ListTenantsResponse, error) {
+	config, err := a.tenantCluster(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants, err := kube.ListTenants(ctx, client)
+	if err != nil {
+		a.logger.Errorw("failed to list tenants", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+	return &proto.ListTenantsResponse{Tenants: tenants}, nil
+}
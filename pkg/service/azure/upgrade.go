@@ -0,0 +1,396 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	k8sversion "k8s.io/apimachinery/pkg/version"
+)
+
+//runningVersionsTimeout upgrade status is still reported when cluster cannot be reached in time
+const runningVersionsTimeout = time.Second * 30
+
+//provisioningStatus map the AKS provisioning state to upgrade step status
+func provisioningStatus(state *string) string {
+	switch to.String(state) {
+	case "Succeeded":
+		return constants.UpgradeSuccessful
+	case "Failed", "Canceled":
+		return constants.UpgradeFailed
+	case "Upgrading", "Updating":
+		return constants.UpgradeInProgress
+	}
+	return constants.UpgradePending
+}
+
+//runningVersions kubernetes version of the control plane and of each agent pool, version of the pool is of its oldest node
+func (a *AzureController) runningVersions(ctx context.Context, account, clusterName string) (string, map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, runningVersionsTimeout)
+	defer cancel()
+
+	client, err := a.getK8sClient(ctx, account, clusterName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	raw, err := client.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get server version")
+	}
+	var info k8sversion.Info
+	if err = json.Unmarshal(raw, &info); err != nil {
+		return "", nil, errors.Wrap(err, "failed to parse server version")
+	}
+
+	nodes, err := poolNodes(ctx, client)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get nodes")
+	}
+
+	pools := map[string]string{}
+	for pool, members := range nodes {
+		for _, n := range members {
+			v := strings.TrimPrefix(n.Status.NodeInfo.KubeletVersion, "v")
+			if cur, ok := pools[pool]; !ok || common.CompareVersion(v, cur) < 0 {
+				pools[pool] = v
+			}
+		}
+	}
+	return strings.TrimPrefix(info.GitVersion, "v"), pools, nil
+}
+
+func (a *AzureController) listKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+
+	cred, err := getCredentials(ctx, req.AccountName)
+	if err != nil {
+		return nil, err
+	}
+
+	csClient, err := getContainerServicesClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "listKubernetesVersions: cannot get container service client")
+	}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/container-service/container-services/list-orchestrators
+	res, err := csClient.ListOrchestrators(ctx, req.Region, "managedClusters")
+	if err != nil {
+		a.logger.Errorw("failed to list orchestrators", "error", err, "region", req.Region)
+		return nil, err
+	}
+
+	resp := &proto.ListKubernetesVersionsResponse{
+		Versions: []string{},
+	}
+	if res.OrchestratorVersionProfileProperties == nil || res.Orchestrators == nil {
+		return resp, nil
+	}
+
+	for _, o := range *res.Orchestrators {
+		if to.Bool(o.IsPreview) {
+			continue
+		}
+		v := to.String(o.OrchestratorVersion)
+		resp.Versions = append(resp.Versions, v)
+		if to.Bool(o.Default) {
+			resp.DefaultVersion = v
+		}
+	}
+
+	sort.Slice(resp.Versions, func(i, j int) bool {
+		return common.CompareVersion(resp.Versions[i], resp.Versions[j]) > 0
+	})
+	return resp, nil
+}
+
+//upgradeAgentPool set the orchestrator version on the pool and wait for the upgrade to complete
+func (a *AzureController) upgradeAgentPool(ctx context.Context, apc *containerservice.AgentPoolsClient, groupName, clusterName, pool, version string) error {
+
+	ap, err := apc.Get(ctx, groupName, clusterName, pool)
+	if err != nil {
+		return err
+	}
+
+	if to.String(ap.OrchestratorVersion) == version {
+		a.logger.Infow("node pool already at requested version", "nodepool", pool, "version", version)
+		return nil
+	}
+
+	ap.OrchestratorVersion = &version
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/agent-pools/create-or-update
+	future, err := apc.CreateOrUpdate(ctx, groupName, clusterName, pool, ap)
+	if err != nil {
+		return err
+	}
+
+	a.logger.Infow("upgrading node pool", "cluster", clusterName, "nodepool", pool, "version", version)
+	return future.WaitForCompletionRef(ctx, apc.Client)
+}
+
+func (a *AzureController) upgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+
+	clusterName := req.ClusterName
+	version := req.KubernetesVersion
+
+	if version == "" {
+		return nil, errors.New("kubernetesVersion must be provided")
+	}
+
+	cred, err := a.clusterCredentials(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	groupName := cred.ResourceGroup
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "upgradeCluster: cannot get AKS client")
+	}
+
+	apc, err := getAgentPoolClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "upgradeCluster: cannot get agent pool client")
+	}
+
+	clstr, err := aksClient.Get(ctx, groupName, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	if !isSpawnerTagged(clstr.Tags) {
+		return nil, fmt.Errorf("cluster '%s' not available in scope '%s'", clusterName, labels.ScopeTag())
+	}
+
+	if state := to.String(clstr.ProvisioningState); state != provisioningSucceeded {
+		return nil, fmt.Errorf("cluster '%s' must be %s to upgrade, current state '%s'", clusterName, provisioningSucceeded, state)
+	}
+
+	if clstr.PowerState != nil && clstr.PowerState.Code != containerservice.CodeRunning {
+		return nil, fmt.Errorf("cluster '%s' must be %s to upgrade, current power state '%s'", clusterName, containerservice.CodeRunning, clstr.PowerState.Code)
+	}
+
+	currentVersion := to.String(clstr.KubernetesVersion)
+	if common.CompareVersion(version, currentVersion) < 0 {
+		return nil, fmt.Errorf("cannot downgrade cluster from '%s' to '%s'", currentVersion, version)
+	}
+
+	clusterStep := &proto.UpgradeStep{
+		Resource:       clusterName,
+		Type:           constants.UpgradeTypeCluster,
+		CurrentVersion: currentVersion,
+		TargetVersion:  version,
+		Status:         constants.UpgradeSuccessful,
+	}
+
+	var future *containerservice.ManagedClustersCreateOrUpdateFuture
+	if currentVersion != version {
+		//agent pool profiles carry their current orchestrator version, so only the control plane gets upgraded here
+		clstr.KubernetesVersion = &version
+
+		//Doc : https://docs.microsoft.com/en-us/rest/api/aks/managed-clusters/create-or-update
+		f, err := aksClient.CreateOrUpdate(ctx, groupName, clusterName, clstr)
+		if err != nil {
+			a.logger.Errorw("failed to upgrade cluster", "error", err, "cluster", clusterName, "version", version)
+			return nil, err
+		}
+		future = &f
+		clusterStep.Status = constants.UpgradeInProgress
+	}
+
+	steps := []*proto.UpgradeStep{clusterStep}
+	pools := []string{}
+
+	if !req.SkipNodePools && clstr.AgentPoolProfiles != nil {
+		for _, app := range *clstr.AgentPoolProfiles {
+			pools = append(pools, *app.Name)
+			steps = append(steps, &proto.UpgradeStep{
+				Resource:       *app.Name,
+				Type:           constants.UpgradeTypeNodePool,
+				CurrentVersion: to.String(app.OrchestratorVersion),
+				TargetVersion:  version,
+				Status:         constants.UpgradePending,
+			})
+		}
+	}
+
+	//upgrade is tracked through GetUpgradeStatus, run it detached from request context
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), common.UpgradeTimeout())
+		defer cancel()
+
+		if future != nil {
+			a.logger.Infow("waiting on control plane upgrade", "cluster", clusterName)
+			if err := future.WaitForCompletionRef(ctx, aksClient.Client); err != nil {
+				a.logger.Errorw("control plane upgrade failed, skipping node pools upgrade", "cluster", clusterName, "error", err)
+				return
+			}
+			a.logger.Infow("control plane upgraded", "cluster", clusterName, "version", version)
+		}
+
+		for _, pool := range pools {
+			if err := a.upgradeAgentPool(ctx, apc, groupName, clusterName, pool, version); err != nil {
+				//carry on with rest of the pools, failed one can be retried with UpgradeNodePool
+				a.logger.Errorw("failed to upgrade node pool", "cluster", clusterName, "nodepool", pool, "error", err)
+				continue
+			}
+			a.logger.Infow("node pool upgraded", "cluster", clusterName, "nodepool", pool, "version", version)
+		}
+	}()
+
+	a.logger.Infow("cluster upgrade requested, it might take some time, check GetUpgradeStatus for progress", "cluster", clusterName, "version", version)
+	return &proto.UpgradeClusterResponse{Steps: steps}, nil
+}
+
+func (a *AzureController) upgradeNodePool(ctx context.Context, req *proto.UpgradeNodePoolRequest) (*proto.UpgradeNodePoolResponse, error) {
+
+	clusterName := req.ClusterName
+	pool := req.NodeGroupName
+
+	cred, err := a.clusterCredentials(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+	groupName := cred.ResourceGroup
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "upgradeNodePool: cannot get AKS client")
+	}
+
+	apc, err := getAgentPoolClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "upgradeNodePool: cannot get agent pool client")
+	}
+
+	clstr, err := aksClient.Get(ctx, groupName, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	clusterVersion := to.String(clstr.KubernetesVersion)
+	version := req.KubernetesVersion
+	if version == "" {
+		version = clusterVersion
+	}
+
+	if common.CompareVersion(version, clusterVersion) > 0 {
+		return nil, fmt.Errorf("node pool version '%s' cannot be newer than control plane version '%s'", version, clusterVersion)
+	}
+
+	ap, err := apc.Get(ctx, groupName, clusterName, pool)
+	if err != nil {
+		a.logger.Errorw("failed to get node pool", "error", err, "nodepool", pool)
+		return nil, err
+	}
+
+	if !isSpawnerTagged(ap.Tags) {
+		return nil, fmt.Errorf("node pool '%s' not available in scope '%s'", pool, labels.ScopeTag())
+	}
+
+	step := &proto.UpgradeStep{
+		Resource:       pool,
+		Type:           constants.UpgradeTypeNodePool,
+		CurrentVersion: to.String(ap.OrchestratorVersion),
+		TargetVersion:  version,
+		Status:         constants.UpgradeSuccessful,
+	}
+
+	if step.CurrentVersion == version {
+		return &proto.UpgradeNodePoolResponse{Step: step}, nil
+	}
+
+	ap.OrchestratorVersion = &version
+	_, err = apc.CreateOrUpdate(ctx, groupName, clusterName, pool, ap)
+	if err != nil {
+		a.logger.Errorw("failed to upgrade node pool", "error", err, "nodepool", pool, "version", version)
+		return nil, err
+	}
+
+	step.Status = constants.UpgradeInProgress
+	return &proto.UpgradeNodePoolResponse{Step: step}, nil
+}
+
+func (a *AzureController) getUpgradeStatus(ctx context.Context, req *proto.GetUpgradeStatusRequest) (*proto.GetUpgradeStatusResponse, error) {
+
+	clusterName := req.ClusterName
+
+	cred, err := a.clusterCredentials(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "getUpgradeStatus: cannot get AKS client")
+	}
+
+	clstr, err := aksClient.Get(ctx, cred.ResourceGroup, clusterName)
+	if err != nil {
+		a.logger.Errorw("failed to get cluster", "error", err, "cluster", clusterName)
+		return nil, err
+	}
+
+	pools := []containerservice.ManagedClusterAgentPoolProfile{}
+	if clstr.AgentPoolProfiles != nil {
+		pools = *clstr.AgentPoolProfiles
+	}
+
+	//AKS reports the target version, running versions are read from the cluster only while upgrading
+	upgrading := provisioningStatus(clstr.ProvisioningState) == constants.UpgradeInProgress
+	for _, app := range pools {
+		upgrading = upgrading || provisioningStatus(app.ProvisioningState) == constants.UpgradeInProgress
+	}
+
+	target := to.String(clstr.KubernetesVersion)
+	current := target
+	poolVersions := map[string]string{}
+	if upgrading {
+		cp, pv, err := a.runningVersions(ctx, req.AccountName, clusterName)
+		if err != nil {
+			a.logger.Warnw("failed to get running versions, reporting target versions", "cluster", clusterName, "error", err)
+		} else {
+			current = cp
+			poolVersions = pv
+		}
+	}
+
+	steps := []*proto.UpgradeStep{
+		{
+			Resource:       clusterName,
+			Type:           constants.UpgradeTypeCluster,
+			CurrentVersion: current,
+			TargetVersion:  target,
+			Status:         provisioningStatus(clstr.ProvisioningState),
+		},
+	}
+
+	for _, app := range pools {
+		name := to.String(app.Name)
+		v := to.String(app.OrchestratorVersion)
+		cv, ok := poolVersions[name]
+		if !ok {
+			cv = v
+		}
+		steps = append(steps, &proto.UpgradeStep{
+			Resource:       name,
+			Type:           constants.UpgradeTypeNodePool,
+			CurrentVersion: cv,
+			TargetVersion:  v,
+			Status:         provisioningStatus(app.ProvisioningState),
+		})
+	}
+
+	return &proto.GetUpgradeStatusResponse{Steps: steps}, nil
+}
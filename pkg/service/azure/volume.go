@@ -40,6 +40,12 @@ func (a *AzureController) createVolume(ctx context.Context, req *proto.CreateVol
 	if err != nil {
 		return nil, err
 	}
+	cred = withResourceGroup(cred, req.ResourceGroup)
+
+	if err = a.ensureResourceGroup(ctx, cred, req.Region); err != nil {
+		return nil, err
+	}
+
 	disksClient, err := getDisksClient(cred)
 	if err != nil {
 		a.logger.Errorw("failed to get the disk client", "error", err)
@@ -120,7 +126,12 @@ func (a *AzureController) createVolume(ctx context.Context, req *proto.CreateVol
 				return
 			}
 
-			err = a.deleteSnapshot(ctx, sc, cred.ResourceGroup, req.Snapshotid)
+			//snapshot can be in a group other than the new disk
+			groupName := cred.ResourceGroup
+			if req.SnapshotUri != "" {
+				groupName = resourceGroupOf(req.SnapshotUri)
+			}
+			err = a.deleteSnapshot(ctx, sc, groupName, req.Snapshotid)
 			if err != nil {
 				//we will silently log error and return here for now, we dont want to tell the user that volume creation failed in this case.
 				a.logger.Errorw("failed to delete the snapshot", "error", err)
@@ -172,6 +183,8 @@ func (a *AzureController) deleteVolume(ctx context.Context, req *proto.DeleteVol
 	if err != nil {
 		return nil, err
 	}
+	cred = withResourceGroup(cred, req.ResourceGroup)
+
 	disksClient, err := getDisksClient(cred)
 	if err != nil {
 		a.logger.Errorw("failed to get the disk client", "error", err)
@@ -183,5 +196,9 @@ func (a *AzureController) deleteVolume(ctx context.Context, req *proto.DeleteVol
 	if err != nil {
 		return nil, err
 	}
+
+	if err = a.releaseResourceGroup(ctx, cred); err != nil {
+		a.logger.Warnw("failed to release resource group of the disk", "name", name, "resource-group", cred.ResourceGroup, "error", err)
+	}
 	return &proto.DeleteVolumeResponse{Deleted: true}, nil
 }
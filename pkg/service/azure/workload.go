@@ -0,0 +1,243 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/authorization/mgmt/authorization"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/msi/mgmt/msi"
+	aksPreview "github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-01-02-preview/containerservice"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/kube"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/labels"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	workloadIdentityClientIDAnnotation = "azure.workload.identity/client-id"
+	workloadIdentityTenantIDAnnotation = "azure.workload.identity/tenant-id"
+	workloadIdentityUseLabel           = "azure.workload.identity/use"
+	tokenExchangeAudience              = "api://AzureADTokenExchange"
+
+	//federatedCredentialAPIVersion federated identity credentials are not in the msi sdk yet, they are created over REST
+	federatedCredentialAPIVersion = "2022-01-31-preview"
+
+	//workload identity webhook injects the token exchange env and projected token into the pods of bound service accounts
+	workloadIdentityNamespace  = "azure-workload-identity-system"
+	workloadIdentityRelease    = "workload-identity-webhook"
+	workloadIdentityDeployment = "azure-wi-webhook-controller-manager"
+	workloadIdentityRepo       = "https://azure.github.io/azure-workload-identity/charts"
+
+	roleAssignmentRetries    = 6
+	roleAssignmentRetryDelay = time.Second * 10
+)
+
+//enableOIDCIssuer enable the OIDC issuer on the cluster if it is not, returns the issuer url and cluster location
+func (a AzureController) enableOIDCIssuer(ctx context.Context, client *aksPreview.ManagedClustersClient, resourceGroup, clusterName string) (string, string, error) {
+	clstr, err := client.Get(ctx, resourceGroup, clusterName)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get cluster '%s'", clusterName)
+	}
+
+	if !isSpawnerTagged(clstr.Tags) {
+		return "", "", fmt.Errorf("cluster '%s' not available in scope '%s'", clusterName, labels.ScopeTag())
+	}
+
+	if clstr.ManagedClusterProperties == nil {
+		return "", "", fmt.Errorf("cluster '%s' has no properties", clusterName)
+	}
+
+	if p := clstr.OidcIssuerProfile; p != nil && to.Bool(p.Enabled) && to.String(p.IssuerURL) != "" {
+		return to.String(p.IssuerURL), to.String(clstr.Location), nil
+	}
+
+	a.logger.Infow("enabling OIDC issuer on cluster", "cluster", clusterName)
+	clstr.OidcIssuerProfile = &aksPreview.ManagedClusterOIDCIssuerProfile{Enabled: to.BoolPtr(true)}
+
+	//Doc : https://docs.microsoft.com/en-us/rest/api/aks/managed-clusters/create-or-update
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, clusterName, clstr)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to enable OIDC issuer on cluster '%s'", clusterName)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return "", "", errors.Wrapf(err, "failed to enable OIDC issuer on cluster '%s'", clusterName)
+	}
+
+	clstr, err = client.Get(ctx, resourceGroup, clusterName)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get cluster '%s'", clusterName)
+	}
+	if clstr.OidcIssuerProfile == nil || to.String(clstr.OidcIssuerProfile.IssuerURL) == "" {
+		return "", "", fmt.Errorf("cluster '%s' has no OIDC issuer url", clusterName)
+	}
+	return to.String(clstr.OidcIssuerProfile.IssuerURL), to.String(clstr.Location), nil
+}
+
+//createFederatedCredential trust the service account tokens issued by the cluster for the managed identity
+func createFederatedCredential(ctx context.Context, client *msi.UserAssignedIdentitiesClient, resourceGroup, identity, name, issuer, subject string) error {
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s/federatedIdentityCredentials/%s",
+		client.SubscriptionID, resourceGroup, identity, name)
+
+	body := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"issuer":    issuer,
+			"subject":   subject,
+			"audiences": []string{tokenExchangeAudience},
+		},
+	}
+	return putResource(ctx, client.Client, client.BaseURI, id, federatedCredentialAPIVersion, body, false)
+}
+
+//roleDefinitionID role definition can be given as guid or as full resource id
+func roleDefinitionID(subscriptionID, role string) string {
+	if strings.HasPrefix(role, "/") {
+		return role
+	}
+	return fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s", subscriptionID, role)
+}
+
+//assignRoles assign the roles to the principal on the scope, assignment name is derived from its content so re-runs are no op.
+//new identities take a while to replicate, assignments are retried until the principal is found
+func (a AzureController) assignRoles(ctx context.Context, client *authorization.RoleAssignmentsClient, scope, principalID string, roles []string) error {
+	for _, role := range roles {
+		roleID := roleDefinitionID(client.SubscriptionID, role)
+		name := uuid.NewSHA1(uuid.NameSpaceURL, []byte(scope+principalID+roleID)).String()
+		params := authorization.RoleAssignmentCreateParameters{
+			Properties: &authorization.RoleAssignmentProperties{
+				RoleDefinitionID: &roleID,
+				PrincipalID:      &principalID,
+			},
+		}
+
+		var err error
+		for i := 0; i < roleAssignmentRetries; i++ {
+			_, err = client.Create(ctx, scope, name, params)
+			if err == nil || !strings.Contains(err.Error(), "PrincipalNotFound") {
+				break
+			}
+			time.Sleep(roleAssignmentRetryDelay)
+		}
+		if err != nil && !strings.Contains(err.Error(), "RoleAssignmentExists") {
+			return errors.Wrapf(err, "failed to assign role '%s'", role)
+		}
+	}
+	return nil
+}
+
+//ensureWorkloadIdentityWebhook install the workload identity webhook if cluster does not have it
+func ensureWorkloadIdentityWebhook(ctx context.Context, client kubernetes.Interface, tenantID string) error {
+	_, err := client.AppsV1().Deployments(workloadIdentityNamespace).Get(ctx, workloadIdentityDeployment, metav1.GetOptions{})
+	if err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return kube.InstallHelmChart(ctx, client, kube.HelmChart{
+		Release:   workloadIdentityRelease,
+		Namespace: workloadIdentityNamespace,
+		Repo:      workloadIdentityRepo,
+		Chart:     workloadIdentityRelease,
+		Set:       map[string]string{"azureTenantID": tenantID},
+	})
+}
+
+func workloadIdentityName(cluster, namespace, serviceAccount string) string {
+	return fmt.Sprintf("%s-%s-%s", cluster, namespace, serviceAccount)
+}
+
+func (a AzureController) bindServiceAccountRole(ctx context.Context, req *proto.BindServiceAccountRoleRequest) (*proto.BindServiceAccountRoleResponse, error) {
+	if req.Namespace == "" || req.ServiceAccount == "" {
+		return nil, errors.New("namespace and service account must be provided")
+	}
+
+	cred, err := a.clusterCredentials(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	aksClient, err := getAKSPreviewClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "bindServiceAccountRole: cannot get AKS client")
+	}
+
+	issuer, location, err := a.enableOIDCIssuer(ctx, aksClient, cred.ResourceGroup, req.ClusterName)
+	if err != nil {
+		a.logger.Errorw("failed to enable OIDC issuer", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+
+	identityClient, err := getUserAssignedIdentitiesClient(cred)
+	if err != nil {
+		return nil, errors.Wrap(err, "bindServiceAccountRole: cannot get managed identity client")
+	}
+
+	name := req.RoleName
+	if name == "" {
+		name = workloadIdentityName(req.ClusterName, req.Namespace, req.ServiceAccount)
+	}
+
+	identity, err := identityClient.CreateOrUpdate(ctx, cred.ResourceGroup, name, msi.Identity{
+		Location: &location,
+		Tags:     labels.DefaultTags(),
+	})
+	if err != nil {
+		a.logger.Errorw("failed to create managed identity", "cluster", req.ClusterName, "identity", name, "error", err)
+		return nil, err
+	}
+	if identity.UserAssignedIdentityProperties == nil || identity.ClientID == nil || identity.PrincipalID == nil {
+		return nil, fmt.Errorf("managed identity '%s' has no client id", name)
+	}
+
+	subject := fmt.Sprintf("system:serviceaccount:%s:%s", req.Namespace, req.ServiceAccount)
+	if err = createFederatedCredential(ctx, identityClient, cred.ResourceGroup, name, name, issuer, subject); err != nil {
+		a.logger.Errorw("failed to create federated credential", "cluster", req.ClusterName, "identity", name, "error", err)
+		return nil, err
+	}
+
+	if len(req.PolicyArns) > 0 {
+		roleClient, err := getRoleAssignmentsClient(cred)
+		if err != nil {
+			return nil, errors.Wrap(err, "bindServiceAccountRole: cannot get role assignments client")
+		}
+
+		scope := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", cred.SubscriptionID, cred.ResourceGroup)
+		if err = a.assignRoles(ctx, roleClient, scope, identity.PrincipalID.String(), req.PolicyArns); err != nil {
+			a.logger.Errorw("failed to assign roles to managed identity", "cluster", req.ClusterName, "identity", name, "error", err)
+			return nil, err
+		}
+	}
+
+	k8s, err := a.getK8sClient(ctx, req.AccountName, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ensureWorkloadIdentityWebhook(ctx, k8s, cred.TenantID); err != nil {
+		a.logger.Errorw("failed to install workload identity webhook", "cluster", req.ClusterName, "error", err)
+		return nil, err
+	}
+
+	clientID := identity.ClientID.String()
+	annotations := map[string]string{
+		workloadIdentityClientIDAnnotation: clientID,
+		workloadIdentityTenantIDAnnotation: cred.TenantID,
+	}
+	saLabels := map[string]string{workloadIdentityUseLabel: "true"}
+	if err = kube.BindServiceAccount(ctx, k8s, req.Namespace, req.ServiceAccount, annotations, saLabels); err != nil {
+		a.logger.Errorw("failed to annotate service account", "cluster", req.ClusterName, "namespace", req.Namespace, "serviceaccount", req.ServiceAccount, "error", err)
+		return nil, err
+	}
+
+	a.logger.Infow("bound managed identity to service account", "cluster", req.ClusterName, "namespace", req.Namespace, "serviceaccount", req.ServiceAccount, "identity", name)
+	return &proto.BindServiceAccountRoleResponse{
+		RoleArn:    to.String(identity.ID),
+		ClientId:   clientID,
+		OidcIssuer: issuer,
+	}, nil
+}
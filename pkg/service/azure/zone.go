@@ -0,0 +1,80 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//skuZones zones of the region where the sku can be deployed by the subscription
+func skuZones(sku *compute.ResourceSku, region string) map[string]struct{} {
+	zones := map[string]struct{}{}
+	if sku.LocationInfo != nil {
+		for _, l := range *sku.LocationInfo {
+			if l.Location == nil || !strings.EqualFold(*l.Location, region) || l.Zones == nil {
+				continue
+			}
+			for _, z := range *l.Zones {
+				zones[z] = struct{}{}
+			}
+		}
+	}
+
+	if sku.Restrictions != nil {
+		for _, r := range *sku.Restrictions {
+			if r.Type != compute.ResourceSkuRestrictionsTypeZone || r.RestrictionInfo == nil || r.RestrictionInfo.Zones == nil {
+				continue
+			}
+			for _, z := range *r.RestrictionInfo.Zones {
+				delete(zones, z)
+			}
+		}
+	}
+	return zones
+}
+
+//normalizeZone accepts both zone number and region qualified zone i.e 1 and eastus-1
+func normalizeZone(region, zone string) string {
+	return strings.TrimPrefix(strings.ToLower(zone), strings.ToLower(region)+"-")
+}
+
+//poolZones requested zones of the node pool validated against the vm size zones in the region, nil when not requested
+func poolZones(ctx context.Context, cred *system.AzureCredential, region string, spec *proto.NodeSpec) (*[]string, error) {
+	if len(spec.AvailabilityZones) == 0 {
+		return nil, nil
+	}
+
+	instance, _, err := nodeInstance(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	sku, err := findSku(ctx, cred, region, instance)
+	if err != nil {
+		return nil, err
+	}
+	if sku == nil {
+		return nil, fmt.Errorf("'%s' is not offered in %s", instance, region)
+	}
+
+	available := skuZones(sku, region)
+	zones := []string{}
+	invalid := []string{}
+	for _, z := range spec.AvailabilityZones {
+		zone := normalizeZone(region, z)
+		if _, ok := available[zone]; !ok {
+			invalid = append(invalid, z)
+			continue
+		}
+		zones = append(zones, zone)
+	}
+
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid availability zones %s for '%s' in region '%s'", strings.Join(invalid, ", "), instance, region)
+	}
+	return &zones, nil
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+)
+
+//PassedCheck capacity check which passed
+func PassedCheck(name, reason string) *proto.CapacityCheck {
+	return &proto.CapacityCheck{Name: name, Status: constants.CapacityPassed, Reason: reason}
+}
+
+//WarningCheck capacity check which passed, but provisioning might still run into the limit
+func WarningCheck(name, reason string) *proto.CapacityCheck {
+	return &proto.CapacityCheck{Name: name, Status: constants.CapacityWarning, Reason: reason}
+}
+
+//FailedCheck capacity check which failed, provisioning would fail
+func FailedCheck(name, reason string) *proto.CapacityCheck {
+	return &proto.CapacityCheck{Name: name, Status: constants.CapacityFailed, Reason: reason}
+}
+
+//CapacityResponse response for the checks, ok is set when none of the checks failed
+func CapacityResponse(checks []*proto.CapacityCheck) *proto.CheckCapacityResponse {
+	ok := true
+	for _, c := range checks {
+		if c.Status == constants.CapacityFailed {
+			ok = false
+			break
+		}
+	}
+	return &proto.CheckCapacityResponse{Ok: ok, Checks: checks}
+}
+
+func capacityReasons(resp *proto.CheckCapacityResponse, status string) []string {
+	reasons := []string{}
+	for _, c := range resp.Checks {
+		if c.Status == status {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", c.Name, c.Reason))
+		}
+	}
+	return reasons
+}
+
+//CapacityError error listing the failed checks, nil when provisioning can go ahead
+func CapacityError(resp *proto.CheckCapacityResponse) error {
+	if resp.Ok {
+		return nil
+	}
+	return fmt.Errorf("capacity preflight failed, %s", strings.Join(capacityReasons(resp, constants.CapacityFailed), "; "))
+}
+
+//CapacityWarnings reasons of the checks which passed with warning
+func CapacityWarnings(resp *proto.CheckCapacityResponse) []string {
+	return capacityReasons(resp, constants.CapacityWarning)
+}
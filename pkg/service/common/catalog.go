@@ -0,0 +1,145 @@
+package common
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+//ArchARM64 architecture of arm machine types, rest are x86_64
+const ArchARM64 = "arm64"
+
+//go:embed machines.yaml
+var defaultCatalog []byte
+
+type GPU struct {
+	Model string `json:"model"`
+	Count int32  `json:"count"`
+}
+
+//MachineType spawner machine type and the instance which backs it on each provider
+type MachineType struct {
+	Name string `json:"name"`
+	VCPU int32  `json:"vcpu"`
+	//Memory in GiB
+	Memory       int32             `json:"memory"`
+	Architecture string            `json:"architecture"`
+	GPU          *GPU              `json:"gpu,omitempty"`
+	Instances    map[string]string `json:"instances"`
+	//Regions optional per provider region restriction, instance is considered available everywhere when not listed
+	Regions map[string][]string `json:"regions,omitempty"`
+}
+
+//Catalog versioned list of machine types
+type Catalog struct {
+	Version  string        `json:"version"`
+	Machines []MachineType `json:"machines"`
+
+	index map[string]*MachineType
+}
+
+var (
+	catalogMu sync.RWMutex
+	catalog   *Catalog
+)
+
+func init() {
+	c, err := ParseCatalog(defaultCatalog)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded machine catalog: %s", err))
+	}
+	catalog = c
+}
+
+//ParseCatalog parse catalog in yaml or json format
+func ParseCatalog(data []byte) (*Catalog, error) {
+	c := &Catalog{}
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096).Decode(c); err != nil {
+		return nil, errors.Wrap(err, "ParseCatalog")
+	}
+
+	if c.Version == "" {
+		return nil, errors.New("ParseCatalog: catalog version must be set")
+	}
+
+	c.index = make(map[string]*MachineType, len(c.Machines))
+	for i := range c.Machines {
+		m := &c.Machines[i]
+		if m.Name == "" {
+			return nil, fmt.Errorf("ParseCatalog: machine type at %d has no name", i)
+		}
+		if _, ok := c.index[m.Name]; ok {
+			return nil, fmt.Errorf("ParseCatalog: duplicate machine type '%s'", m.Name)
+		}
+		c.index[m.Name] = m
+	}
+	return c, nil
+}
+
+//LoadCatalog replace the embedded catalog with the one from the file
+func LoadCatalog(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "LoadCatalog")
+	}
+
+	c, err := ParseCatalog(data)
+	if err != nil {
+		return err
+	}
+
+	catalogMu.Lock()
+	catalog = c
+	catalogMu.Unlock()
+	return nil
+}
+
+//GetCatalog machine type catalog in use
+func GetCatalog() *Catalog {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	return catalog
+}
+
+//Get machine type by name
+func (c *Catalog) Get(name string) (MachineType, bool) {
+	m, ok := c.index[name]
+	if !ok {
+		return MachineType{}, false
+	}
+	return *m, true
+}
+
+//AvailableIn whether machine type has instance for the provider in the region, empty region matches all
+func (m MachineType) AvailableIn(provider, region string) bool {
+	if _, ok := m.Instances[provider]; !ok {
+		return false
+	}
+
+	regions, ok := m.Regions[provider]
+	if !ok || len(regions) == 0 || region == "" {
+		return true
+	}
+
+	for _, r := range regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+//IsGPU machine type has GPUs attached
+func (m MachineType) IsGPU() bool {
+	return m.GPU != nil && m.GPU.Count > 0
+}
+
+//IsARM machine type runs on arm64 processors
+func (m MachineType) IsARM() bool {
+	return m.Architecture == ArchARM64
+}
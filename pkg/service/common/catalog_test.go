@@ -0,0 +1,46 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseCatalog(t *testing.T) {
+
+	c, err := ParseCatalog([]byte(`
+version: "1"
+machines:
+  - name: m+arm
+    vcpu: 8
+    memory: 32
+    architecture: arm64
+    instances:
+      aws: m6g.2xlarge
+    regions:
+      aws: [us-east-1]
+`))
+	assert.NoError(t, err, "ParseCatalog: valid catalog")
+
+	m, ok := c.Get("m+arm")
+	assert.True(t, ok, "Get('m+arm')")
+	assert.Equal(t, int32(8), m.VCPU)
+	assert.False(t, m.IsGPU(), "expected non-gpu machine")
+	assert.True(t, m.AvailableIn("aws", "us-east-1"), "AvailableIn: listed region")
+	assert.True(t, m.AvailableIn("aws", ""), "AvailableIn: any region")
+	assert.False(t, m.AvailableIn("aws", "eu-west-1"), "AvailableIn: unlisted region")
+	assert.False(t, m.AvailableIn("azure", ""), "AvailableIn: provider without instance")
+
+	_, err = ParseCatalog([]byte(`{"version": "1", "machines": [{"name": "s"}, {"name": "s"}]}`))
+	assert.Error(t, err, "ParseCatalog: duplicate machine type")
+
+	_, err = ParseCatalog([]byte(`{"machines": []}`))
+	assert.Error(t, err, "ParseCatalog: missing version")
+}
+
+func Test_DefaultCatalog(t *testing.T) {
+
+	assert.Equal(t, "p4d.24xlarge", GetInstance("aws", XLa100), "GetInstance('aws', 'xl+a100')")
+	assert.True(t, IsGPU(XLh100), "expected gpu machine")
+	assert.False(t, IsGPU(MArm), "expected non-gpu machine")
+}
@@ -25,4 +25,7 @@ func Test_GetInstance(t *testing.T) {
 
 	assert.True(t, IsGPU(Lk80), "expected gpu machine")
 	assert.False(t, IsGPU(M), "expected non-gpu machine")
+
+	assert.True(t, IsARM(MArm), "expected arm machine")
+	assert.False(t, IsARM(M), "expected x86 machine")
 }
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+)
+
+//UpgradeTimeout time spawner keeps upgrading the node pools of the cluster in the background, defaults to 3 hours
+func UpgradeTimeout() time.Duration {
+	t := config.Get().ClusterUpgradeTimeout
+	if t <= 0 {
+		t = 180
+	}
+	return time.Minute * time.Duration(t)
+}
+
+func versionParts(v string) []int {
+	v = strings.TrimPrefix(v, "v")
+	//drop pre-release or provider suffix, 1.21.5-eks-9017834
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+
+	parts := []int{}
+	for _, p := range strings.Split(v, ".") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+//CompareVersion compare kubernetes versions such as "1.21" or "v1.22.6", returns -1 if a < b, 0 if equal and 1 if a > b.
+//Missing components are treated as 0, so "1.21" and "1.21.0" are equal.
+func CompareVersion(a, b string) int {
+	pa := versionParts(a)
+	pb := versionParts(b)
+
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		x, y := 0, 0
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x < y {
+			return -1
+		}
+		if x > y {
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CompareVersion(t *testing.T) {
+
+	assert.Equal(t, 0, CompareVersion("1.21", "1.21.0"), "CompareVersion: missing patch")
+	assert.Equal(t, -1, CompareVersion("1.21", "1.22"), "CompareVersion: older minor")
+	assert.Equal(t, 1, CompareVersion("1.10", "1.9"), "CompareVersion: numeric compare")
+	assert.Equal(t, 0, CompareVersion("v1.21.5-eks-9017834", "1.21.5"), "CompareVersion: provider suffix")
+	assert.Equal(t, 1, CompareVersion("1.22.6", "1.22.4"), "CompareVersion: newer patch")
+}
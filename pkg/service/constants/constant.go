@@ -19,6 +19,7 @@ var (
 	VpcTagKey                = "vpc"
 	NBTypeTagkey             = "nb-type"
 	NBRegionWkspNetworkStack = "nb-region-ntwk-stk"
+	SubnetTierTagKey         = "subnet-tier"
 	WorkspaceId              = "workspaceid"
 	AzureLabel               = "azure"
 	GcpLabel                 = "gcp"
@@ -37,6 +38,48 @@ const (
 	Inactive = "inactive"
 )
 
+//upgrade step status
+const (
+	UpgradePending    = "pending"
+	UpgradeInProgress = "in-progress"
+	UpgradeSuccessful = "successful"
+	UpgradeFailed     = "failed"
+)
+
+//upgrade step resource type
+const (
+	UpgradeTypeCluster  = "cluster"
+	UpgradeTypeNodePool = "nodepool"
+)
+
+//bootstrap step status
+const (
+	BootstrapPending    = "pending"
+	BootstrapSuccessful = "successful"
+	BootstrapFailed     = "failed"
+)
+
+//bootstrap step type
+const (
+	BootstrapTypeNamespace = "namespace"
+	BootstrapTypeManifest  = "manifest"
+	BootstrapTypeChart     = "chart"
+)
+
+//capacity check status
+const (
+	CapacityPassed  = "passed"
+	CapacityWarning = "warning"
+	CapacityFailed  = "failed"
+)
+
+//capacity check names
+const (
+	CheckInstanceOffering = "instance-offering"
+	CheckVCPUQuota        = "vcpu-quota"
+	CheckSKURestriction   = "sku-restriction"
+)
+
 const ActualCost string = "ActualCost"
 
 const (
@@ -26,4 +26,26 @@ type Controller interface {
 	GetKubeConfig(ctx context.Context, in *proto.GetKubeConfigRequest) (*proto.GetKubeConfigResponse, error)
 	TagNodeInstance(ctx context.Context, req *proto.TagNodeInstanceRequest) (*proto.TagNodeInstanceResponse, error)
 	GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error)
+	ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error)
+	UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error)
+	UpgradeNodePool(ctx context.Context, req *proto.UpgradeNodePoolRequest) (*proto.UpgradeNodePoolResponse, error)
+	GetUpgradeStatus(ctx context.Context, req *proto.GetUpgradeStatusRequest) (*proto.GetUpgradeStatusResponse, error)
+	CheckCapacity(ctx context.Context, req *proto.CheckCapacityRequest) (*proto.CheckCapacityResponse, error)
+	GetNetworkStack(ctx context.Context, req *proto.GetNetworkStackRequest) (*proto.GetNetworkStackResponse, error)
+	ListNetworkStacks(ctx context.Context, req *proto.ListNetworkStacksRequest) (*proto.ListNetworkStacksResponse, error)
+	DeleteNetworkStack(ctx context.Context, req *proto.DeleteNetworkStackRequest) (*proto.DeleteNetworkStackResponse, error)
+	ListAddons(ctx context.Context, req *proto.ListAddonsRequest) (*proto.ListAddonsResponse, error)
+	InstallAddon(ctx context.Context, req *proto.InstallAddonRequest) (*proto.InstallAddonResponse, error)
+	UpdateAddon(ctx context.Context, req *proto.UpdateAddonRequest) (*proto.UpdateAddonResponse, error)
+	RemoveAddon(ctx context.Context, req *proto.RemoveAddonRequest) (*proto.RemoveAddonResponse, error)
+	BindServiceAccountRole(ctx context.Context, req *proto.BindServiceAccountRoleRequest) (*proto.BindServiceAccountRoleResponse, error)
+	UpdateClusterConfig(ctx context.Context, req *proto.UpdateClusterConfigRequest) (*proto.UpdateClusterConfigResponse, error)
+	StopCluster(ctx context.Context, req *proto.StopClusterRequest) (*proto.StopClusterResponse, error)
+	StartCluster(ctx context.Context, req *proto.StartClusterRequest) (*proto.StartClusterResponse, error)
+	GetBootstrapStatus(ctx context.Context, req *proto.GetBootstrapStatusRequest) (*proto.GetBootstrapStatusResponse, error)
+	CreateTenant(ctx context.Context, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error)
+	DeleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error)
+	ListTenants(ctx context.Context, req *proto.ListTenantsRequest) (*proto.ListTenantsResponse, error)
+	AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error)
+	DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error)
 }
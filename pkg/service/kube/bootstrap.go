@@ -0,0 +1,299 @@
+package kube
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+)
+
+const (
+	//bootstrapConfigMap progress of the bootstrap is kept in the cluster, so any spawner instance can report it
+	bootstrapConfigMap   = "spawner-bootstrap"
+	bootstrapNamespace   = "kube-system"
+	bootstrapStepsKey    = "steps"
+	bootstrapFieldOwner  = "spawner-service"
+	bootstrapPollPeriod  = time.Second * 15
+	maxManifestSizeBytes = 10 << 20
+	manifestFetchTimeout = time.Minute
+)
+
+//manifestClient fetch of a manifest must not hang the bootstrap on a slow server
+var manifestClient = &http.Client{Timeout: manifestFetchTimeout}
+
+//ValidateBootstrap bundle is checked before the cluster is created, invalid steps would only fail once it is up
+func ValidateBootstrap(bundle *proto.Bootstrap) error {
+	for i, m := range bundle.GetManifests() {
+		if (m.Url == "") == (m.Yaml == "") {
+			return fmt.Errorf("bootstrap manifest %d must have one of url or yaml", i)
+		}
+	}
+	for _, c := range bundle.GetCharts() {
+		if c.Release == "" || c.Namespace == "" || c.Repo == "" || c.Chart == "" {
+			return fmt.Errorf("bootstrap chart '%s' requires release, namespace, repo and chart", c.Release)
+		}
+	}
+	return nil
+}
+
+//BootstrapSteps steps of the bundle in the order they run, all pending
+func BootstrapSteps(bundle *proto.Bootstrap) []*proto.BootstrapStep {
+	steps := []*proto.BootstrapStep{}
+	add := func(resource, typ string) {
+		steps = append(steps, &proto.BootstrapStep{Resource: resource, Type: typ, Status: constants.BootstrapPending})
+	}
+
+	for _, ns := range bundle.GetNamespaces() {
+		add(ns, constants.BootstrapTypeNamespace)
+	}
+	for i, m := range bundle.GetManifests() {
+		if m.Url != "" {
+			add(m.Url, constants.BootstrapTypeManifest)
+		} else {
+			add(fmt.Sprintf("inline-%d", i), constants.BootstrapTypeManifest)
+		}
+	}
+	for _, c := range bundle.GetCharts() {
+		add(c.Release, constants.BootstrapTypeChart)
+	}
+	return steps
+}
+
+func recordBootstrap(ctx context.Context, client kubernetes.Interface, steps []*proto.BootstrapStep) error {
+	data, err := protojson.Marshal(&proto.GetBootstrapStatusResponse{Steps: steps})
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: bootstrapConfigMap, Namespace: bootstrapNamespace},
+		Data:       map[string]string{bootstrapStepsKey: string(data)},
+	}
+	cms := client.CoreV1().ConfigMaps(bootstrapNamespace)
+	_, err = cms.Update(ctx, cm, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = cms.Create(ctx, cm, metav1.CreateOptions{})
+	}
+	return err
+}
+
+//BootstrapStatus steps recorded by the bootstrap of the cluster, empty when there was none
+func BootstrapStatus(ctx context.Context, client kubernetes.Interface) ([]*proto.BootstrapStep, error) {
+	cm, err := client.CoreV1().ConfigMaps(bootstrapNamespace).Get(ctx, bootstrapConfigMap, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []*proto.BootstrapStep{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "BootstrapStatus: failed to get bootstrap config map")
+	}
+
+	status := &proto.GetBootstrapStatusResponse{}
+	if err = protojson.Unmarshal([]byte(cm.Data[bootstrapStepsKey]), status); err != nil {
+		return nil, errors.Wrap(err, "BootstrapStatus: invalid bootstrap status")
+	}
+	return status.Steps, nil
+}
+
+//waitForReadyNode workloads of the bootstrap need somewhere to run, helm waits on them
+func waitForReadyNode(ctx context.Context, client kubernetes.Interface) error {
+	for {
+		nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return errors.Wrap(err, "failed to list nodes")
+		}
+		for _, n := range nodes.Items {
+			for _, c := range n.Status.Conditions {
+				if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+					return nil
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "no node of the cluster became ready")
+		case <-time.After(bootstrapPollPeriod):
+		}
+	}
+}
+
+func fetchManifest(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := manifestClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch manifest '%s'", url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch manifest '%s': %s", url, resp.Status)
+	}
+	//one byte over the limit tells a manifest that is too large from one that fits exactly
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxManifestSizeBytes+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest '%s'", url)
+	}
+	if len(data) > maxManifestSizeBytes {
+		return nil, fmt.Errorf("manifest '%s' is larger than %d bytes", url, maxManifestSizeBytes)
+	}
+	return data, nil
+}
+
+//applyObject server side apply, so re-runs update what an earlier run created
+func applyObject(ctx context.Context, client dynamic.Interface, mapper meta.ResettableRESTMapper, obj *unstructured.Unstructured) error {
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		//kind may come from a CRD applied earlier in the same bundle
+		mapper.Reset()
+		mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "unknown kind '%s'", gvk)
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	var resource dynamic.ResourceInterface = client.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		ns := obj.GetNamespace()
+		if ns == "" {
+			ns = corev1.NamespaceDefault
+		}
+		resource = client.Resource(mapping.Resource).Namespace(ns)
+	}
+
+	force := true
+	_, err = resource.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: bootstrapFieldOwner,
+		Force:        &force,
+	})
+	return errors.Wrapf(err, "failed to apply %s '%s'", gvk.Kind, obj.GetName())
+}
+
+//ApplyManifest apply every object of the multi document yaml in order
+func ApplyManifest(ctx context.Context, client dynamic.Interface, mapper meta.ResettableRESTMapper, manifest []byte) error {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		err := decoder.Decode(&obj.Object)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "invalid manifest")
+		}
+		//empty documents between separators
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		if err = applyObject(ctx, client, mapper, obj); err != nil {
+			return err
+		}
+	}
+}
+
+//Bootstrap create namespaces, apply manifests and install charts of the bundle once the cluster has a ready node.
+//A failed step does not stop the rest, result of every step is recorded for BootstrapStatus
+func Bootstrap(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, bundle *proto.Bootstrap, logger *zap.SugaredLogger) error {
+	steps := BootstrapSteps(bundle)
+	if len(steps) == 0 {
+		return nil
+	}
+
+	if err := recordBootstrap(ctx, client, steps); err != nil {
+		return errors.Wrap(err, "Bootstrap: failed to record bootstrap steps")
+	}
+
+	if err := waitForReadyNode(ctx, client); err != nil {
+		for _, step := range steps {
+			step.Status = constants.BootstrapFailed
+			step.Error = err.Error()
+		}
+		//ctx may be done already, status is recorded with a fresh one
+		recordCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if rerr := recordBootstrap(recordCtx, client, steps); rerr != nil {
+			logger.Warnw("failed to record bootstrap failure", "error", rerr)
+		}
+		return err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client.Discovery()))
+
+	i := 0
+	done := func(err error) {
+		step := steps[i]
+		i++
+
+		step.Status = constants.BootstrapSuccessful
+		if err != nil {
+			step.Status = constants.BootstrapFailed
+			step.Error = err.Error()
+			logger.Errorw("bootstrap step failed", "type", step.Type, "resource", step.Resource, "error", err)
+		} else {
+			logger.Infow("bootstrap step done", "type", step.Type, "resource", step.Resource)
+		}
+
+		if err := recordBootstrap(ctx, client, steps); err != nil {
+			logger.Warnw("failed to record bootstrap step", "type", step.Type, "resource", step.Resource, "error", err)
+		}
+	}
+
+	for _, ns := range bundle.Namespaces {
+		done(EnsureNamespace(ctx, client, ns))
+	}
+
+	for _, m := range bundle.Manifests {
+		manifest := []byte(m.Yaml)
+		var err error
+		if m.Url != "" {
+			manifest, err = fetchManifest(ctx, m.Url)
+		}
+		if err == nil {
+			err = ApplyManifest(ctx, dynamicClient, mapper, manifest)
+		}
+		done(err)
+	}
+
+	for _, c := range bundle.Charts {
+		done(InstallHelmChart(ctx, client, HelmChart{
+			Release:   c.Release,
+			Namespace: c.Namespace,
+			Repo:      c.Repo,
+			Chart:     c.Chart,
+			Version:   c.Version,
+			Set:       c.Set,
+			Values:    c.Values,
+		}))
+	}
+	return nil
+}
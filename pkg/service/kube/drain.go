@@ -0,0 +1,210 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/config"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+//evictionRetryInterval interval between eviction attempts when PodDisruptionBudget does not allow disruption
+const evictionRetryInterval = time.Second * 5
+
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+//Cordon mark the node unschedulable
+func Cordon(ctx context.Context, client kubernetes.Interface, node string) error {
+	patch := []byte(`{"spec":{"unschedulable":true}}`)
+	_, err := client.CoreV1().Nodes().Patch(ctx, node, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+//evictablePods pods running on the node which needs to be evicted, daemonset and mirror pods are skipped since
+//they are bound to node and would not be rescheduled anyway.
+func evictablePods(ctx context.Context, client kubernetes.Interface, node string) ([]corev1.Pod, error) {
+	pods, err := client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]corev1.Pod, 0, len(pods.Items))
+	for _, p := range pods.Items {
+		if _, ok := p.Annotations[mirrorPodAnnotation]; ok {
+			continue
+		}
+		if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		daemon := false
+		for _, o := range p.OwnerReferences {
+			if o.Kind == "DaemonSet" {
+				daemon = true
+				break
+			}
+		}
+		if daemon {
+			continue
+		}
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
+
+//supportsPolicyV1 policy/v1 eviction is available from kubernetes 1.22, older clusters only serve v1beta1
+func supportsPolicyV1(client kubernetes.Interface) bool {
+	_, err := client.Discovery().ServerResourcesForGroupVersion("policy/v1")
+	return err == nil
+}
+
+func evict(ctx context.Context, client kubernetes.Interface, pod corev1.Pod, v1 bool) error {
+	meta := metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}
+	if v1 {
+		return client.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{ObjectMeta: meta})
+	}
+	return client.PolicyV1beta1().Evictions(pod.Namespace).Evict(ctx, &policyv1beta1.Eviction{ObjectMeta: meta})
+}
+
+//evictPod evict the pod, retries as long as PodDisruptionBudget blocks the eviction or ctx is done
+func evictPod(ctx context.Context, client kubernetes.Interface, pod corev1.Pod, v1 bool) error {
+	for {
+		err := evict(ctx, client, pod, v1)
+		if err == nil || apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		//429 is returned when the eviction would violate PodDisruptionBudget
+		if !apierrors.IsTooManyRequests(err) {
+			return errors.Wrapf(err, "failed to evict pod '%s/%s'", pod.Namespace, pod.Name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "pod '%s/%s' eviction blocked by disruption budget", pod.Namespace, pod.Name)
+		case <-time.After(evictionRetryInterval):
+		}
+	}
+}
+
+//waitForPodGone wait until the evicted pod is terminated or replaced by pod with new UID
+func waitForPodGone(ctx context.Context, client kubernetes.Interface, pod corev1.Pod) error {
+	for {
+		p, err := client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && p.UID != pod.UID) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to get pod '%s/%s'", pod.Namespace, pod.Name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "pod '%s/%s' still terminating", pod.Namespace, pod.Name)
+		case <-time.After(evictionRetryInterval):
+		}
+	}
+}
+
+//evictAndWait evict the pod and wait until it is terminated
+func evictAndWait(ctx context.Context, client kubernetes.Interface, pod corev1.Pod, v1 bool) error {
+	if err := evictPod(ctx, client, pod, v1); err != nil {
+		return err
+	}
+	return waitForPodGone(ctx, client, pod)
+}
+
+//Drain cordon all nodes matching the label selector and evict their pods respecting PodDisruptionBudgets.
+//
+// All nodes are cordoned first, so evicted pods are not rescheduled on the nodes which are about to be drained.
+// Drain returns when all pods are evicted and terminated, or when ctx is done, callers are expected to set the deadline.
+func Drain(ctx context.Context, client kubernetes.Interface, selector string, logger *zap.SugaredLogger) error {
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.Wrap(err, "drain: failed to list nodes")
+	}
+
+	if len(nodes.Items) == 0 {
+		logger.Infow("no nodes found to drain", "selector", selector)
+		return nil
+	}
+
+	for _, n := range nodes.Items {
+		if err := Cordon(ctx, client, n.Name); err != nil {
+			return errors.Wrapf(err, "drain: failed to cordon node '%s'", n.Name)
+		}
+		logger.Infow("node cordoned", "node", n.Name)
+	}
+
+	v1 := supportsPolicyV1(client)
+	pods := []corev1.Pod{}
+	for _, n := range nodes.Items {
+		p, err := evictablePods(ctx, client, n.Name)
+		if err != nil {
+			return errors.Wrapf(err, "drain: failed to list pods on node '%s'", n.Name)
+		}
+		logger.Infow("evicting pods from node", "node", n.Name, "count", len(p))
+		pods = append(pods, p...)
+	}
+
+	//pods are evicted concurrently as kubectl drain does, pod blocked by its disruption budget does not hold back the rest
+	errs := make([]error, len(pods))
+	var wg sync.WaitGroup
+	for i := range pods {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = evictAndWait(ctx, client, pods[i], v1)
+		}(i)
+	}
+	wg.Wait()
+
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return errors.Wrap(err, "drain")
+	}
+
+	logger.Infow("nodes drained", "selector", selector, "nodes", len(nodes.Items), "pods", len(pods))
+	return nil
+}
+
+//DrainTimeout request specific timeout if set, otherwise configured drain timeout, defaults to 5 minutes
+func DrainTimeout(seconds int32) time.Duration {
+	if seconds <= 0 {
+		seconds = config.Get().DrainTimeout
+	}
+	if seconds <= 0 {
+		seconds = 300
+	}
+	return time.Second * time.Duration(seconds)
+}
+
+//DrainBeforeDelete drain the nodes matching selector within the request timeout before their node pools are deleted.
+//drain failures are logged and not returned, deletion goes ahead regardless.
+func DrainBeforeDelete(ctx context.Context, client kubernetes.Interface, selector string, timeout int32, logger *zap.SugaredLogger) {
+	ctx, cancel := context.WithTimeout(ctx, DrainTimeout(timeout))
+	defer cancel()
+
+	logger.Infow("draining nodes", "selector", selector)
+	if err := Drain(ctx, client, selector, logger); err != nil {
+		logger.Warnw("drain did not complete, proceeding with deletion", "selector", selector, "error", err)
+	}
+}
+
+//NodePoolSelector label selector for the nodes of the spawner node pool
+func NodePoolSelector(labelKey, pool string) string {
+	return fmt.Sprintf("%s=%s", labelKey, pool)
+}
@@ -0,0 +1,43 @@
+package kube
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	//NodePoolKind kind used for the involved object of node pool events, there is no kubernetes object for it
+	NodePoolKind = "NodePool"
+	eventSource  = "spawner"
+)
+
+//RecordNodePoolEvent record the event against the node pool in kube-system namespace,
+//events can be listed with `kubectl get events -n kube-system --field-selector involvedObject.kind=NodePool`
+func RecordNodePoolEvent(ctx context.Context, client kubernetes.Interface, pool, eventType, reason, message string) error {
+	now := metav1.NewTime(time.Now())
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: pool + "-",
+			Namespace:    metav1.NamespaceSystem,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      NodePoolKind,
+			Name:      pool,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: eventSource},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+
+	_, err := client.CoreV1().Events(metav1.NamespaceSystem).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
@@ -0,0 +1,244 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	//helmImage spawner does not carry helm client, charts are installed by running helm as a job inside the cluster
+	helmImage = "alpine/helm:3.8.1"
+
+	helmServiceAccount = "spawner-helm"
+	helmValuesFile     = "values.yaml"
+	helmValuesPath     = "/etc/spawner/helm"
+	helmJobPollPeriod  = time.Second * 10
+)
+
+//HelmChart chart to be installed from the repository
+type HelmChart struct {
+	Release   string
+	Namespace string
+	//Repo chart repository URL
+	Repo    string
+	Chart   string
+	Version string
+	//Set values passed with --set
+	Set map[string]string
+	//Values content of values.yaml
+	Values string
+}
+
+func (h HelmChart) jobName() string {
+	return fmt.Sprintf("helm-install-%s", h.Release)
+}
+
+func (h HelmChart) args() []string {
+	args := []string{"upgrade", "--install", h.Release, h.Chart,
+		"--repo", h.Repo,
+		"--namespace", h.Namespace,
+		"--wait",
+	}
+	if h.Version != "" {
+		args = append(args, "--version", h.Version)
+	}
+
+	//keep the order stable, so re-runs produce the same job spec
+	keys := make([]string, 0, len(h.Set))
+	for k := range h.Set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--set", fmt.Sprintf("%s=%s", k, h.Set[k]))
+	}
+
+	if h.Values != "" {
+		args = append(args, "--values", fmt.Sprintf("%s/%s", helmValuesPath, helmValuesFile))
+	}
+	return args
+}
+
+func ignoreExists(err error) error {
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+//EnsureNamespace create the namespace if it does not exist
+func EnsureNamespace(ctx context.Context, client kubernetes.Interface, name string) error {
+	_, err := client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}, metav1.CreateOptions{})
+	return ignoreExists(err)
+}
+
+//ensureHelmAccess service account for the helm job, charts usually carry cluster scoped resources, so it gets cluster-admin
+func ensureHelmAccess(ctx context.Context, client kubernetes.Interface, namespace string) error {
+	_, err := client.CoreV1().ServiceAccounts(namespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: helmServiceAccount},
+	}, metav1.CreateOptions{})
+	if err = ignoreExists(err); err != nil {
+		return err
+	}
+
+	_, err = client.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-%s", helmServiceAccount, namespace)},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      helmServiceAccount,
+				Namespace: namespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	return ignoreExists(err)
+}
+
+func helmJob(chart HelmChart) *batchv1.Job {
+	backoff := int32(2)
+	ttl := int32(3600)
+
+	container := corev1.Container{
+		Name:  "helm",
+		Image: helmImage,
+		Args:  chart.args(),
+	}
+
+	spec := corev1.PodSpec{
+		ServiceAccountName: helmServiceAccount,
+		RestartPolicy:      corev1.RestartPolicyNever,
+		Containers:         []corev1.Container{container},
+	}
+
+	if chart.Values != "" {
+		spec.Volumes = []corev1.Volume{
+			{
+				Name: "values",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: chart.jobName()},
+					},
+				},
+			},
+		}
+		spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+			{
+				Name:      "values",
+				MountPath: helmValuesPath,
+				ReadOnly:  true,
+			},
+		}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      chart.jobName(),
+			Namespace: chart.Namespace,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoff,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				Spec: spec,
+			},
+		},
+	}
+}
+
+//InstallHelmChart install or upgrade the chart release and wait until the helm job completes or ctx is done
+func InstallHelmChart(ctx context.Context, client kubernetes.Interface, chart HelmChart) error {
+
+	if err := EnsureNamespace(ctx, client, chart.Namespace); err != nil {
+		return errors.Wrapf(err, "InstallHelmChart: failed to create namespace '%s'", chart.Namespace)
+	}
+
+	if err := ensureHelmAccess(ctx, client, chart.Namespace); err != nil {
+		return errors.Wrap(err, "InstallHelmChart: failed to setup helm service account")
+	}
+
+	if chart.Values != "" {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: chart.jobName(), Namespace: chart.Namespace},
+			Data:       map[string]string{helmValuesFile: chart.Values},
+		}
+		_, err := client.CoreV1().ConfigMaps(chart.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = client.CoreV1().ConfigMaps(chart.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+		}
+		if err != nil {
+			return errors.Wrap(err, "InstallHelmChart: failed to create values config map")
+		}
+	}
+
+	jobs := client.BatchV1().Jobs(chart.Namespace)
+	name := chart.jobName()
+
+	//job spec is immutable, previous run is removed so the release can be upgraded with new values
+	background := metav1.DeletePropagationBackground
+	err := jobs.Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &background})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "InstallHelmChart: failed to remove previous helm job")
+	}
+
+	for {
+		_, err = jobs.Create(ctx, helmJob(chart), metav1.CreateOptions{})
+		if !apierrors.IsAlreadyExists(err) {
+			break
+		}
+		//previous job still being deleted
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "InstallHelmChart: previous helm job not deleted")
+		case <-time.After(helmJobPollPeriod):
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, "InstallHelmChart: failed to create helm job")
+	}
+
+	return waitForJob(ctx, client, chart.Namespace, name)
+}
+
+func waitForJob(ctx context.Context, client kubernetes.Interface, namespace, name string) error {
+	for {
+		job, err := client.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get job '%s/%s'", namespace, name)
+		}
+
+		for _, c := range job.Status.Conditions {
+			if c.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch c.Type {
+			case batchv1.JobComplete:
+				return nil
+			case batchv1.JobFailed:
+				return fmt.Errorf("job '%s/%s' failed: %s", namespace, name, c.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "job '%s/%s' not completed", namespace, name)
+		case <-time.After(helmJobPollPeriod):
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package kube
+
+import (
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	//spawnerCommand spawner cli, 'get-token' implements the kubectl credential plugin
+	spawnerCommand = "spawner"
+	//execAPIVersion ExecCredential version written by 'spawner get-token'
+	execAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+//SpawnerExecConfig kubeconfig user getting its token from spawner, cloud credentials stay with spawner
+func SpawnerExecConfig(addr, provider, region, account, clusterName string) *clientcmdapi.ExecConfig {
+	args := []string{"get-token",
+		"--provider", provider,
+		"--cluster", clusterName,
+	}
+	if addr != "" {
+		args = append(args, "--addr", addr)
+	}
+	if region != "" {
+		args = append(args, "--region", region)
+	}
+	if account != "" {
+		args = append(args, "--account", account)
+	}
+
+	return &clientcmdapi.ExecConfig{
+		Command:         spawnerCommand,
+		Args:            args,
+		APIVersion:      execAPIVersion,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+}
@@ -0,0 +1,143 @@
+package kube
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	//MigConfigLabel node label read by GPU operator mig-manager to partition the GPUs
+	MigConfigLabel = "nvidia.com/mig.config"
+
+	//GPUResource resource advertised by the device plugin
+	GPUResource = "nvidia.com/gpu"
+
+	//MIG strategies, single advertises the MIG devices as nvidia.com/gpu, all the GPUs on the node has to use same profile
+	MIGStrategyNone   = "none"
+	MIGStrategySingle = "single"
+
+	devicePluginName  = "nvidia-device-plugin-daemonset"
+	devicePluginImage = "nvcr.io/nvidia/k8s-device-plugin:v0.11.0"
+
+	gpuOperatorNamespace = "gpu-operator"
+	gpuOperatorRepo      = "https://helm.ngc.nvidia.com/nvidia"
+	gpuOperatorVersion   = "v1.10.1"
+)
+
+//GPUPluginOptions how the GPU nodes are set up by the plugin
+type GPUPluginOptions struct {
+	MIGStrategy string
+	//MIGManager let GPU operator partition the GPUs based on MigConfigLabel
+	MIGManager bool
+}
+
+func devicePluginDaemonSet(migStrategy string) *appsv1.DaemonSet {
+	labels := map[string]string{"name": "nvidia-device-plugin-ds"}
+	privilegeEscalation := false
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      devicePluginName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					PriorityClassName: "system-node-critical",
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      GPUResource,
+							Operator: corev1.TolerationOpExists,
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:  "nvidia-device-plugin-ctr",
+							Image: devicePluginImage,
+							Env: []corev1.EnvVar{
+								{Name: "FAIL_ON_INIT_ERROR", Value: "false"},
+								{Name: "MIG_STRATEGY", Value: migStrategy},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: &privilegeEscalation,
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "device-plugin", MountPath: "/var/lib/kubelet/device-plugins"},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "device-plugin",
+							VolumeSource: corev1.VolumeSource{
+								HostPath: &corev1.HostPathVolumeSource{Path: "/var/lib/kubelet/device-plugins"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+//InstallDevicePlugin install NVIDIA device plugin daemonset, existing one is updated with the MIG strategy
+func InstallDevicePlugin(ctx context.Context, client kubernetes.Interface, migStrategy string) error {
+	ds := devicePluginDaemonSet(migStrategy)
+	dsClient := client.AppsV1().DaemonSets(metav1.NamespaceSystem)
+
+	_, err := dsClient.Create(ctx, ds, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = dsClient.Update(ctx, ds, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+//InstallGPUOperator install NVIDIA GPU operator, managed node images come with the driver and container toolkit,
+//so the operator only runs the device plugin, feature discovery and mig-manager.
+func InstallGPUOperator(ctx context.Context, client kubernetes.Interface, opts GPUPluginOptions) error {
+	return InstallHelmChart(ctx, client, HelmChart{
+		Release:   "gpu-operator",
+		Namespace: gpuOperatorNamespace,
+		Repo:      gpuOperatorRepo,
+		Chart:     "gpu-operator",
+		Version:   gpuOperatorVersion,
+		Set: map[string]string{
+			"driver.enabled":     "false",
+			"toolkit.enabled":    "false",
+			"mig.strategy":       opts.MIGStrategy,
+			"migManager.enabled": strconv.FormatBool(opts.MIGManager),
+		},
+	})
+}
+
+//InstallGPUPlugin install the requested GPU plugin into the cluster
+func InstallGPUPlugin(ctx context.Context, client kubernetes.Interface, plugin proto.GPUPlugin, opts GPUPluginOptions) error {
+	if opts.MIGStrategy == "" {
+		opts.MIGStrategy = MIGStrategyNone
+	}
+
+	switch plugin {
+	case proto.GPUPlugin_DEVICE_PLUGIN:
+		return errors.Wrap(InstallDevicePlugin(ctx, client, opts.MIGStrategy), "failed to install device plugin")
+	case proto.GPUPlugin_GPU_OPERATOR:
+		return errors.Wrap(InstallGPUOperator(ctx, client, opts), "failed to install gpu operator")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package kube
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+//BindServiceAccount create the service account or merge the annotations and labels into the existing one,
+//namespace is created if it does not exist
+func BindServiceAccount(ctx context.Context, client kubernetes.Interface, namespace, name string, annotations, labels map[string]string) error {
+	if err := EnsureNamespace(ctx, client, namespace); err != nil {
+		return err
+	}
+
+	sa, err := client.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = client.CoreV1().ServiceAccounts(namespace).Create(ctx, &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: annotations,
+				Labels:      labels,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if sa.Annotations == nil {
+		sa.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		sa.Annotations[k] = v
+	}
+	if sa.Labels == nil {
+		sa.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		sa.Labels[k] = v
+	}
+
+	_, err = client.CoreV1().ServiceAccounts(namespace).Update(ctx, sa, metav1.UpdateOptions{})
+	return err
+}
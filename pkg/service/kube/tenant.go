@@ -0,0 +1,425 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
+	proto "gitlab.com/netbook-devs/spawner-service/proto/netbookai/spawner"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	tenantServiceAccount = "tenant"
+	tenantQuota          = "tenant-quota"
+	tenantLimits         = "tenant-limits"
+	//tenantRole role spawner defines in the tenant namespace, see tenantRules
+	tenantRole = "spawner-tenant"
+
+	defaultTenantTokenExpiry = time.Hour
+	//minTenantTokenExpiry kubernetes rejects shorter token requests
+	minTenantTokenExpiry = time.Minute * 10
+	//minTenantVersion older kubernetes creates a token secret which never expires for every service account
+	minTenantVersion = "1.24"
+)
+
+var tenantWriteVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete", "deletecollection"}
+var tenantReadVerbs = []string{"get", "list", "watch"}
+
+//tenantRules workloads of the namespace can be managed, secrets are not accessible and service accounts and rbac
+//are read only, so the tenant cannot mint tokens or grant itself more access. Quota and limits are read only
+var tenantRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods", "pods/log", "pods/exec", "pods/attach", "pods/portforward", "services", "endpoints", "configmaps", "persistentvolumeclaims", "events"},
+		Verbs:     tenantWriteVerbs,
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"serviceaccounts", "resourcequotas", "limitranges"},
+		Verbs:     tenantReadVerbs,
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "deployments/scale", "statefulsets", "statefulsets/scale", "daemonsets", "replicasets", "replicasets/scale"},
+		Verbs:     tenantWriteVerbs,
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     tenantWriteVerbs,
+	},
+	{
+		APIGroups: []string{"autoscaling"},
+		Resources: []string{"horizontalpodautoscalers"},
+		Verbs:     tenantWriteVerbs,
+	},
+	{
+		APIGroups: []string{"policy"},
+		Resources: []string{"poddisruptionbudgets"},
+		Verbs:     tenantWriteVerbs,
+	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"ingresses", "networkpolicies"},
+		Verbs:     tenantWriteVerbs,
+	},
+	{
+		APIGroups: []string{rbacv1.GroupName},
+		Resources: []string{"roles", "rolebindings"},
+		Verbs:     tenantReadVerbs,
+	},
+}
+
+//tenantSelector namespaces spawner created for tenants
+func tenantSelector() labels.Set {
+	return labels.Set{constants.CreatorLabel: constants.SpawnerServiceLabel}
+}
+
+//TenantNamespace namespace of the tenant, 'tenant-<workspaceId>' when not requested
+func TenantNamespace(req *proto.CreateTenantRequest) string {
+	if req.Namespace != "" {
+		return req.Namespace
+	}
+	return fmt.Sprintf("tenant-%s", strings.ToLower(req.WorkspaceId))
+}
+
+func resourceList(name string, values map[string]string) (corev1.ResourceList, error) {
+	list := corev1.ResourceList{}
+	for k, v := range values {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s '%s': '%s'", name, k, v)
+		}
+		list[corev1.ResourceName(k)] = q
+	}
+	return list, nil
+}
+
+//ValidateTenant request is checked before anything is created in the cluster
+func ValidateTenant(req *proto.CreateTenantRequest) error {
+	if req.WorkspaceId == "" {
+		return errors.New("workspaceId is required")
+	}
+	if errs := validation.IsValidLabelValue(req.WorkspaceId); len(errs) > 0 {
+		return fmt.Errorf("invalid workspaceId '%s': %s", req.WorkspaceId, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsDNS1123Label(TenantNamespace(req)); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace '%s': %s", TenantNamespace(req), strings.Join(errs, ", "))
+	}
+	if req.TokenExpirySeconds != 0 && time.Duration(req.TokenExpirySeconds)*time.Second < minTenantTokenExpiry {
+		return fmt.Errorf("tokenExpirySeconds must be at least %d", int64(minTenantTokenExpiry.Seconds()))
+	}
+
+	for name, values := range map[string]map[string]string{"quota": req.Quota, "defaultRequest": req.DefaultRequest, "defaultLimit": req.DefaultLimit} {
+		if _, err := resourceList(name, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toTenant(ns corev1.Namespace, quota *corev1.ResourceQuota) *proto.Tenant {
+	t := &proto.Tenant{
+		WorkspaceId:  ns.Labels[constants.WorkspaceLabel],
+		Namespace:    ns.Name,
+		Quota:        map[string]string{},
+		CreationTime: ns.CreationTimestamp.UTC().Format(time.RFC3339),
+	}
+	if quota != nil {
+		for k, v := range quota.Spec.Hard {
+			t.Quota[string(k)] = v.String()
+		}
+	}
+	return t
+}
+
+//ensureNamespace create the tenant namespace, namespace of another workspace or not created by spawner is not taken over
+func ensureTenantNamespace(ctx context.Context, client kubernetes.Interface, name string, nsLabels map[string]string) (*corev1.Namespace, error) {
+	namespaces := client.CoreV1().Namespaces()
+	ns, err := namespaces.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return namespaces.Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nsLabels},
+		}, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := nsLabels[constants.WorkspaceLabel]
+	if ns.Labels[constants.CreatorLabel] != constants.SpawnerServiceLabel || ns.Labels[constants.WorkspaceLabel] != workspace {
+		return nil, fmt.Errorf("namespace '%s' already exists and does not belong to workspace '%s'", name, workspace)
+	}
+
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	for k, v := range nsLabels {
+		ns.Labels[k] = v
+	}
+	return namespaces.Update(ctx, ns, metav1.UpdateOptions{})
+}
+
+func applyQuota(ctx context.Context, client kubernetes.Interface, namespace string, hard corev1.ResourceList) (*corev1.ResourceQuota, error) {
+	quotas := client.CoreV1().ResourceQuotas(namespace)
+	if len(hard) == 0 {
+		err := quotas.Delete(ctx, tenantQuota, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: tenantQuota, Namespace: namespace},
+		Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+	}
+	q, err := quotas.Update(ctx, quota, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		q, err = quotas.Create(ctx, quota, metav1.CreateOptions{})
+	}
+	return q, err
+}
+
+func applyLimits(ctx context.Context, client kubernetes.Interface, namespace string, defaultRequest, defaultLimit corev1.ResourceList) error {
+	limits := client.CoreV1().LimitRanges(namespace)
+	if len(defaultRequest) == 0 && len(defaultLimit) == 0 {
+		err := limits.Delete(ctx, tenantLimits, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	lr := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: tenantLimits, Namespace: namespace},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{
+				{
+					Type:           corev1.LimitTypeContainer,
+					DefaultRequest: defaultRequest,
+					Default:        defaultLimit,
+				},
+			},
+		},
+	}
+	_, err := limits.Update(ctx, lr, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = limits.Create(ctx, lr, metav1.CreateOptions{})
+	}
+	return err
+}
+
+func ensureTenantAccess(ctx context.Context, client kubernetes.Interface, namespace string) error {
+	_, err := client.CoreV1().ServiceAccounts(namespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: tenantServiceAccount},
+	}, metav1.CreateOptions{})
+	if err = ignoreExists(err); err != nil {
+		return err
+	}
+
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: tenantRole, Namespace: namespace},
+		Rules:      tenantRules,
+	}
+	roles := client.RbacV1().Roles(namespace)
+	_, err = roles.Update(ctx, role, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = roles.Create(ctx, role, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	roleRef := rbacv1.RoleRef{
+		APIGroup: rbacv1.GroupName,
+		Kind:     "Role",
+		Name:     tenantRole,
+	}
+	bindings := client.RbacV1().RoleBindings(namespace)
+	existing, err := bindings.Get(ctx, tenantServiceAccount, metav1.GetOptions{})
+	if err == nil && existing.RoleRef == roleRef {
+		return nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		//role of a binding cannot be changed, tenants created earlier were bound to a broader role
+		if err = bindings.Delete(ctx, tenantServiceAccount, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	_, err = bindings.Create(ctx, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: tenantServiceAccount, Namespace: namespace},
+		RoleRef:    roleRef,
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      tenantServiceAccount,
+				Namespace: namespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	return ignoreExists(err)
+}
+
+//tenantKubeConfig kubeconfig of the tenant service account, its context defaults to the tenant namespace
+func tenantKubeConfig(config *rest.Config, clusterName, namespace, token string) ([]byte, error) {
+	user := fmt.Sprintf("%s-%s", clusterName, namespace)
+	kc := clientcmdapi.NewConfig()
+	kc.Clusters[clusterName] = &clientcmdapi.Cluster{
+		Server:                   config.Host,
+		CertificateAuthorityData: config.CAData,
+	}
+	kc.AuthInfos[user] = &clientcmdapi.AuthInfo{Token: token}
+	kc.Contexts[user] = &clientcmdapi.Context{
+		Cluster:   clusterName,
+		AuthInfo:  user,
+		Namespace: namespace,
+	}
+	kc.CurrentContext = user
+	return clientcmd.Write(*kc)
+}
+
+//CreateTenant create or update the tenant namespace with its quota, default limits and service account,
+//returns a kubeconfig with a token of the service account which expires. Request is expected to be checked
+//with ValidateTenant already. Clusters older than 1.24 are refused, their service account token secret never expires
+func CreateTenant(ctx context.Context, config *rest.Config, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error) {
+	hard, err := resourceList("quota", req.Quota)
+	if err != nil {
+		return nil, err
+	}
+	defaultRequest, err := resourceList("defaultRequest", req.DefaultRequest)
+	if err != nil {
+		return nil, err
+	}
+	defaultLimit, err := resourceList("defaultLimit", req.DefaultLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateTenant: failed to get kubernetes client")
+	}
+
+	server, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateTenant: failed to get server version")
+	}
+	if common.CompareVersion(server.GitVersion, minTenantVersion) < 0 {
+		return nil, fmt.Errorf("tenants require kubernetes %s or later, cluster '%s' runs %s", minTenantVersion, req.ClusterName, server.GitVersion)
+	}
+
+	nsLabels := map[string]string{}
+	for k, v := range req.Labels {
+		nsLabels[k] = v
+	}
+	for k, v := range tenantSelector() {
+		nsLabels[k] = v
+	}
+	nsLabels[constants.WorkspaceLabel] = req.WorkspaceId
+
+	name := TenantNamespace(req)
+	ns, err := ensureTenantNamespace(ctx, client, name, nsLabels)
+	if err != nil {
+		return nil, errors.Wrapf(err, "CreateTenant: failed to create namespace '%s'", name)
+	}
+
+	quota, err := applyQuota(ctx, client, name, hard)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateTenant: failed to set resource quota")
+	}
+	if err = applyLimits(ctx, client, name, defaultRequest, defaultLimit); err != nil {
+		return nil, errors.Wrap(err, "CreateTenant: failed to set limit range")
+	}
+	if err = ensureTenantAccess(ctx, client, name); err != nil {
+		return nil, errors.Wrap(err, "CreateTenant: failed to setup tenant service account")
+	}
+
+	expiry := int64(defaultTenantTokenExpiry.Seconds())
+	if req.TokenExpirySeconds != 0 {
+		expiry = req.TokenExpirySeconds
+	}
+	tok, err := client.CoreV1().ServiceAccounts(name).CreateToken(ctx, tenantServiceAccount, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expiry},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateTenant: failed to create service account token")
+	}
+
+	kc, err := tenantKubeConfig(config, req.ClusterName, name, tok.Status.Token)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateTenant: failed to write kubeconfig")
+	}
+
+	return &proto.CreateTenantResponse{
+		Tenant:      toTenant(*ns, quota),
+		Kubeconfig:  kc,
+		TokenExpiry: tok.Status.ExpirationTimestamp.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+//DeleteTenant delete the namespaces of the workspace, everything in them goes along
+func DeleteTenant(ctx context.Context, client kubernetes.Interface, workspaceID string) ([]string, error) {
+	if workspaceID == "" {
+		return nil, errors.New("workspaceId is required")
+	}
+
+	selector := tenantSelector()
+	selector[constants.WorkspaceLabel] = workspaceID
+	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "DeleteTenant: failed to list tenant namespaces")
+	}
+
+	deleted := []string{}
+	for _, ns := range namespaces.Items {
+		err = client.CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return deleted, errors.Wrapf(err, "DeleteTenant: failed to delete namespace '%s'", ns.Name)
+		}
+		deleted = append(deleted, ns.Name)
+	}
+	return deleted, nil
+}
+
+//ListTenants tenant namespaces of the cluster
+func ListTenants(ctx context.Context, client kubernetes.Interface) ([]*proto.Tenant, error) {
+	selector := tenantSelector().String() + "," + constants.WorkspaceLabel
+	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListTenants: failed to list tenant namespaces")
+	}
+
+	tenants := []*proto.Tenant{}
+	for _, ns := range namespaces.Items {
+		quota, err := client.CoreV1().ResourceQuotas(ns.Name).Get(ctx, tenantQuota, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			quota, err = nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "ListTenants: failed to get quota of namespace '%s'", ns.Name)
+		}
+		tenants = append(tenants, toTenant(ns, quota))
+	}
+	return tenants, nil
+}
@@ -9,6 +9,7 @@ import (
 	rnchrClient "github.com/rancher/rancher/pkg/client/generated/management/v3"
 	aws "gitlab.com/netbook-devs/spawner-service/pkg/service/aws"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/azure"
+	"gitlab.com/netbook-devs/spawner-service/pkg/service/common"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/constants"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/rancher"
 	"gitlab.com/netbook-devs/spawner-service/pkg/service/system"
@@ -43,6 +44,29 @@ type SpawnerService interface {
 	ReadCredential(context.Context, *proto.ReadCredentialRequest) (*proto.ReadCredentialResponse, error)
 	AddRoute53Record(ctx context.Context, req *proto.AddRoute53RecordRequest) (*proto.AddRoute53RecordResponse, error)
 	GetCostByTime(ctx context.Context, req *proto.GetCostByTimeRequest) (*proto.GetCostByTimeResponse, error)
+	ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error)
+	UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error)
+	UpgradeNodePool(ctx context.Context, req *proto.UpgradeNodePoolRequest) (*proto.UpgradeNodePoolResponse, error)
+	GetUpgradeStatus(ctx context.Context, req *proto.GetUpgradeStatusRequest) (*proto.GetUpgradeStatusResponse, error)
+	ListMachineTypes(ctx context.Context, req *proto.ListMachineTypesRequest) (*proto.ListMachineTypesResponse, error)
+	CheckCapacity(ctx context.Context, req *proto.CheckCapacityRequest) (*proto.CheckCapacityResponse, error)
+	GetNetworkStack(ctx context.Context, req *proto.GetNetworkStackRequest) (*proto.GetNetworkStackResponse, error)
+	ListNetworkStacks(ctx context.Context, req *proto.ListNetworkStacksRequest) (*proto.ListNetworkStacksResponse, error)
+	DeleteNetworkStack(ctx context.Context, req *proto.DeleteNetworkStackRequest) (*proto.DeleteNetworkStackResponse, error)
+	ListAddons(ctx context.Context, req *proto.ListAddonsRequest) (*proto.ListAddonsResponse, error)
+	InstallAddon(ctx context.Context, req *proto.InstallAddonRequest) (*proto.InstallAddonResponse, error)
+	UpdateAddon(ctx context.Context, req *proto.UpdateAddonRequest) (*proto.UpdateAddonResponse, error)
+	RemoveAddon(ctx context.Context, req *proto.RemoveAddonRequest) (*proto.RemoveAddonResponse, error)
+	BindServiceAccountRole(ctx context.Context, req *proto.BindServiceAccountRoleRequest) (*proto.BindServiceAccountRoleResponse, error)
+	UpdateClusterConfig(ctx context.Context, req *proto.UpdateClusterConfigRequest) (*proto.UpdateClusterConfigResponse, error)
+	StopCluster(ctx context.Context, req *proto.StopClusterRequest) (*proto.StopClusterResponse, error)
+	StartCluster(ctx context.Context, req *proto.StartClusterRequest) (*proto.StartClusterResponse, error)
+	GetBootstrapStatus(ctx context.Context, req *proto.GetBootstrapStatusRequest) (*proto.GetBootstrapStatusResponse, error)
+	CreateTenant(ctx context.Context, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error)
+	DeleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error)
+	ListTenants(ctx context.Context, req *proto.ListTenantsRequest) (*proto.ListTenantsResponse, error)
+	AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error)
+	DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error)
 }
 
 //spawnerService manage provider and clusters
@@ -62,6 +86,14 @@ func New(logger *zap.SugaredLogger) SpawnerService {
 		azureController: azure.NewController(logger),
 		logger:          logger,
 	}
+
+	if path := config.Get().MachineCatalogPath; path != "" {
+		if err := common.LoadCatalog(path); err != nil {
+			logger.Errorw("failed to load machine catalog, using the default catalog", "path", path, "error", err)
+		} else {
+			logger.Infow("machine catalog loaded", "path", path, "version", common.GetCatalog().Version)
+		}
+	}
 	return svc
 }
 
@@ -436,3 +468,240 @@ func (s *spawnerService) GetCostByTime(ctx context.Context, req *proto.GetCostBy
 	}
 	return provider.GetCostByTime(ctx, req)
 }
+
+//ListKubernetesVersions list kubernetes versions supported by provider in the region
+func (s *spawnerService) ListKubernetesVersions(ctx context.Context, req *proto.ListKubernetesVersionsRequest) (*proto.ListKubernetesVersionsResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListKubernetesVersions(ctx, req)
+}
+
+//UpgradeCluster upgrade cluster control plane and then its node pools
+func (s *spawnerService) UpgradeCluster(ctx context.Context, req *proto.UpgradeClusterRequest) (*proto.UpgradeClusterResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.UpgradeCluster(ctx, req)
+}
+
+//UpgradeNodePool upgrade single node pool in the cluster
+func (s *spawnerService) UpgradeNodePool(ctx context.Context, req *proto.UpgradeNodePoolRequest) (*proto.UpgradeNodePoolResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.UpgradeNodePool(ctx, req)
+}
+
+//GetUpgradeStatus get the progress of cluster and node pool upgrades
+func (s *spawnerService) GetUpgradeStatus(ctx context.Context, req *proto.GetUpgradeStatusRequest) (*proto.GetUpgradeStatusResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.GetUpgradeStatus(ctx, req)
+}
+
+//ListMachineTypes machine types from the catalog, provider and region filters are applied when set
+func (s *spawnerService) ListMachineTypes(ctx context.Context, req *proto.ListMachineTypesRequest) (*proto.ListMachineTypesResponse, error) {
+	if req.Provider != "" {
+		if _, err := s.controller(req.Provider); err != nil {
+			return nil, err
+		}
+	}
+
+	catalog := common.GetCatalog()
+	resp := &proto.ListMachineTypesResponse{
+		CatalogVersion: catalog.Version,
+		MachineTypes:   make([]*proto.MachineType, 0, len(catalog.Machines)),
+	}
+
+	for _, m := range catalog.Machines {
+		instances := m.Instances
+		if req.Provider != "" {
+			if !m.AvailableIn(req.Provider, req.Region) {
+				continue
+			}
+			instances = map[string]string{req.Provider: m.Instances[req.Provider]}
+		}
+
+		mt := &proto.MachineType{
+			Name:         m.Name,
+			Vcpu:         m.VCPU,
+			Memory:       m.Memory,
+			Architecture: m.Architecture,
+			Instances:    instances,
+		}
+		if m.GPU != nil {
+			mt.GpuModel = m.GPU.Model
+			mt.GpuCount = m.GPU.Count
+		}
+		resp.MachineTypes = append(resp.MachineTypes, mt)
+	}
+	return resp, nil
+}
+
+//CheckCapacity preflight instance availability and quota checks for the node spec
+func (s *spawnerService) CheckCapacity(ctx context.Context, req *proto.CheckCapacityRequest) (*proto.CheckCapacityResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.CheckCapacity(ctx, req)
+}
+
+//GetNetworkStack network stack of the region and clusters using it
+func (s *spawnerService) GetNetworkStack(ctx context.Context, req *proto.GetNetworkStackRequest) (*proto.GetNetworkStackResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.GetNetworkStack(ctx, req)
+}
+
+//ListNetworkStacks network stacks across the regions
+func (s *spawnerService) ListNetworkStacks(ctx context.Context, req *proto.ListNetworkStacksRequest) (*proto.ListNetworkStacksResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListNetworkStacks(ctx, req)
+}
+
+//DeleteNetworkStack delete network stack which is no longer used by any cluster
+func (s *spawnerService) DeleteNetworkStack(ctx context.Context, req *proto.DeleteNetworkStackRequest) (*proto.DeleteNetworkStackResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DeleteNetworkStack(ctx, req)
+}
+
+//ListAddons installed and available add-ons of the cluster
+func (s *spawnerService) ListAddons(ctx context.Context, req *proto.ListAddonsRequest) (*proto.ListAddonsResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListAddons(ctx, req)
+}
+
+//InstallAddon install add-on on the cluster
+func (s *spawnerService) InstallAddon(ctx context.Context, req *proto.InstallAddonRequest) (*proto.InstallAddonResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.InstallAddon(ctx, req)
+}
+
+//UpdateAddon update version or configuration of the installed add-on
+func (s *spawnerService) UpdateAddon(ctx context.Context, req *proto.UpdateAddonRequest) (*proto.UpdateAddonResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.UpdateAddon(ctx, req)
+}
+
+//RemoveAddon remove add-on from the cluster
+func (s *spawnerService) RemoveAddon(ctx context.Context, req *proto.RemoveAddonRequest) (*proto.RemoveAddonResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.RemoveAddon(ctx, req)
+}
+
+//BindServiceAccountRole cloud role for the kubernetes service account, workloads using it get cloud access
+func (s *spawnerService) BindServiceAccountRole(ctx context.Context, req *proto.BindServiceAccountRoleRequest) (*proto.BindServiceAccountRoleResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.BindServiceAccountRole(ctx, req)
+}
+
+//UpdateClusterConfig change control plane logging and secrets encryption of the cluster
+func (s *spawnerService) UpdateClusterConfig(ctx context.Context, req *proto.UpdateClusterConfigRequest) (*proto.UpdateClusterConfigResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.UpdateClusterConfig(ctx, req)
+}
+
+//StopCluster stop the cluster, nodes are removed until it is started again
+func (s *spawnerService) StopCluster(ctx context.Context, req *proto.StopClusterRequest) (*proto.StopClusterResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.StopCluster(ctx, req)
+}
+
+//StartCluster start the stopped cluster, nodes are restored to their size before stop
+func (s *spawnerService) StartCluster(ctx context.Context, req *proto.StartClusterRequest) (*proto.StartClusterResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.StartCluster(ctx, req)
+}
+
+//GetBootstrapStatus result of each step of the bootstrap requested with the cluster
+func (s *spawnerService) GetBootstrapStatus(ctx context.Context, req *proto.GetBootstrapStatusRequest) (*proto.GetBootstrapStatusResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.GetBootstrapStatus(ctx, req)
+}
+
+//CreateTenant namespace of the workspace with its quota, returns a kubeconfig limited to it
+func (s *spawnerService) CreateTenant(ctx context.Context, req *proto.CreateTenantRequest) (*proto.CreateTenantResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.CreateTenant(ctx, req)
+}
+
+//DeleteTenant delete the namespaces of the workspace
+func (s *spawnerService) DeleteTenant(ctx context.Context, req *proto.DeleteTenantRequest) (*proto.DeleteTenantResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DeleteTenant(ctx, req)
+}
+
+//ListTenants tenant namespaces of the cluster
+func (s *spawnerService) ListTenants(ctx context.Context, req *proto.ListTenantsRequest) (*proto.ListTenantsResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.ListTenants(ctx, req)
+}
+
+//AttachVolume attach the volume to the instance or cluster node of the same availability zone
+func (s *spawnerService) AttachVolume(ctx context.Context, req *proto.AttachVolumeRequest) (*proto.AttachVolumeResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.AttachVolume(ctx, req)
+}
+
+//DetachVolume detach the volume from the instance or cluster node
+func (s *spawnerService) DetachVolume(ctx context.Context, req *proto.DetachVolumeRequest) (*proto.DetachVolumeResponse, error) {
+	provider, err := s.controller(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return provider.DetachVolume(ctx, req)
+}
@@ -78,6 +78,56 @@ func (MIGProfile) EnumDescriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{0}
 }
 
+// GPUPlugin component installed in the cluster to advertise nvidia.com/gpu resources of the GPU node pools
+type GPUPlugin int32
+
+const (
+	GPUPlugin_PluginNONE    GPUPlugin = 0
+	GPUPlugin_DEVICE_PLUGIN GPUPlugin = 1
+	GPUPlugin_GPU_OPERATOR  GPUPlugin = 2
+)
+
+// Enum value maps for GPUPlugin.
+var (
+	GPUPlugin_name = map[int32]string{
+		0: "PluginNONE",
+		1: "DEVICE_PLUGIN",
+		2: "GPU_OPERATOR",
+	}
+	GPUPlugin_value = map[string]int32{
+		"PluginNONE":    0,
+		"DEVICE_PLUGIN": 1,
+		"GPU_OPERATOR":  2,
+	}
+)
+
+func (x GPUPlugin) Enum() *GPUPlugin {
+	p := new(GPUPlugin)
+	*p = x
+	return p
+}
+
+func (x GPUPlugin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GPUPlugin) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[1].Descriptor()
+}
+
+func (GPUPlugin) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[1]
+}
+
+func (x GPUPlugin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GPUPlugin.Descriptor instead.
+func (GPUPlugin) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{1}
+}
+
 type CapacityType int32
 
 const (
@@ -111,11 +161,11 @@ func (x CapacityType) String() string {
 }
 
 func (CapacityType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_netbookai_spawner_spawner_proto_enumTypes[1].Descriptor()
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[2].Descriptor()
 }
 
 func (CapacityType) Type() protoreflect.EnumType {
-	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[1]
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[2]
 }
 
 func (x CapacityType) Number() protoreflect.EnumNumber {
@@ -124,7 +174,319 @@ func (x CapacityType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CapacityType.Descriptor instead.
 func (CapacityType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{1}
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{2}
+}
+
+// EndpointAccess reachability of the cluster API server endpoint
+type EndpointAccess int32
+
+const (
+	EndpointAccess_PUBLIC             EndpointAccess = 0
+	EndpointAccess_PRIVATE            EndpointAccess = 1
+	EndpointAccess_PUBLIC_AND_PRIVATE EndpointAccess = 2
+)
+
+// Enum value maps for EndpointAccess.
+var (
+	EndpointAccess_name = map[int32]string{
+		0: "PUBLIC",
+		1: "PRIVATE",
+		2: "PUBLIC_AND_PRIVATE",
+	}
+	EndpointAccess_value = map[string]int32{
+		"PUBLIC":             0,
+		"PRIVATE":            1,
+		"PUBLIC_AND_PRIVATE": 2,
+	}
+)
+
+func (x EndpointAccess) Enum() *EndpointAccess {
+	p := new(EndpointAccess)
+	*p = x
+	return p
+}
+
+func (x EndpointAccess) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EndpointAccess) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[3].Descriptor()
+}
+
+func (EndpointAccess) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[3]
+}
+
+func (x EndpointAccess) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EndpointAccess.Descriptor instead.
+func (EndpointAccess) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{3}
+}
+
+type NetworkPlugin int32
+
+const (
+	NetworkPlugin_KUBENET   NetworkPlugin = 0
+	NetworkPlugin_AZURE_CNI NetworkPlugin = 1
+)
+
+// Enum value maps for NetworkPlugin.
+var (
+	NetworkPlugin_name = map[int32]string{
+		0: "KUBENET",
+		1: "AZURE_CNI",
+	}
+	NetworkPlugin_value = map[string]int32{
+		"KUBENET":   0,
+		"AZURE_CNI": 1,
+	}
+)
+
+func (x NetworkPlugin) Enum() *NetworkPlugin {
+	p := new(NetworkPlugin)
+	*p = x
+	return p
+}
+
+func (x NetworkPlugin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NetworkPlugin) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[4].Descriptor()
+}
+
+func (NetworkPlugin) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[4]
+}
+
+func (x NetworkPlugin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NetworkPlugin.Descriptor instead.
+func (NetworkPlugin) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{4}
+}
+
+type OutboundType int32
+
+const (
+	OutboundType_LOAD_BALANCER OutboundType = 0
+	//USER_DEFINED_ROUTING egress through the route table of the subnet, requires subnetId
+	OutboundType_USER_DEFINED_ROUTING OutboundType = 1
+	OutboundType_MANAGED_NAT_GATEWAY  OutboundType = 2
+	//USER_ASSIGNED_NAT_GATEWAY NAT gateway attached to the subnet, requires subnetId
+	OutboundType_USER_ASSIGNED_NAT_GATEWAY OutboundType = 3
+)
+
+// Enum value maps for OutboundType.
+var (
+	OutboundType_name = map[int32]string{
+		0: "LOAD_BALANCER",
+		1: "USER_DEFINED_ROUTING",
+		2: "MANAGED_NAT_GATEWAY",
+		3: "USER_ASSIGNED_NAT_GATEWAY",
+	}
+	OutboundType_value = map[string]int32{
+		"LOAD_BALANCER":             0,
+		"USER_DEFINED_ROUTING":      1,
+		"MANAGED_NAT_GATEWAY":       2,
+		"USER_ASSIGNED_NAT_GATEWAY": 3,
+	}
+)
+
+func (x OutboundType) Enum() *OutboundType {
+	p := new(OutboundType)
+	*p = x
+	return p
+}
+
+func (x OutboundType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutboundType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[5].Descriptor()
+}
+
+func (OutboundType) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[5]
+}
+
+func (x OutboundType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutboundType.Descriptor instead.
+func (OutboundType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{5}
+}
+
+type ClusterIdentityType int32
+
+const (
+	ClusterIdentityType_SYSTEM_ASSIGNED ClusterIdentityType = 0
+	ClusterIdentityType_USER_ASSIGNED   ClusterIdentityType = 1
+	//SERVICE_PRINCIPAL account client id and secret, kept for clusters created before managed identity
+	ClusterIdentityType_SERVICE_PRINCIPAL ClusterIdentityType = 2
+)
+
+// Enum value maps for ClusterIdentityType.
+var (
+	ClusterIdentityType_name = map[int32]string{
+		0: "SYSTEM_ASSIGNED",
+		1: "USER_ASSIGNED",
+		2: "SERVICE_PRINCIPAL",
+	}
+	ClusterIdentityType_value = map[string]int32{
+		"SYSTEM_ASSIGNED":   0,
+		"USER_ASSIGNED":     1,
+		"SERVICE_PRINCIPAL": 2,
+	}
+)
+
+func (x ClusterIdentityType) Enum() *ClusterIdentityType {
+	p := new(ClusterIdentityType)
+	*p = x
+	return p
+}
+
+func (x ClusterIdentityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClusterIdentityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[6].Descriptor()
+}
+
+func (ClusterIdentityType) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[6]
+}
+
+func (x ClusterIdentityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClusterIdentityType.Descriptor instead.
+func (ClusterIdentityType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{6}
+}
+
+type NatGateway int32
+
+const (
+	NatGateway_NAT_SINGLE   NatGateway = 0
+	NatGateway_NAT_PER_ZONE NatGateway = 1
+)
+
+// Enum value maps for NatGateway.
+var (
+	NatGateway_name = map[int32]string{
+		0: "NAT_SINGLE",
+		1: "NAT_PER_ZONE",
+	}
+	NatGateway_value = map[string]int32{
+		"NAT_SINGLE":   0,
+		"NAT_PER_ZONE": 1,
+	}
+)
+
+func (x NatGateway) Enum() *NatGateway {
+	p := new(NatGateway)
+	*p = x
+	return p
+}
+
+func (x NatGateway) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NatGateway) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[7].Descriptor()
+}
+
+func (NatGateway) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[7]
+}
+
+func (x NatGateway) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NatGateway.Descriptor instead.
+func (NatGateway) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{7}
+}
+
+// ClusterState provider neutral state of cluster and node pool
+type ClusterState int32
+
+const (
+	ClusterState_STATE_UNKNOWN ClusterState = 0
+	ClusterState_PROVISIONING  ClusterState = 1
+	ClusterState_RUNNING       ClusterState = 2
+	ClusterState_UPDATING      ClusterState = 3
+	//DEGRADED running with failed node pools or unhealthy nodes
+	ClusterState_DEGRADED ClusterState = 4
+	ClusterState_STOPPED  ClusterState = 5
+	ClusterState_DELETING ClusterState = 6
+	ClusterState_FAILED   ClusterState = 7
+)
+
+// Enum value maps for ClusterState.
+var (
+	ClusterState_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "PROVISIONING",
+		2: "RUNNING",
+		3: "UPDATING",
+		4: "DEGRADED",
+		5: "STOPPED",
+		6: "DELETING",
+		7: "FAILED",
+	}
+	ClusterState_value = map[string]int32{
+		"STATE_UNKNOWN": 0,
+		"PROVISIONING":  1,
+		"RUNNING":       2,
+		"UPDATING":      3,
+		"DEGRADED":      4,
+		"STOPPED":       5,
+		"DELETING":      6,
+		"FAILED":        7,
+	}
+)
+
+func (x ClusterState) Enum() *ClusterState {
+	p := new(ClusterState)
+	*p = x
+	return p
+}
+
+func (x ClusterState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClusterState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_netbookai_spawner_spawner_proto_enumTypes[8].Descriptor()
+}
+
+func (ClusterState) Type() protoreflect.EnumType {
+	return &file_proto_netbookai_spawner_spawner_proto_enumTypes[8]
+}
+
+func (x ClusterState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClusterState.Descriptor instead.
+func (ClusterState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{8}
 }
 
 type Empty struct {
@@ -264,23 +626,43 @@ type NodeSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Instance         string            `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
-	DiskSize         int32             `protobuf:"varint,3,opt,name=diskSize,proto3" json:"diskSize,omitempty"`
-	HostName         string            `protobuf:"bytes,4,opt,name=hostName,proto3" json:"hostName,omitempty"`
-	State            string            `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
-	Uuid             string            `protobuf:"bytes,6,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	IpAddr           string            `protobuf:"bytes,7,opt,name=ipAddr,proto3" json:"ipAddr,omitempty"`
-	Availabilityzone string            `protobuf:"bytes,8,opt,name=availabilityzone,proto3" json:"availabilityzone,omitempty"`
-	ClusterId        string            `protobuf:"bytes,9,opt,name=clusterId,proto3" json:"clusterId,omitempty"`
-	Labels           map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	GpuEnabled       bool              `protobuf:"varint,11,opt,name=gpu_enabled,json=gpuEnabled,proto3" json:"gpu_enabled,omitempty"`
-	Health           *Health           `protobuf:"bytes,12,opt,name=health,proto3" json:"health,omitempty"`
-	MigProfile       MIGProfile        `protobuf:"varint,13,opt,name=migProfile,proto3,enum=spawner.MIGProfile" json:"migProfile,omitempty"`
-	Count            int64             `protobuf:"varint,14,opt,name=count,proto3" json:"count,omitempty"`
-	CapacityType     CapacityType      `protobuf:"varint,15,opt,name=capacityType,proto3,enum=spawner.CapacityType" json:"capacityType,omitempty"`
-	SpotInstances    []string          `protobuf:"bytes,16,rep,name=spotInstances,proto3" json:"spotInstances,omitempty"`
-	MachineType      string            `protobuf:"bytes,17,opt,name=machineType,proto3" json:"machineType,omitempty"`
+	Name              string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Instance          string            `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	DiskSize          int32             `protobuf:"varint,3,opt,name=diskSize,proto3" json:"diskSize,omitempty"`
+	HostName          string            `protobuf:"bytes,4,opt,name=hostName,proto3" json:"hostName,omitempty"`
+	State             string            `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Uuid              string            `protobuf:"bytes,6,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	IpAddr            string            `protobuf:"bytes,7,opt,name=ipAddr,proto3" json:"ipAddr,omitempty"`
+	Availabilityzone  string            `protobuf:"bytes,8,opt,name=availabilityzone,proto3" json:"availabilityzone,omitempty"`
+	ClusterId         string            `protobuf:"bytes,9,opt,name=clusterId,proto3" json:"clusterId,omitempty"`
+	Labels            map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GpuEnabled        bool              `protobuf:"varint,11,opt,name=gpu_enabled,json=gpuEnabled,proto3" json:"gpu_enabled,omitempty"`
+	Health            *Health           `protobuf:"bytes,12,opt,name=health,proto3" json:"health,omitempty"`
+	MigProfile        MIGProfile        `protobuf:"varint,13,opt,name=migProfile,proto3,enum=spawner.MIGProfile" json:"migProfile,omitempty"`
+	Count             int64             `protobuf:"varint,14,opt,name=count,proto3" json:"count,omitempty"`
+	CapacityType      CapacityType      `protobuf:"varint,15,opt,name=capacityType,proto3,enum=spawner.CapacityType" json:"capacityType,omitempty"`
+	SpotInstances     []string          `protobuf:"bytes,16,rep,name=spotInstances,proto3" json:"spotInstances,omitempty"`
+	MachineType       string            `protobuf:"bytes,17,opt,name=machineType,proto3" json:"machineType,omitempty"`
+	KubernetesVersion string            `protobuf:"bytes,18,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	SpotFallback      *SpotFallback     `protobuf:"bytes,19,opt,name=spotFallback,proto3" json:"spotFallback,omitempty"`
+	//amiId custom AMI for the node group, userData must bootstrap the node into the cluster when set
+	AmiId string `protobuf:"bytes,20,opt,name=amiId,proto3" json:"amiId,omitempty"`
+	//userData bootstrap script run on node start, merged with EKS bootstrap unless custom AMI is used
+	UserData        string                   `protobuf:"bytes,21,opt,name=userData,proto3" json:"userData,omitempty"`
+	RootVolumeType  string                   `protobuf:"bytes,22,opt,name=rootVolumeType,proto3" json:"rootVolumeType,omitempty"`
+	RootVolumeIops  int64                    `protobuf:"varint,23,opt,name=rootVolumeIops,proto3" json:"rootVolumeIops,omitempty"`
+	EbsVolumes      []*EbsVolume             `protobuf:"bytes,24,rep,name=ebsVolumes,proto3" json:"ebsVolumes,omitempty"`
+	MetadataOptions *InstanceMetadataOptions `protobuf:"bytes,25,opt,name=metadataOptions,proto3" json:"metadataOptions,omitempty"`
+	//securityGroupIds additional security groups, cluster security group is always attached
+	SecurityGroupIds []string `protobuf:"bytes,26,rep,name=securityGroupIds,proto3" json:"securityGroupIds,omitempty"`
+	//gpuPlugin installed once the GPU node pool is created, with GPU_OPERATOR MIG partitioning is done by the operator
+	GpuPlugin GPUPlugin `protobuf:"varint,27,opt,name=gpuPlugin,proto3,enum=spawner.GPUPlugin" json:"gpuPlugin,omitempty"`
+	//availabilityZones zones the node pool is pinned to, spans all zones of the cluster network when empty.
+	//availabilityzone is only set on reads
+	AvailabilityZones []string `protobuf:"bytes,28,rep,name=availabilityZones,proto3" json:"availabilityZones,omitempty"`
+	//poolState provider neutral state of the node pool, providerStatus is the raw provider status it is mapped from
+	PoolState      ClusterState `protobuf:"varint,29,opt,name=poolState,proto3,enum=spawner.ClusterState" json:"poolState,omitempty"`
+	ProviderStatus string       `protobuf:"bytes,30,opt,name=providerStatus,proto3" json:"providerStatus,omitempty"`
 }
 
 func (x *NodeSpec) Reset() {
@@ -434,146 +816,127 @@ func (x *NodeSpec) GetMachineType() string {
 	return ""
 }
 
-type Issue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Code        string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	ResourceIds []string `protobuf:"bytes,3,rep,name=resourceIds,proto3" json:"resourceIds,omitempty"`
-}
-
-func (x *Issue) Reset() {
-	*x = Issue{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *NodeSpec) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
 	}
+	return ""
 }
 
-func (x *Issue) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeSpec) GetSpotFallback() *SpotFallback {
+	if x != nil {
+		return x.SpotFallback
+	}
+	return nil
 }
 
-func (*Issue) ProtoMessage() {}
-
-func (x *Issue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *NodeSpec) GetAmiId() string {
+	if x != nil {
+		return x.AmiId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
-func (*Issue) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{4}
+func (x *NodeSpec) GetUserData() string {
+	if x != nil {
+		return x.UserData
+	}
+	return ""
 }
 
-func (x *Issue) GetCode() string {
+func (x *NodeSpec) GetRootVolumeType() string {
 	if x != nil {
-		return x.Code
+		return x.RootVolumeType
 	}
 	return ""
 }
 
-func (x *Issue) GetDescription() string {
+func (x *NodeSpec) GetRootVolumeIops() int64 {
 	if x != nil {
-		return x.Description
+		return x.RootVolumeIops
 	}
-	return ""
+	return 0
 }
 
-func (x *Issue) GetResourceIds() []string {
+func (x *NodeSpec) GetEbsVolumes() []*EbsVolume {
 	if x != nil {
-		return x.ResourceIds
+		return x.EbsVolumes
 	}
 	return nil
 }
 
-type Health struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *NodeSpec) GetMetadataOptions() *InstanceMetadataOptions {
+	if x != nil {
+		return x.MetadataOptions
+	}
+	return nil
+}
 
-	Issue []*Issue `protobuf:"bytes,1,rep,name=issue,proto3" json:"issue,omitempty"`
+func (x *NodeSpec) GetSecurityGroupIds() []string {
+	if x != nil {
+		return x.SecurityGroupIds
+	}
+	return nil
 }
 
-func (x *Health) Reset() {
-	*x = Health{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *NodeSpec) GetGpuPlugin() GPUPlugin {
+	if x != nil {
+		return x.GpuPlugin
 	}
+	return GPUPlugin_PluginNONE
 }
 
-func (x *Health) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Health) ProtoMessage() {}
-
-func (x *Health) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *NodeSpec) GetAvailabilityZones() []string {
+	if x != nil {
+		return x.AvailabilityZones
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Health.ProtoReflect.Descriptor instead.
-func (*Health) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{5}
+func (x *NodeSpec) GetPoolState() ClusterState {
+	if x != nil {
+		return x.PoolState
+	}
+	return ClusterState_STATE_UNKNOWN
 }
 
-func (x *Health) GetIssue() []*Issue {
+func (x *NodeSpec) GetProviderStatus() string {
 	if x != nil {
-		return x.Issue
+		return x.ProviderStatus
 	}
-	return nil
+	return ""
 }
 
-type ClusterRequest struct {
+type EbsVolume struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string            `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	Node        *NodeSpec         `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
-	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DeviceName string `protobuf:"bytes,1,opt,name=deviceName,proto3" json:"deviceName,omitempty"`
+	Size       int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	VolumeType string `protobuf:"bytes,3,opt,name=volumeType,proto3" json:"volumeType,omitempty"`
+	Iops       int64  `protobuf:"varint,4,opt,name=iops,proto3" json:"iops,omitempty"`
+	Throughput int64  `protobuf:"varint,5,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	Encrypted  bool   `protobuf:"varint,6,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
 }
 
-func (x *ClusterRequest) Reset() {
-	*x = ClusterRequest{}
+func (x *EbsVolume) Reset() {
+	*x = EbsVolume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[6]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClusterRequest) String() string {
+func (x *EbsVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterRequest) ProtoMessage() {}
+func (*EbsVolume) ProtoMessage() {}
 
-func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[6]
+func (x *EbsVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,81 +947,81 @@ func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterRequest.ProtoReflect.Descriptor instead.
-func (*ClusterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use EbsVolume.ProtoReflect.Descriptor instead.
+func (*EbsVolume) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ClusterRequest) GetProvider() string {
+func (x *EbsVolume) GetDeviceName() string {
 	if x != nil {
-		return x.Provider
+		return x.DeviceName
 	}
 	return ""
 }
 
-func (x *ClusterRequest) GetRegion() string {
+func (x *EbsVolume) GetSize() int64 {
 	if x != nil {
-		return x.Region
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *ClusterRequest) GetAccountName() string {
+func (x *EbsVolume) GetVolumeType() string {
 	if x != nil {
-		return x.AccountName
+		return x.VolumeType
 	}
 	return ""
 }
 
-func (x *ClusterRequest) GetClusterName() string {
+func (x *EbsVolume) GetIops() int64 {
 	if x != nil {
-		return x.ClusterName
+		return x.Iops
 	}
-	return ""
+	return 0
 }
 
-func (x *ClusterRequest) GetNode() *NodeSpec {
+func (x *EbsVolume) GetThroughput() int64 {
 	if x != nil {
-		return x.Node
+		return x.Throughput
 	}
-	return nil
+	return 0
 }
 
-func (x *ClusterRequest) GetLabels() map[string]string {
+func (x *EbsVolume) GetEncrypted() bool {
 	if x != nil {
-		return x.Labels
+		return x.Encrypted
 	}
-	return nil
+	return false
 }
 
-type GetClusterRequest struct {
+// InstanceMetadataOptions IMDS settings of the node instances
+type InstanceMetadataOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//requireTokens enforce IMDSv2
+	RequireTokens bool  `protobuf:"varint,1,opt,name=requireTokens,proto3" json:"requireTokens,omitempty"`
+	HopLimit      int64 `protobuf:"varint,2,opt,name=hopLimit,proto3" json:"hopLimit,omitempty"`
 }
 
-func (x *GetClusterRequest) Reset() {
-	*x = GetClusterRequest{}
+func (x *InstanceMetadataOptions) Reset() {
+	*x = InstanceMetadataOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[7]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClusterRequest) String() string {
+func (x *InstanceMetadataOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterRequest) ProtoMessage() {}
+func (*InstanceMetadataOptions) ProtoMessage() {}
 
-func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[7]
+func (x *InstanceMetadataOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,66 +1032,55 @@ func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterRequest.ProtoReflect.Descriptor instead.
-func (*GetClusterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *GetClusterRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *GetClusterRequest) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
+// Deprecated: Use InstanceMetadataOptions.ProtoReflect.Descriptor instead.
+func (*InstanceMetadataOptions) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetClusterRequest) GetAccountName() string {
+func (x *InstanceMetadataOptions) GetRequireTokens() bool {
 	if x != nil {
-		return x.AccountName
+		return x.RequireTokens
 	}
-	return ""
+	return false
 }
 
-func (x *GetClusterRequest) GetClusterName() string {
+func (x *InstanceMetadataOptions) GetHopLimit() int64 {
 	if x != nil {
-		return x.ClusterName
+		return x.HopLimit
 	}
-	return ""
+	return 0
 }
 
-type GetClustersRequest struct {
+// SpotFallback on-demand fallback policy for spot node pools which cannot get capacity
+type SpotFallback struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	//timeoutMinutes wait for the spot pool to become healthy before falling back, defaults to 15
+	TimeoutMinutes int32 `protobuf:"varint,2,opt,name=timeoutMinutes,proto3" json:"timeoutMinutes,omitempty"`
+	//deleteSpotPool delete the failed spot pool once the on-demand pool is requested
+	DeleteSpotPool bool `protobuf:"varint,3,opt,name=deleteSpotPool,proto3" json:"deleteSpotPool,omitempty"`
 }
 
-func (x *GetClustersRequest) Reset() {
-	*x = GetClustersRequest{}
+func (x *SpotFallback) Reset() {
+	*x = SpotFallback{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[8]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClustersRequest) String() string {
+func (x *SpotFallback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClustersRequest) ProtoMessage() {}
+func (*SpotFallback) ProtoMessage() {}
 
-func (x *GetClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[8]
+func (x *SpotFallback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,59 +1091,59 @@ func (x *GetClustersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClustersRequest.ProtoReflect.Descriptor instead.
-func (*GetClustersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SpotFallback.ProtoReflect.Descriptor instead.
+func (*SpotFallback) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetClustersRequest) GetProvider() string {
+func (x *SpotFallback) GetEnabled() bool {
 	if x != nil {
-		return x.Provider
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *GetClustersRequest) GetRegion() string {
+func (x *SpotFallback) GetTimeoutMinutes() int32 {
 	if x != nil {
-		return x.Region
+		return x.TimeoutMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *GetClustersRequest) GetAccountName() string {
+func (x *SpotFallback) GetDeleteSpotPool() bool {
 	if x != nil {
-		return x.AccountName
+		return x.DeleteSpotPool
 	}
-	return ""
+	return false
 }
 
-type ClusterSpec struct {
+type Issue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ClusterId string      `protobuf:"bytes,2,opt,name=clusterId,proto3" json:"clusterId,omitempty"`
-	NodeSpec  []*NodeSpec `protobuf:"bytes,3,rep,name=nodeSpec,proto3" json:"nodeSpec,omitempty"`
+	Code        string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ResourceIds []string `protobuf:"bytes,3,rep,name=resourceIds,proto3" json:"resourceIds,omitempty"`
 }
 
-func (x *ClusterSpec) Reset() {
-	*x = ClusterSpec{}
+func (x *Issue) Reset() {
+	*x = Issue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[9]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClusterSpec) String() string {
+func (x *Issue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterSpec) ProtoMessage() {}
+func (*Issue) ProtoMessage() {}
 
-func (x *ClusterSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[9]
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,57 +1154,57 @@ func (x *ClusterSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterSpec.ProtoReflect.Descriptor instead.
-func (*ClusterSpec) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ClusterSpec) GetName() string {
+func (x *Issue) GetCode() string {
 	if x != nil {
-		return x.Name
+		return x.Code
 	}
 	return ""
 }
 
-func (x *ClusterSpec) GetClusterId() string {
+func (x *Issue) GetDescription() string {
 	if x != nil {
-		return x.ClusterId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ClusterSpec) GetNodeSpec() []*NodeSpec {
+func (x *Issue) GetResourceIds() []string {
 	if x != nil {
-		return x.NodeSpec
+		return x.ResourceIds
 	}
 	return nil
 }
 
-type GetClustersResponse struct {
+type Health struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clusters []*ClusterSpec `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Issue []*Issue `protobuf:"bytes,1,rep,name=issue,proto3" json:"issue,omitempty"`
 }
 
-func (x *GetClustersResponse) Reset() {
-	*x = GetClustersResponse{}
+func (x *Health) Reset() {
+	*x = Health{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[10]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetClustersResponse) String() string {
+func (x *Health) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClustersResponse) ProtoMessage() {}
+func (*Health) ProtoMessage() {}
 
-func (x *GetClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[10]
+func (x *Health) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,45 +1215,73 @@ func (x *GetClustersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClustersResponse.ProtoReflect.Descriptor instead.
-func (*GetClustersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Health.ProtoReflect.Descriptor instead.
+func (*Health) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetClustersResponse) GetClusters() []*ClusterSpec {
+func (x *Health) GetIssue() []*Issue {
 	if x != nil {
-		return x.Clusters
+		return x.Issue
 	}
 	return nil
 }
 
-type ClusterResponse struct {
+type ClusterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName   string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	NodeGroupName string `protobuf:"bytes,2,opt,name=nodeGroupName,proto3" json:"nodeGroupName,omitempty"`
-	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Provider          string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region            string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName       string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName       string            `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Node              *NodeSpec         `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
+	Labels            map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KubernetesVersion string            `protobuf:"bytes,7,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	//vpcId existing VPC to place the cluster in, region network stack is not used when set
+	VpcId string `protobuf:"bytes,8,opt,name=vpcId,proto3" json:"vpcId,omitempty"`
+	//subnetIds subnets of vpcId, at least two availability zones are required
+	SubnetIds []string `protobuf:"bytes,9,rep,name=subnetIds,proto3" json:"subnetIds,omitempty"`
+	//securityGroupIds additional security groups attached to the control plane
+	SecurityGroupIds []string       `protobuf:"bytes,10,rep,name=securityGroupIds,proto3" json:"securityGroupIds,omitempty"`
+	EndpointAccess   EndpointAccess `protobuf:"varint,11,opt,name=endpointAccess,proto3,enum=spawner.EndpointAccess" json:"endpointAccess,omitempty"`
+	//publicAccessCidrs allowed to reach the public endpoint, open to all when empty
+	PublicAccessCidrs []string `protobuf:"bytes,12,rep,name=publicAccessCidrs,proto3" json:"publicAccessCidrs,omitempty"`
+	//networkStack options of the region network stack, used only when the stack is created
+	NetworkStack *NetworkStackOptions `protobuf:"bytes,13,opt,name=networkStack,proto3" json:"networkStack,omitempty"`
+	//addons installed once the cluster is active
+	Addons     []*Addon           `protobuf:"bytes,14,rep,name=addons,proto3" json:"addons,omitempty"`
+	Logging    *ClusterLogging    `protobuf:"bytes,15,opt,name=logging,proto3" json:"logging,omitempty"`
+	Encryption *SecretsEncryption `protobuf:"bytes,16,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	//identity of the AKS cluster, system assigned managed identity when not set
+	Identity *ClusterIdentity `protobuf:"bytes,17,opt,name=identity,proto3" json:"identity,omitempty"`
+	//azureNetwork network options of the AKS cluster, managed vnet with kubenet when not set.
+	//system pool zones are taken from node.availabilityZones
+	AzureNetwork *AzureNetworkOptions `protobuf:"bytes,18,opt,name=azureNetwork,proto3" json:"azureNetwork,omitempty"`
+	//resourceGroup Azure resource group of the cluster, created when missing. account resource group when empty
+	ResourceGroup string `protobuf:"bytes,19,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
+	//bootstrap applied once the cluster is active and has a ready node, progress is reported by GetBootstrapStatus
+	Bootstrap *Bootstrap `protobuf:"bytes,20,opt,name=bootstrap,proto3" json:"bootstrap,omitempty"`
 }
 
-func (x *ClusterResponse) Reset() {
-	*x = ClusterResponse{}
+func (x *ClusterRequest) Reset() {
+	*x = ClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[11]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClusterResponse) String() string {
+func (x *ClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterResponse) ProtoMessage() {}
+func (*ClusterRequest) ProtoMessage() {}
 
-func (x *ClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[11]
+func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,129 +1292,189 @@ func (x *ClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterResponse.ProtoReflect.Descriptor instead.
-func (*ClusterResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ClusterRequest.ProtoReflect.Descriptor instead.
+func (*ClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ClusterResponse) GetClusterName() string {
+func (x *ClusterRequest) GetProvider() string {
 	if x != nil {
-		return x.ClusterName
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *ClusterResponse) GetNodeGroupName() string {
+func (x *ClusterRequest) GetRegion() string {
 	if x != nil {
-		return x.NodeGroupName
+		return x.Region
 	}
 	return ""
 }
 
-func (x *ClusterResponse) GetError() string {
+func (x *ClusterRequest) GetAccountName() string {
 	if x != nil {
-		return x.Error
+		return x.AccountName
 	}
 	return ""
 }
 
-type ClusterStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+func (x *ClusterRequest) GetNode() *NodeSpec {
+	if x != nil {
+		return x.Node
+	}
+	return nil
 }
 
-func (x *ClusterStatusRequest) Reset() {
-	*x = ClusterStatusRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ClusterRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
+	return nil
 }
 
-func (x *ClusterStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ClusterRequest) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
+	}
+	return ""
 }
 
-func (*ClusterStatusRequest) ProtoMessage() {}
+func (x *ClusterRequest) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
 
-func (x *ClusterStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ClusterRequest) GetSubnetIds() []string {
+	if x != nil {
+		return x.SubnetIds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ClusterStatusRequest.ProtoReflect.Descriptor instead.
-func (*ClusterStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{12}
+func (x *ClusterRequest) GetSecurityGroupIds() []string {
+	if x != nil {
+		return x.SecurityGroupIds
+	}
+	return nil
 }
 
-func (x *ClusterStatusRequest) GetProvider() string {
+func (x *ClusterRequest) GetEndpointAccess() EndpointAccess {
 	if x != nil {
-		return x.Provider
+		return x.EndpointAccess
 	}
-	return ""
+	return EndpointAccess_PUBLIC
 }
 
-func (x *ClusterStatusRequest) GetRegion() string {
+func (x *ClusterRequest) GetPublicAccessCidrs() []string {
 	if x != nil {
-		return x.Region
+		return x.PublicAccessCidrs
 	}
-	return ""
+	return nil
 }
 
-func (x *ClusterStatusRequest) GetAccountName() string {
+func (x *ClusterRequest) GetNetworkStack() *NetworkStackOptions {
 	if x != nil {
-		return x.AccountName
+		return x.NetworkStack
 	}
-	return ""
+	return nil
 }
 
-func (x *ClusterStatusRequest) GetClusterName() string {
+func (x *ClusterRequest) GetAddons() []*Addon {
 	if x != nil {
-		return x.ClusterName
+		return x.Addons
+	}
+	return nil
+}
+
+func (x *ClusterRequest) GetLogging() *ClusterLogging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+func (x *ClusterRequest) GetEncryption() *SecretsEncryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+func (x *ClusterRequest) GetIdentity() *ClusterIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *ClusterRequest) GetAzureNetwork() *AzureNetworkOptions {
+	if x != nil {
+		return x.AzureNetwork
+	}
+	return nil
+}
+
+func (x *ClusterRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
 	}
 	return ""
 }
 
-type ClusterStatusResponse struct {
+func (x *ClusterRequest) GetBootstrap() *Bootstrap {
+	if x != nil {
+		return x.Bootstrap
+	}
+	return nil
+}
+
+// AzureNetworkOptions network setup of AKS cluster
+type AzureNetworkOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-}
-
-func (x *ClusterStatusResponse) Reset() {
-	*x = ClusterStatusResponse{}
+	NetworkPlugin NetworkPlugin `protobuf:"varint,1,opt,name=networkPlugin,proto3,enum=spawner.NetworkPlugin" json:"networkPlugin,omitempty"`
+	//subnetId existing subnet resource id to place the nodes in, AKS creates a managed vnet when empty
+	SubnetId string `protobuf:"bytes,2,opt,name=subnetId,proto3" json:"subnetId,omitempty"`
+	//podCidr used only with KUBENET, pods get subnet addresses with AZURE_CNI
+	PodCidr string `protobuf:"bytes,3,opt,name=podCidr,proto3" json:"podCidr,omitempty"`
+	//serviceCidr must not overlap the subnet, dnsServiceIp has to be in it
+	ServiceCidr  string       `protobuf:"bytes,4,opt,name=serviceCidr,proto3" json:"serviceCidr,omitempty"`
+	DnsServiceIp string       `protobuf:"bytes,5,opt,name=dnsServiceIp,proto3" json:"dnsServiceIp,omitempty"`
+	OutboundType OutboundType `protobuf:"varint,6,opt,name=outboundType,proto3,enum=spawner.OutboundType" json:"outboundType,omitempty"`
+	//privateCluster API server is reachable only from the cluster vnet
+	PrivateCluster bool `protobuf:"varint,7,opt,name=privateCluster,proto3" json:"privateCluster,omitempty"`
+	//authorizedIpRanges allowed to reach the public API server, open to all when empty
+	AuthorizedIpRanges []string `protobuf:"bytes,8,rep,name=authorizedIpRanges,proto3" json:"authorizedIpRanges,omitempty"`
+}
+
+func (x *AzureNetworkOptions) Reset() {
+	*x = AzureNetworkOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[13]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClusterStatusResponse) String() string {
+func (x *AzureNetworkOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterStatusResponse) ProtoMessage() {}
+func (*AzureNetworkOptions) ProtoMessage() {}
 
-func (x *ClusterStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[13]
+func (x *AzureNetworkOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,52 +1485,98 @@ func (x *ClusterStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterStatusResponse.ProtoReflect.Descriptor instead.
-func (*ClusterStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AzureNetworkOptions.ProtoReflect.Descriptor instead.
+func (*AzureNetworkOptions) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ClusterStatusResponse) GetStatus() string {
+func (x *AzureNetworkOptions) GetNetworkPlugin() NetworkPlugin {
 	if x != nil {
-		return x.Status
+		return x.NetworkPlugin
+	}
+	return NetworkPlugin_KUBENET
+}
+
+func (x *AzureNetworkOptions) GetSubnetId() string {
+	if x != nil {
+		return x.SubnetId
 	}
 	return ""
 }
 
-func (x *ClusterStatusResponse) GetError() string {
+func (x *AzureNetworkOptions) GetPodCidr() string {
 	if x != nil {
-		return x.Error
+		return x.PodCidr
 	}
 	return ""
 }
 
-type AddTokenRequest struct {
+func (x *AzureNetworkOptions) GetServiceCidr() string {
+	if x != nil {
+		return x.ServiceCidr
+	}
+	return ""
+}
+
+func (x *AzureNetworkOptions) GetDnsServiceIp() string {
+	if x != nil {
+		return x.DnsServiceIp
+	}
+	return ""
+}
+
+func (x *AzureNetworkOptions) GetOutboundType() OutboundType {
+	if x != nil {
+		return x.OutboundType
+	}
+	return OutboundType_LOAD_BALANCER
+}
+
+func (x *AzureNetworkOptions) GetPrivateCluster() bool {
+	if x != nil {
+		return x.PrivateCluster
+	}
+	return false
+}
+
+func (x *AzureNetworkOptions) GetAuthorizedIpRanges() []string {
+	if x != nil {
+		return x.AuthorizedIpRanges
+	}
+	return nil
+}
+
+// ClusterIdentity identity the AKS control plane and kubelet use to manage Azure resources
+type ClusterIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	ClusterName string `protobuf:"bytes,3,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Type ClusterIdentityType `protobuf:"varint,1,opt,name=type,proto3,enum=spawner.ClusterIdentityType" json:"type,omitempty"`
+	//identityId user assigned identity resource id of the control plane, required for USER_ASSIGNED
+	IdentityId string `protobuf:"bytes,2,opt,name=identityId,proto3" json:"identityId,omitempty"`
+	//kubeletIdentityId user assigned identity resource id of kubelet, AKS creates one when empty.
+	//requires USER_ASSIGNED control plane identity
+	KubeletIdentityId string `protobuf:"bytes,3,opt,name=kubeletIdentityId,proto3" json:"kubeletIdentityId,omitempty"`
 }
 
-func (x *AddTokenRequest) Reset() {
-	*x = AddTokenRequest{}
+func (x *ClusterIdentity) Reset() {
+	*x = ClusterIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddTokenRequest) String() string {
+func (x *ClusterIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTokenRequest) ProtoMessage() {}
+func (*ClusterIdentity) ProtoMessage() {}
 
-func (x *AddTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
+func (x *ClusterIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,58 +1587,61 @@ func (x *AddTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTokenRequest.ProtoReflect.Descriptor instead.
-func (*AddTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ClusterIdentity.ProtoReflect.Descriptor instead.
+func (*ClusterIdentity) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AddTokenRequest) GetProvider() string {
+func (x *ClusterIdentity) GetType() ClusterIdentityType {
 	if x != nil {
-		return x.Provider
+		return x.Type
 	}
-	return ""
+	return ClusterIdentityType_SYSTEM_ASSIGNED
 }
 
-func (x *AddTokenRequest) GetRegion() string {
+func (x *ClusterIdentity) GetIdentityId() string {
 	if x != nil {
-		return x.Region
+		return x.IdentityId
 	}
 	return ""
 }
 
-func (x *AddTokenRequest) GetClusterName() string {
+func (x *ClusterIdentity) GetKubeletIdentityId() string {
 	if x != nil {
-		return x.ClusterName
+		return x.KubeletIdentityId
 	}
 	return ""
 }
 
-type AddTokenResponse struct {
+// ClusterLogging control plane logs shipped to CloudWatch on AWS, Log Analytics workspace on Azure
+type ClusterLogging struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	//types api, audit, authenticator, controllerManager, scheduler, logging is turned off when empty
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	//workspaceId Log Analytics workspace resource id, required on Azure
+	WorkspaceId string `protobuf:"bytes,2,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
 }
 
-func (x *AddTokenResponse) Reset() {
-	*x = AddTokenResponse{}
+func (x *ClusterLogging) Reset() {
+	*x = ClusterLogging{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddTokenResponse) String() string {
+func (x *ClusterLogging) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTokenResponse) ProtoMessage() {}
+func (*ClusterLogging) ProtoMessage() {}
 
-func (x *AddTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
+func (x *ClusterLogging) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,53 +1652,53 @@ func (x *AddTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTokenResponse.ProtoReflect.Descriptor instead.
-func (*AddTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ClusterLogging.ProtoReflect.Descriptor instead.
+func (*ClusterLogging) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AddTokenResponse) GetStatus() string {
+func (x *ClusterLogging) GetTypes() []string {
 	if x != nil {
-		return x.Status
+		return x.Types
 	}
-	return ""
+	return nil
 }
 
-func (x *AddTokenResponse) GetError() string {
+func (x *ClusterLogging) GetWorkspaceId() string {
 	if x != nil {
-		return x.Error
+		return x.WorkspaceId
 	}
 	return ""
 }
 
-type GetTokenRequest struct {
+// SecretsEncryption envelope encryption of kubernetes secrets
+type SecretsEncryption struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	//keyId KMS key ARN on AWS, spawner creates a key when empty. Key Vault key identifier on Azure
+	KeyId string `protobuf:"bytes,2,opt,name=keyId,proto3" json:"keyId,omitempty"`
 }
 
-func (x *GetTokenRequest) Reset() {
-	*x = GetTokenRequest{}
+func (x *SecretsEncryption) Reset() {
+	*x = SecretsEncryption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTokenRequest) String() string {
+func (x *SecretsEncryption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenRequest) ProtoMessage() {}
+func (*SecretsEncryption) ProtoMessage() {}
 
-func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
+func (x *SecretsEncryption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,68 +1709,59 @@ func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
-func (*GetTokenRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetTokenRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *GetTokenRequest) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
+// Deprecated: Use SecretsEncryption.ProtoReflect.Descriptor instead.
+func (*SecretsEncryption) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetTokenRequest) GetAccountName() string {
+func (x *SecretsEncryption) GetEnabled() bool {
 	if x != nil {
-		return x.AccountName
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *GetTokenRequest) GetClusterName() string {
+func (x *SecretsEncryption) GetKeyId() string {
 	if x != nil {
-		return x.ClusterName
+		return x.KeyId
 	}
 	return ""
 }
 
-type GetTokenResponse struct {
+// NetworkStackOptions region network stack layout, stack is shared by all clusters of the region
+type NetworkStackOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Status   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Error    string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	CaData   string `protobuf:"bytes,5,opt,name=ca_data,json=caData,proto3" json:"ca_data,omitempty"`
+	//vpcCidr defaults to 192.168.0.0/16, subnets are carved out of it evenly
+	VpcCidr string `protobuf:"bytes,1,opt,name=vpcCidr,proto3" json:"vpcCidr,omitempty"`
+	//zones number of availability zones to span, defaults to 3
+	Zones int32 `protobuf:"varint,2,opt,name=zones,proto3" json:"zones,omitempty"`
+	//privateSubnets add private subnet per zone behind NAT gateway, worker nodes are placed in private subnets
+	PrivateSubnets bool       `protobuf:"varint,3,opt,name=privateSubnets,proto3" json:"privateSubnets,omitempty"`
+	NatGateway     NatGateway `protobuf:"varint,4,opt,name=natGateway,proto3,enum=spawner.NatGateway" json:"natGateway,omitempty"`
+	//vpcEndpoints S3 gateway and ECR interface endpoints for the private subnets
+	VpcEndpoints bool `protobuf:"varint,5,opt,name=vpcEndpoints,proto3" json:"vpcEndpoints,omitempty"`
 }
 
-func (x *GetTokenResponse) Reset() {
-	*x = GetTokenResponse{}
+func (x *NetworkStackOptions) Reset() {
+	*x = NetworkStackOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTokenResponse) String() string {
+func (x *NetworkStackOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenResponse) ProtoMessage() {}
+func (*NetworkStackOptions) ProtoMessage() {}
 
-func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
+func (x *NetworkStackOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,47 +1772,47 @@ func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenResponse.ProtoReflect.Descriptor instead.
-func (*GetTokenResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use NetworkStackOptions.ProtoReflect.Descriptor instead.
+func (*NetworkStackOptions) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetTokenResponse) GetToken() string {
+func (x *NetworkStackOptions) GetVpcCidr() string {
 	if x != nil {
-		return x.Token
+		return x.VpcCidr
 	}
 	return ""
 }
 
-func (x *GetTokenResponse) GetEndpoint() string {
+func (x *NetworkStackOptions) GetZones() int32 {
 	if x != nil {
-		return x.Endpoint
+		return x.Zones
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTokenResponse) GetStatus() string {
+func (x *NetworkStackOptions) GetPrivateSubnets() bool {
 	if x != nil {
-		return x.Status
+		return x.PrivateSubnets
 	}
-	return ""
+	return false
 }
 
-func (x *GetTokenResponse) GetError() string {
+func (x *NetworkStackOptions) GetNatGateway() NatGateway {
 	if x != nil {
-		return x.Error
+		return x.NatGateway
 	}
-	return ""
+	return NatGateway_NAT_SINGLE
 }
 
-func (x *GetTokenResponse) GetCaData() string {
+func (x *NetworkStackOptions) GetVpcEndpoints() bool {
 	if x != nil {
-		return x.CaData
+		return x.VpcEndpoints
 	}
-	return ""
+	return false
 }
 
-type AddRoute53RecordRequest struct {
+type GetClusterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1340,27 +1820,26 @@ type AddRoute53RecordRequest struct {
 	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
 	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	DnsName     string `protobuf:"bytes,4,opt,name=dnsName,proto3" json:"dnsName,omitempty"`
-	RecordName  string `protobuf:"bytes,5,opt,name=recordName,proto3" json:"recordName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *AddRoute53RecordRequest) Reset() {
-	*x = AddRoute53RecordRequest{}
+func (x *GetClusterRequest) Reset() {
+	*x = GetClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddRoute53RecordRequest) String() string {
+func (x *GetClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRoute53RecordRequest) ProtoMessage() {}
+func (*GetClusterRequest) ProtoMessage() {}
 
-func (x *AddRoute53RecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
+func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,72 +1850,66 @@ func (x *AddRoute53RecordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRoute53RecordRequest.ProtoReflect.Descriptor instead.
-func (*AddRoute53RecordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetClusterRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AddRoute53RecordRequest) GetProvider() string {
+func (x *GetClusterRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *AddRoute53RecordRequest) GetRegion() string {
+func (x *GetClusterRequest) GetRegion() string {
 	if x != nil {
 		return x.Region
 	}
 	return ""
 }
 
-func (x *AddRoute53RecordRequest) GetAccountName() string {
+func (x *GetClusterRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *AddRoute53RecordRequest) GetDnsName() string {
-	if x != nil {
-		return x.DnsName
-	}
-	return ""
-}
-
-func (x *AddRoute53RecordRequest) GetRecordName() string {
+func (x *GetClusterRequest) GetClusterName() string {
 	if x != nil {
-		return x.RecordName
+		return x.ClusterName
 	}
 	return ""
 }
 
-type AddRoute53RecordResponse struct {
+type GetClustersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
 }
 
-func (x *AddRoute53RecordResponse) Reset() {
-	*x = AddRoute53RecordResponse{}
+func (x *GetClustersRequest) Reset() {
+	*x = GetClustersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddRoute53RecordResponse) String() string {
+func (x *GetClustersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRoute53RecordResponse) ProtoMessage() {}
+func (*GetClustersRequest) ProtoMessage() {}
 
-func (x *AddRoute53RecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
+func (x *GetClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,54 +1920,62 @@ func (x *AddRoute53RecordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRoute53RecordResponse.ProtoReflect.Descriptor instead.
-func (*AddRoute53RecordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetClustersRequest.ProtoReflect.Descriptor instead.
+func (*GetClustersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddRoute53RecordResponse) GetStatus() string {
+func (x *GetClustersRequest) GetProvider() string {
 	if x != nil {
-		return x.Status
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *AddRoute53RecordResponse) GetError() string {
+func (x *GetClustersRequest) GetRegion() string {
 	if x != nil {
-		return x.Error
+		return x.Region
 	}
 	return ""
 }
 
-type NodeSpawnRequest struct {
+func (x *GetClustersRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type ClusterSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string    `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string    `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string    `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string    `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	NodeSpec    *NodeSpec `protobuf:"bytes,5,opt,name=nodeSpec,proto3" json:"nodeSpec,omitempty"`
+	Name              string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ClusterId         string       `protobuf:"bytes,2,opt,name=clusterId,proto3" json:"clusterId,omitempty"`
+	NodeSpec          []*NodeSpec  `protobuf:"bytes,3,rep,name=nodeSpec,proto3" json:"nodeSpec,omitempty"`
+	KubernetesVersion string       `protobuf:"bytes,4,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	State             ClusterState `protobuf:"varint,5,opt,name=state,proto3,enum=spawner.ClusterState" json:"state,omitempty"`
+	ProviderStatus    string       `protobuf:"bytes,6,opt,name=providerStatus,proto3" json:"providerStatus,omitempty"`
 }
 
-func (x *NodeSpawnRequest) Reset() {
-	*x = NodeSpawnRequest{}
+func (x *ClusterSpec) Reset() {
+	*x = ClusterSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeSpawnRequest) String() string {
+func (x *ClusterSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeSpawnRequest) ProtoMessage() {}
+func (*ClusterSpec) ProtoMessage() {}
 
-func (x *NodeSpawnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
+func (x *ClusterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1505,71 +1986,78 @@ func (x *NodeSpawnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeSpawnRequest.ProtoReflect.Descriptor instead.
-func (*NodeSpawnRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ClusterSpec.ProtoReflect.Descriptor instead.
+func (*ClusterSpec) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *NodeSpawnRequest) GetProvider() string {
+func (x *ClusterSpec) GetName() string {
 	if x != nil {
-		return x.Provider
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NodeSpawnRequest) GetRegion() string {
+func (x *ClusterSpec) GetClusterId() string {
 	if x != nil {
-		return x.Region
+		return x.ClusterId
 	}
 	return ""
 }
 
-func (x *NodeSpawnRequest) GetAccountName() string {
+func (x *ClusterSpec) GetNodeSpec() []*NodeSpec {
 	if x != nil {
-		return x.AccountName
+		return x.NodeSpec
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeSpawnRequest) GetClusterName() string {
+func (x *ClusterSpec) GetKubernetesVersion() string {
 	if x != nil {
-		return x.ClusterName
+		return x.KubernetesVersion
 	}
 	return ""
 }
 
-func (x *NodeSpawnRequest) GetNodeSpec() *NodeSpec {
+func (x *ClusterSpec) GetState() ClusterState {
 	if x != nil {
-		return x.NodeSpec
+		return x.State
 	}
-	return nil
+	return ClusterState_STATE_UNKNOWN
 }
 
-type NodeSpawnResponse struct {
+func (x *ClusterSpec) GetProviderStatus() string {
+	if x != nil {
+		return x.ProviderStatus
+	}
+	return ""
+}
+
+type GetClustersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Clusters []*ClusterSpec `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
 }
 
-func (x *NodeSpawnResponse) Reset() {
-	*x = NodeSpawnResponse{}
+func (x *GetClustersResponse) Reset() {
+	*x = GetClustersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeSpawnResponse) String() string {
+func (x *GetClustersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeSpawnResponse) ProtoMessage() {}
+func (*GetClustersResponse) ProtoMessage() {}
 
-func (x *NodeSpawnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
+func (x *GetClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,47 +2068,47 @@ func (x *NodeSpawnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeSpawnResponse.ProtoReflect.Descriptor instead.
-func (*NodeSpawnResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetClustersResponse.ProtoReflect.Descriptor instead.
+func (*GetClustersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *NodeSpawnResponse) GetError() string {
+func (x *GetClustersResponse) GetClusters() []*ClusterSpec {
 	if x != nil {
-		return x.Error
+		return x.Clusters
 	}
-	return ""
+	return nil
 }
 
-type ClusterDeleteRequest struct {
+type ClusterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	ForceDelete bool   `protobuf:"varint,5,opt,name=forceDelete,proto3" json:"forceDelete,omitempty"`
+	ClusterName   string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeGroupName string `protobuf:"bytes,2,opt,name=nodeGroupName,proto3" json:"nodeGroupName,omitempty"`
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	//warnings request options the provider ignored
+	Warnings []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *ClusterDeleteRequest) Reset() {
-	*x = ClusterDeleteRequest{}
+func (x *ClusterResponse) Reset() {
+	*x = ClusterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClusterDeleteRequest) String() string {
+func (x *ClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterDeleteRequest) ProtoMessage() {}
+func (*ClusterResponse) ProtoMessage() {}
 
-func (x *ClusterDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
+func (x *ClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1631,71 +2119,67 @@ func (x *ClusterDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterDeleteRequest.ProtoReflect.Descriptor instead.
-func (*ClusterDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ClusterDeleteRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+// Deprecated: Use ClusterResponse.ProtoReflect.Descriptor instead.
+func (*ClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ClusterDeleteRequest) GetRegion() string {
+func (x *ClusterResponse) GetClusterName() string {
 	if x != nil {
-		return x.Region
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *ClusterDeleteRequest) GetAccountName() string {
+func (x *ClusterResponse) GetNodeGroupName() string {
 	if x != nil {
-		return x.AccountName
+		return x.NodeGroupName
 	}
 	return ""
 }
 
-func (x *ClusterDeleteRequest) GetClusterName() string {
+func (x *ClusterResponse) GetError() string {
 	if x != nil {
-		return x.ClusterName
+		return x.Error
 	}
 	return ""
 }
 
-func (x *ClusterDeleteRequest) GetForceDelete() bool {
+func (x *ClusterResponse) GetWarnings() []string {
 	if x != nil {
-		return x.ForceDelete
+		return x.Warnings
 	}
-	return false
+	return nil
 }
 
-type ClusterDeleteResponse struct {
+type ClusterStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *ClusterDeleteResponse) Reset() {
-	*x = ClusterDeleteResponse{}
+func (x *ClusterStatusRequest) Reset() {
+	*x = ClusterStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClusterDeleteResponse) String() string {
+func (x *ClusterStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterDeleteResponse) ProtoMessage() {}
+func (*ClusterStatusRequest) ProtoMessage() {}
 
-func (x *ClusterDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
+func (x *ClusterStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1706,47 +2190,69 @@ func (x *ClusterDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterDeleteResponse.ProtoReflect.Descriptor instead.
-func (*ClusterDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ClusterStatusRequest.ProtoReflect.Descriptor instead.
+func (*ClusterStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ClusterDeleteResponse) GetError() string {
+func (x *ClusterStatusRequest) GetProvider() string {
 	if x != nil {
-		return x.Error
+		return x.Provider
 	}
 	return ""
 }
 
-type NodeDeleteRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ClusterStatusRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
 
-	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region        string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName   string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName   string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	NodeGroupName string `protobuf:"bytes,5,opt,name=nodeGroupName,proto3" json:"nodeGroupName,omitempty"`
+func (x *ClusterStatusRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
 }
 
-func (x *NodeDeleteRequest) Reset() {
-	*x = NodeDeleteRequest{}
+func (x *ClusterStatusRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type ClusterStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//status kept for older clients, raw EKS status on AWS and active/inactive on Azure
+	Status string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	State  ClusterState `protobuf:"varint,3,opt,name=state,proto3,enum=spawner.ClusterState" json:"state,omitempty"`
+	//providerStatus raw provider status the state is mapped from
+	ProviderStatus string `protobuf:"bytes,4,opt,name=providerStatus,proto3" json:"providerStatus,omitempty"`
+}
+
+func (x *ClusterStatusResponse) Reset() {
+	*x = ClusterStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeDeleteRequest) String() string {
+func (x *ClusterStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeDeleteRequest) ProtoMessage() {}
+func (*ClusterStatusResponse) ProtoMessage() {}
 
-func (x *NodeDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
+func (x *ClusterStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,71 +2263,66 @@ func (x *NodeDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeDeleteRequest.ProtoReflect.Descriptor instead.
-func (*NodeDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *NodeDeleteRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+// Deprecated: Use ClusterStatusResponse.ProtoReflect.Descriptor instead.
+func (*ClusterStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *NodeDeleteRequest) GetRegion() string {
+func (x *ClusterStatusResponse) GetStatus() string {
 	if x != nil {
-		return x.Region
+		return x.Status
 	}
 	return ""
 }
 
-func (x *NodeDeleteRequest) GetAccountName() string {
+func (x *ClusterStatusResponse) GetError() string {
 	if x != nil {
-		return x.AccountName
+		return x.Error
 	}
 	return ""
 }
 
-func (x *NodeDeleteRequest) GetClusterName() string {
+func (x *ClusterStatusResponse) GetState() ClusterState {
 	if x != nil {
-		return x.ClusterName
+		return x.State
 	}
-	return ""
+	return ClusterState_STATE_UNKNOWN
 }
 
-func (x *NodeDeleteRequest) GetNodeGroupName() string {
+func (x *ClusterStatusResponse) GetProviderStatus() string {
 	if x != nil {
-		return x.NodeGroupName
+		return x.ProviderStatus
 	}
 	return ""
 }
 
-type NodeDeleteResponse struct {
+type AddTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	ClusterName string `protobuf:"bytes,3,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *NodeDeleteResponse) Reset() {
-	*x = NodeDeleteResponse{}
+func (x *AddTokenRequest) Reset() {
+	*x = AddTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeDeleteResponse) String() string {
+func (x *AddTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeDeleteResponse) ProtoMessage() {}
+func (*AddTokenRequest) ProtoMessage() {}
 
-func (x *NodeDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
+func (x *AddTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1832,52 +2333,58 @@ func (x *NodeDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeDeleteResponse.ProtoReflect.Descriptor instead.
-func (*NodeDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AddTokenRequest.ProtoReflect.Descriptor instead.
+func (*AddTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *NodeDeleteResponse) GetError() string {
+func (x *AddTokenRequest) GetProvider() string {
 	if x != nil {
-		return x.Error
+		return x.Provider
 	}
 	return ""
 }
 
-type CreateVolumeRequest struct {
+func (x *AddTokenRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *AddTokenRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type AddTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider         string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region           string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName      string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	Availabilityzone string            `protobuf:"bytes,4,opt,name=availabilityzone,proto3" json:"availabilityzone,omitempty"`
-	Volumetype       string            `protobuf:"bytes,5,opt,name=volumetype,proto3" json:"volumetype,omitempty"`
-	Size             int64             `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
-	Snapshotid       string            `protobuf:"bytes,7,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
-	Labels           map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	SnapshotUri      string            `protobuf:"bytes,9,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
-	DeleteSnapshot   bool              `protobuf:"varint,10,opt,name=deleteSnapshot,proto3" json:"deleteSnapshot,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *CreateVolumeRequest) Reset() {
-	*x = CreateVolumeRequest{}
+func (x *AddTokenResponse) Reset() {
+	*x = AddTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateVolumeRequest) String() string {
+func (x *AddTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeRequest) ProtoMessage() {}
+func (*AddTokenResponse) ProtoMessage() {}
 
-func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
+func (x *AddTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1888,109 +2395,127 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AddTokenResponse.ProtoReflect.Descriptor instead.
+func (*AddTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateVolumeRequest) GetProvider() string {
+func (x *AddTokenResponse) GetStatus() string {
 	if x != nil {
-		return x.Provider
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreateVolumeRequest) GetRegion() string {
+func (x *AddTokenResponse) GetError() string {
 	if x != nil {
-		return x.Region
+		return x.Error
 	}
 	return ""
 }
 
-func (x *CreateVolumeRequest) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
+type GetTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *CreateVolumeRequest) GetAvailabilityzone() string {
-	if x != nil {
-		return x.Availabilityzone
+func (x *GetTokenRequest) Reset() {
+	*x = GetTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateVolumeRequest) GetVolumetype() string {
-	if x != nil {
-		return x.Volumetype
-	}
-	return ""
+func (x *GetTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateVolumeRequest) GetSize() int64 {
-	if x != nil {
-		return x.Size
+func (*GetTokenRequest) ProtoMessage() {}
+
+func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateVolumeRequest) GetSnapshotid() string {
+// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTokenRequest) GetProvider() string {
 	if x != nil {
-		return x.Snapshotid
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *CreateVolumeRequest) GetLabels() map[string]string {
+func (x *GetTokenRequest) GetRegion() string {
 	if x != nil {
-		return x.Labels
+		return x.Region
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateVolumeRequest) GetSnapshotUri() string {
+func (x *GetTokenRequest) GetAccountName() string {
 	if x != nil {
-		return x.SnapshotUri
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *CreateVolumeRequest) GetDeleteSnapshot() bool {
+func (x *GetTokenRequest) GetClusterName() string {
 	if x != nil {
-		return x.DeleteSnapshot
+		return x.ClusterName
 	}
-	return false
+	return ""
 }
 
-type CreateVolumeResponse struct {
+type GetTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// volume id is name
-	Volumeid    string `protobuf:"bytes,1,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
-	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	ResourceUri string `protobuf:"bytes,3,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Status   string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error    string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	CaData   string `protobuf:"bytes,5,opt,name=ca_data,json=caData,proto3" json:"ca_data,omitempty"`
+	//expirationTimestamp RFC3339 time the token expires, empty when it does not
+	ExpirationTimestamp string `protobuf:"bytes,6,opt,name=expirationTimestamp,proto3" json:"expirationTimestamp,omitempty"`
 }
 
-func (x *CreateVolumeResponse) Reset() {
-	*x = CreateVolumeResponse{}
+func (x *GetTokenResponse) Reset() {
+	*x = GetTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateVolumeResponse) String() string {
+func (x *GetTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeResponse) ProtoMessage() {}
+func (*GetTokenResponse) ProtoMessage() {}
 
-func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
+func (x *GetTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2001,33 +2526,54 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateVolumeResponse) GetVolumeid() string {
+func (x *GetTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Volumeid
+		return x.Token
 	}
 	return ""
 }
 
-func (x *CreateVolumeResponse) GetError() string {
+func (x *GetTokenResponse) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *GetTokenResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetTokenResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-func (x *CreateVolumeResponse) GetResourceUri() string {
+func (x *GetTokenResponse) GetCaData() string {
 	if x != nil {
-		return x.ResourceUri
+		return x.CaData
 	}
 	return ""
 }
 
-type DeleteVolumeRequest struct {
+func (x *GetTokenResponse) GetExpirationTimestamp() string {
+	if x != nil {
+		return x.ExpirationTimestamp
+	}
+	return ""
+}
+
+type AddRoute53RecordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2035,26 +2581,27 @@ type DeleteVolumeRequest struct {
 	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
 	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	Volumeid    string `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	DnsName     string `protobuf:"bytes,4,opt,name=dnsName,proto3" json:"dnsName,omitempty"`
+	RecordName  string `protobuf:"bytes,5,opt,name=recordName,proto3" json:"recordName,omitempty"`
 }
 
-func (x *DeleteVolumeRequest) Reset() {
-	*x = DeleteVolumeRequest{}
+func (x *AddRoute53RecordRequest) Reset() {
+	*x = AddRoute53RecordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteVolumeRequest) String() string {
+func (x *AddRoute53RecordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeRequest) ProtoMessage() {}
+func (*AddRoute53RecordRequest) ProtoMessage() {}
 
-func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
+func (x *AddRoute53RecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,65 +2612,72 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AddRoute53RecordRequest.ProtoReflect.Descriptor instead.
+func (*AddRoute53RecordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeleteVolumeRequest) GetProvider() string {
+func (x *AddRoute53RecordRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *DeleteVolumeRequest) GetRegion() string {
+func (x *AddRoute53RecordRequest) GetRegion() string {
 	if x != nil {
 		return x.Region
 	}
 	return ""
 }
 
-func (x *DeleteVolumeRequest) GetAccountName() string {
+func (x *AddRoute53RecordRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *DeleteVolumeRequest) GetVolumeid() string {
+func (x *AddRoute53RecordRequest) GetDnsName() string {
 	if x != nil {
-		return x.Volumeid
+		return x.DnsName
 	}
 	return ""
 }
 
-type DeleteVolumeResponse struct {
+func (x *AddRoute53RecordRequest) GetRecordName() string {
+	if x != nil {
+		return x.RecordName
+	}
+	return ""
+}
+
+type AddRoute53RecordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deleted bool   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *DeleteVolumeResponse) Reset() {
-	*x = DeleteVolumeResponse{}
+func (x *AddRoute53RecordResponse) Reset() {
+	*x = AddRoute53RecordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteVolumeResponse) String() string {
+func (x *AddRoute53RecordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeResponse) ProtoMessage() {}
+func (*AddRoute53RecordResponse) ProtoMessage() {}
 
-func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
+func (x *AddRoute53RecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,54 +2688,54 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AddRoute53RecordResponse.ProtoReflect.Descriptor instead.
+func (*AddRoute53RecordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeleteVolumeResponse) GetDeleted() bool {
+func (x *AddRoute53RecordResponse) GetStatus() string {
 	if x != nil {
-		return x.Deleted
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteVolumeResponse) GetError() string {
+func (x *AddRoute53RecordResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type CreateSnapshotRequest struct {
+type NodeSpawnRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	Volumeid    string            `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
-	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Provider    string    `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string    `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string    `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string    `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeSpec    *NodeSpec `protobuf:"bytes,5,opt,name=nodeSpec,proto3" json:"nodeSpec,omitempty"`
 }
 
-func (x *CreateSnapshotRequest) Reset() {
-	*x = CreateSnapshotRequest{}
+func (x *NodeSpawnRequest) Reset() {
+	*x = NodeSpawnRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSnapshotRequest) String() string {
+func (x *NodeSpawnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotRequest) ProtoMessage() {}
+func (*NodeSpawnRequest) ProtoMessage() {}
 
-func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
+func (x *NodeSpawnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2192,74 +2746,73 @@ func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use NodeSpawnRequest.ProtoReflect.Descriptor instead.
+func (*NodeSpawnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateSnapshotRequest) GetProvider() string {
+func (x *NodeSpawnRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *CreateSnapshotRequest) GetRegion() string {
+func (x *NodeSpawnRequest) GetRegion() string {
 	if x != nil {
 		return x.Region
 	}
 	return ""
 }
 
-func (x *CreateSnapshotRequest) GetAccountName() string {
+func (x *NodeSpawnRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *CreateSnapshotRequest) GetVolumeid() string {
+func (x *NodeSpawnRequest) GetClusterName() string {
 	if x != nil {
-		return x.Volumeid
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *CreateSnapshotRequest) GetLabels() map[string]string {
+func (x *NodeSpawnRequest) GetNodeSpec() *NodeSpec {
 	if x != nil {
-		return x.Labels
+		return x.NodeSpec
 	}
 	return nil
 }
 
-type CreateSnapshotResponse struct {
+type NodeSpawnResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Snapshotid string `protobuf:"bytes,1,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
-	// must retain tag for backward compatibilty, left in commet for reference
-	// string error = 2;
-	SnapshotUri string `protobuf:"bytes,3,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	//warnings capacity preflight checks which passed with warning, provisioning might still run into the limit
+	Warnings []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *CreateSnapshotResponse) Reset() {
-	*x = CreateSnapshotResponse{}
+func (x *NodeSpawnResponse) Reset() {
+	*x = NodeSpawnResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSnapshotResponse) String() string {
+func (x *NodeSpawnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotResponse) ProtoMessage() {}
+func (*NodeSpawnResponse) ProtoMessage() {}
 
-func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
+func (x *NodeSpawnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,54 +2823,58 @@ func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use NodeSpawnResponse.ProtoReflect.Descriptor instead.
+func (*NodeSpawnResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateSnapshotResponse) GetSnapshotid() string {
+func (x *NodeSpawnResponse) GetError() string {
 	if x != nil {
-		return x.Snapshotid
+		return x.Error
 	}
 	return ""
 }
 
-func (x *CreateSnapshotResponse) GetSnapshotUri() string {
+func (x *NodeSpawnResponse) GetWarnings() []string {
 	if x != nil {
-		return x.SnapshotUri
+		return x.Warnings
 	}
-	return ""
+	return nil
 }
 
-type CreateSnapshotAndDeleteRequest struct {
+type ClusterDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	Volumeid    string            `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
-	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	ForceDelete bool   `protobuf:"varint,5,opt,name=forceDelete,proto3" json:"forceDelete,omitempty"`
+	//drain cordon the nodes and evict pods before deleting node pools, applicable with forceDelete
+	Drain bool `protobuf:"varint,6,opt,name=drain,proto3" json:"drain,omitempty"`
+	//drainTimeoutSeconds overrides the configured drain timeout, node pools are deleted once it expires
+	DrainTimeoutSeconds int32 `protobuf:"varint,7,opt,name=drainTimeoutSeconds,proto3" json:"drainTimeoutSeconds,omitempty"`
 }
 
-func (x *CreateSnapshotAndDeleteRequest) Reset() {
-	*x = CreateSnapshotAndDeleteRequest{}
+func (x *ClusterDeleteRequest) Reset() {
+	*x = ClusterDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSnapshotAndDeleteRequest) String() string {
+func (x *ClusterDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotAndDeleteRequest) ProtoMessage() {}
+func (*ClusterDeleteRequest) ProtoMessage() {}
 
-func (x *CreateSnapshotAndDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
+func (x *ClusterDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2328,75 +2885,85 @@ func (x *CreateSnapshotAndDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotAndDeleteRequest.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotAndDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ClusterDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ClusterDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateSnapshotAndDeleteRequest) GetProvider() string {
+func (x *ClusterDeleteRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *CreateSnapshotAndDeleteRequest) GetRegion() string {
+func (x *ClusterDeleteRequest) GetRegion() string {
 	if x != nil {
 		return x.Region
 	}
 	return ""
 }
 
-func (x *CreateSnapshotAndDeleteRequest) GetAccountName() string {
+func (x *ClusterDeleteRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *CreateSnapshotAndDeleteRequest) GetVolumeid() string {
+func (x *ClusterDeleteRequest) GetClusterName() string {
 	if x != nil {
-		return x.Volumeid
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *CreateSnapshotAndDeleteRequest) GetLabels() map[string]string {
+func (x *ClusterDeleteRequest) GetForceDelete() bool {
 	if x != nil {
-		return x.Labels
+		return x.ForceDelete
 	}
-	return nil
+	return false
 }
 
-type CreateSnapshotAndDeleteResponse struct {
+func (x *ClusterDeleteRequest) GetDrain() bool {
+	if x != nil {
+		return x.Drain
+	}
+	return false
+}
+
+func (x *ClusterDeleteRequest) GetDrainTimeoutSeconds() int32 {
+	if x != nil {
+		return x.DrainTimeoutSeconds
+	}
+	return 0
+}
+
+type ClusterDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Snapshotid string `protobuf:"bytes,1,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
-	Deleted    bool   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	// must retain tag for backward compatibilty, left in commet for reference
-	// string error = 3;
-	SnapshotUri string `protobuf:"bytes,4,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *CreateSnapshotAndDeleteResponse) Reset() {
-	*x = CreateSnapshotAndDeleteResponse{}
+func (x *ClusterDeleteResponse) Reset() {
+	*x = ClusterDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSnapshotAndDeleteResponse) String() string {
+func (x *ClusterDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSnapshotAndDeleteResponse) ProtoMessage() {}
+func (*ClusterDeleteResponse) ProtoMessage() {}
 
-func (x *CreateSnapshotAndDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
+func (x *ClusterDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2407,57 +2974,51 @@ func (x *CreateSnapshotAndDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSnapshotAndDeleteResponse.ProtoReflect.Descriptor instead.
-func (*CreateSnapshotAndDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *CreateSnapshotAndDeleteResponse) GetSnapshotid() string {
-	if x != nil {
-		return x.Snapshotid
-	}
-	return ""
-}
-
-func (x *CreateSnapshotAndDeleteResponse) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
-	}
-	return false
+// Deprecated: Use ClusterDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ClusterDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateSnapshotAndDeleteResponse) GetSnapshotUri() string {
+func (x *ClusterDeleteResponse) GetError() string {
 	if x != nil {
-		return x.SnapshotUri
+		return x.Error
 	}
 	return ""
 }
 
-type RancherRegistrationRequest struct {
+type NodeDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region        string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName   string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName   string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeGroupName string `protobuf:"bytes,5,opt,name=nodeGroupName,proto3" json:"nodeGroupName,omitempty"`
+	//drain cordon the nodes and evict pods before deleting the node pool
+	Drain bool `protobuf:"varint,6,opt,name=drain,proto3" json:"drain,omitempty"`
+	//drainTimeoutSeconds overrides the configured drain timeout, node pool is deleted once it expires
+	DrainTimeoutSeconds int32 `protobuf:"varint,7,opt,name=drainTimeoutSeconds,proto3" json:"drainTimeoutSeconds,omitempty"`
 }
 
-func (x *RancherRegistrationRequest) Reset() {
-	*x = RancherRegistrationRequest{}
+func (x *NodeDeleteRequest) Reset() {
+	*x = NodeDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RancherRegistrationRequest) String() string {
+func (x *NodeDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RancherRegistrationRequest) ProtoMessage() {}
+func (*NodeDeleteRequest) ProtoMessage() {}
 
-func (x *RancherRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
+func (x *NodeDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,47 +3029,85 @@ func (x *RancherRegistrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RancherRegistrationRequest.ProtoReflect.Descriptor instead.
-func (*RancherRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use NodeDeleteRequest.ProtoReflect.Descriptor instead.
+func (*NodeDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RancherRegistrationRequest) GetClusterName() string {
+func (x *NodeDeleteRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *NodeDeleteRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *NodeDeleteRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *NodeDeleteRequest) GetClusterName() string {
 	if x != nil {
 		return x.ClusterName
 	}
 	return ""
 }
 
-type RancherRegistrationResponse struct {
+func (x *NodeDeleteRequest) GetNodeGroupName() string {
+	if x != nil {
+		return x.NodeGroupName
+	}
+	return ""
+}
+
+func (x *NodeDeleteRequest) GetDrain() bool {
+	if x != nil {
+		return x.Drain
+	}
+	return false
+}
+
+func (x *NodeDeleteRequest) GetDrainTimeoutSeconds() int32 {
+	if x != nil {
+		return x.DrainTimeoutSeconds
+	}
+	return 0
+}
+
+type NodeDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// must retain tag for backward compatibilty, left in commet for reference
-	// string error = 1;
-	ClusterName string `protobuf:"bytes,2,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	ClusterID   string `protobuf:"bytes,3,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
-	ManifestURL string `protobuf:"bytes,4,opt,name=manifestURL,proto3" json:"manifestURL,omitempty"`
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *RancherRegistrationResponse) Reset() {
-	*x = RancherRegistrationResponse{}
+func (x *NodeDeleteResponse) Reset() {
+	*x = NodeDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RancherRegistrationResponse) String() string {
+func (x *NodeDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RancherRegistrationResponse) ProtoMessage() {}
+func (*NodeDeleteResponse) ProtoMessage() {}
 
-func (x *RancherRegistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
+func (x *NodeDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2519,65 +3118,54 @@ func (x *RancherRegistrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RancherRegistrationResponse.ProtoReflect.Descriptor instead.
-func (*RancherRegistrationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *RancherRegistrationResponse) GetClusterName() string {
-	if x != nil {
-		return x.ClusterName
-	}
-	return ""
-}
-
-func (x *RancherRegistrationResponse) GetClusterID() string {
-	if x != nil {
-		return x.ClusterID
-	}
-	return ""
+// Deprecated: Use NodeDeleteResponse.ProtoReflect.Descriptor instead.
+func (*NodeDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RancherRegistrationResponse) GetManifestURL() string {
+func (x *NodeDeleteResponse) GetError() string {
 	if x != nil {
-		return x.ManifestURL
+		return x.Error
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-type GetWorkspacesCostRequest struct {
+type CreateVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider     string   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	WorkspaceIds []string `protobuf:"bytes,1,rep,name=workspaceIds,proto3" json:"workspaceIds,omitempty"`
-	AccountName  string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	StartDate    string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate      string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Granularity  string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
-	CostType     string   `protobuf:"bytes,7,opt,name=costType,proto3" json:"costType,omitempty"`
-	GroupBy      *GroupBy `protobuf:"bytes,8,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	Provider         string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region           string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName      string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Availabilityzone string            `protobuf:"bytes,4,opt,name=availabilityzone,proto3" json:"availabilityzone,omitempty"`
+	Volumetype       string            `protobuf:"bytes,5,opt,name=volumetype,proto3" json:"volumetype,omitempty"`
+	Size             int64             `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Snapshotid       string            `protobuf:"bytes,7,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
+	Labels           map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SnapshotUri      string            `protobuf:"bytes,9,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
+	DeleteSnapshot   bool              `protobuf:"varint,10,opt,name=deleteSnapshot,proto3" json:"deleteSnapshot,omitempty"`
+	//resourceGroup Azure resource group of the disk, created when missing. account resource group when empty
+	ResourceGroup string `protobuf:"bytes,11,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
 }
 
-func (x *GetWorkspacesCostRequest) Reset() {
-	*x = GetWorkspacesCostRequest{}
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWorkspacesCostRequest) String() string {
+func (x *CreateVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspacesCostRequest) ProtoMessage() {}
+func (*CreateVolumeRequest) ProtoMessage() {}
 
-func (x *GetWorkspacesCostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2588,99 +3176,116 @@ func (x *GetWorkspacesCostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspacesCostRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspacesCostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetWorkspacesCostRequest) GetProvider() string {
+func (x *CreateVolumeRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *GetWorkspacesCostRequest) GetWorkspaceIds() []string {
+func (x *CreateVolumeRequest) GetRegion() string {
 	if x != nil {
-		return x.WorkspaceIds
+		return x.Region
 	}
-	return nil
+	return ""
 }
 
-func (x *GetWorkspacesCostRequest) GetAccountName() string {
+func (x *CreateVolumeRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *GetWorkspacesCostRequest) GetStartDate() string {
+func (x *CreateVolumeRequest) GetAvailabilityzone() string {
 	if x != nil {
-		return x.StartDate
+		return x.Availabilityzone
 	}
 	return ""
 }
 
-func (x *GetWorkspacesCostRequest) GetEndDate() string {
+func (x *CreateVolumeRequest) GetVolumetype() string {
 	if x != nil {
-		return x.EndDate
+		return x.Volumetype
 	}
 	return ""
 }
 
-func (x *GetWorkspacesCostRequest) GetGranularity() string {
+func (x *CreateVolumeRequest) GetSize() int64 {
 	if x != nil {
-		return x.Granularity
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *GetWorkspacesCostRequest) GetCostType() string {
+func (x *CreateVolumeRequest) GetSnapshotid() string {
 	if x != nil {
-		return x.CostType
+		return x.Snapshotid
 	}
 	return ""
 }
 
-func (x *GetWorkspacesCostRequest) GetGroupBy() *GroupBy {
+func (x *CreateVolumeRequest) GetLabels() map[string]string {
 	if x != nil {
-		return x.GroupBy
+		return x.Labels
 	}
 	return nil
 }
 
-type GetApplicationsCostRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ApplicationIds []string `protobuf:"bytes,1,rep,name=applicationIds,proto3" json:"applicationIds,omitempty"`
-	Provider       string   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	AccountName    string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	StartDate      string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate        string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Granularity    string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
-	CostType       string   `protobuf:"bytes,7,opt,name=costType,proto3" json:"costType,omitempty"`
-	GroupBy        *GroupBy `protobuf:"bytes,8,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+func (x *CreateVolumeRequest) GetSnapshotUri() string {
+	if x != nil {
+		return x.SnapshotUri
+	}
+	return ""
 }
 
-func (x *GetApplicationsCostRequest) Reset() {
-	*x = GetApplicationsCostRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateVolumeRequest) GetDeleteSnapshot() bool {
+	if x != nil {
+		return x.DeleteSnapshot
 	}
+	return false
 }
 
-func (x *GetApplicationsCostRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateVolumeRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
 }
 
-func (*GetApplicationsCostRequest) ProtoMessage() {}
+type CreateVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetApplicationsCostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
+	// volume id is name
+	Volumeid    string `protobuf:"bytes,1,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ResourceUri string `protobuf:"bytes,3,opt,name=resource_uri,json=resourceUri,proto3" json:"resource_uri,omitempty"`
+}
+
+func (x *CreateVolumeResponse) Reset() {
+	*x = CreateVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeResponse) ProtoMessage() {}
+
+func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2691,93 +3296,138 @@ func (x *GetApplicationsCostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationsCostRequest.ProtoReflect.Descriptor instead.
-func (*GetApplicationsCostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetApplicationsCostRequest) GetApplicationIds() []string {
+func (x *CreateVolumeResponse) GetVolumeid() string {
 	if x != nil {
-		return x.ApplicationIds
+		return x.Volumeid
 	}
-	return nil
+	return ""
 }
 
-func (x *GetApplicationsCostRequest) GetProvider() string {
+func (x *CreateVolumeResponse) GetError() string {
 	if x != nil {
-		return x.Provider
+		return x.Error
 	}
 	return ""
 }
 
-func (x *GetApplicationsCostRequest) GetAccountName() string {
+func (x *CreateVolumeResponse) GetResourceUri() string {
 	if x != nil {
-		return x.AccountName
+		return x.ResourceUri
 	}
 	return ""
 }
 
-func (x *GetApplicationsCostRequest) GetStartDate() string {
+type DeleteVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Volumeid    string `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	//resourceGroup Azure resource group of the disk, spawner created group is deleted with its last resource
+	ResourceGroup string `protobuf:"bytes,5,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
+}
+
+func (x *DeleteVolumeRequest) Reset() {
+	*x = DeleteVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeRequest) ProtoMessage() {}
+
+func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteVolumeRequest) GetProvider() string {
 	if x != nil {
-		return x.StartDate
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *GetApplicationsCostRequest) GetEndDate() string {
+func (x *DeleteVolumeRequest) GetRegion() string {
 	if x != nil {
-		return x.EndDate
+		return x.Region
 	}
 	return ""
 }
 
-func (x *GetApplicationsCostRequest) GetGranularity() string {
+func (x *DeleteVolumeRequest) GetAccountName() string {
 	if x != nil {
-		return x.Granularity
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *GetApplicationsCostRequest) GetCostType() string {
+func (x *DeleteVolumeRequest) GetVolumeid() string {
 	if x != nil {
-		return x.CostType
+		return x.Volumeid
 	}
 	return ""
 }
 
-func (x *GetApplicationsCostRequest) GetGroupBy() *GroupBy {
+func (x *DeleteVolumeRequest) GetResourceGroup() string {
 	if x != nil {
-		return x.GroupBy
+		return x.ResourceGroup
 	}
-	return nil
+	return ""
 }
 
-type GroupBy struct {
+type DeleteVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Deleted bool   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *GroupBy) Reset() {
-	*x = GroupBy{}
+func (x *DeleteVolumeResponse) Reset() {
+	*x = DeleteVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupBy) String() string {
+func (x *DeleteVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupBy) ProtoMessage() {}
+func (*DeleteVolumeResponse) ProtoMessage() {}
 
-func (x *GroupBy) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
+func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2788,51 +3438,56 @@ func (x *GroupBy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupBy.ProtoReflect.Descriptor instead.
-func (*GroupBy) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GroupBy) GetType() string {
+func (x *DeleteVolumeResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Type
+		return x.Deleted
 	}
-	return ""
+	return false
 }
 
-func (x *GroupBy) GetKey() string {
+func (x *DeleteVolumeResponse) GetError() string {
 	if x != nil {
-		return x.Key
+		return x.Error
 	}
 	return ""
 }
 
-type GetWorkspacesCostResponse struct {
+type CreateSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalCost   int64            `protobuf:"varint,1,opt,name=totalCost,proto3" json:"totalCost,omitempty"`
-	GroupedCost map[string]int64 `protobuf:"bytes,2,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Volumeid    string            `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//resourceGroup Azure resource group of the disk, snapshot is created in the same group
+	ResourceGroup string `protobuf:"bytes,6,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
 }
 
-func (x *GetWorkspacesCostResponse) Reset() {
-	*x = GetWorkspacesCostResponse{}
+func (x *CreateSnapshotRequest) Reset() {
+	*x = CreateSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWorkspacesCostResponse) String() string {
+func (x *CreateSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspacesCostResponse) ProtoMessage() {}
+func (*CreateSnapshotRequest) ProtoMessage() {}
 
-func (x *GetWorkspacesCostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
+func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,51 +3498,81 @@ func (x *GetWorkspacesCostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspacesCostResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkspacesCostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetWorkspacesCostResponse) GetTotalCost() int64 {
+func (x *CreateSnapshotRequest) GetProvider() string {
 	if x != nil {
-		return x.TotalCost
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *GetWorkspacesCostResponse) GetGroupedCost() map[string]int64 {
+func (x *CreateSnapshotRequest) GetRegion() string {
 	if x != nil {
-		return x.GroupedCost
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetVolumeid() string {
+	if x != nil {
+		return x.Volumeid
+	}
+	return ""
+}
+
+func (x *CreateSnapshotRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
 
-type GetApplicationsCostResponse struct {
+func (x *CreateSnapshotRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+type CreateSnapshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalCost   int64            `protobuf:"varint,1,opt,name=totalCost,proto3" json:"totalCost,omitempty"`
-	GroupedCost map[string]int64 `protobuf:"bytes,2,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Snapshotid string `protobuf:"bytes,1,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
+	// must retain tag for backward compatibilty, left in commet for reference
+	// string error = 2;
+	SnapshotUri string `protobuf:"bytes,3,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
 }
 
-func (x *GetApplicationsCostResponse) Reset() {
-	*x = GetApplicationsCostResponse{}
+func (x *CreateSnapshotResponse) Reset() {
+	*x = CreateSnapshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetApplicationsCostResponse) String() string {
+func (x *CreateSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetApplicationsCostResponse) ProtoMessage() {}
+func (*CreateSnapshotResponse) ProtoMessage() {}
 
-func (x *GetApplicationsCostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
+func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,37 +3583,128 @@ func (x *GetApplicationsCostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetApplicationsCostResponse.ProtoReflect.Descriptor instead.
-func (*GetApplicationsCostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetApplicationsCostResponse) GetTotalCost() int64 {
+func (x *CreateSnapshotResponse) GetSnapshotid() string {
 	if x != nil {
-		return x.TotalCost
+		return x.Snapshotid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetApplicationsCostResponse) GetGroupedCost() map[string]int64 {
+func (x *CreateSnapshotResponse) GetSnapshotUri() string {
 	if x != nil {
-		return x.GroupedCost
+		return x.SnapshotUri
 	}
-	return nil
+	return ""
 }
 
-type AwsCredentials struct {
+type CreateSnapshotAndDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessKeyID     string `protobuf:"bytes,1,opt,name=accessKeyID,proto3" json:"accessKeyID,omitempty"`
-	SecretAccessKey string `protobuf:"bytes,2,opt,name=secretAccessKey,proto3" json:"secretAccessKey,omitempty"`
-	Token           string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	Volumeid    string            `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//resourceGroup Azure resource group of the disk, snapshot is created in the same group
+	ResourceGroup string `protobuf:"bytes,6,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
 }
 
-func (x *AwsCredentials) Reset() {
-	*x = AwsCredentials{}
+func (x *CreateSnapshotAndDeleteRequest) Reset() {
+	*x = CreateSnapshotAndDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSnapshotAndDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotAndDeleteRequest) ProtoMessage() {}
+
+func (x *CreateSnapshotAndDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotAndDeleteRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotAndDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateSnapshotAndDeleteRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CreateSnapshotAndDeleteRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateSnapshotAndDeleteRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateSnapshotAndDeleteRequest) GetVolumeid() string {
+	if x != nil {
+		return x.Volumeid
+	}
+	return ""
+}
+
+func (x *CreateSnapshotAndDeleteRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateSnapshotAndDeleteRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+// NodeReference node of a cluster, resolved to the instance it runs on
+type NodeReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//nodeGroup narrows the lookup to the node group, optional
+	NodeGroup string `protobuf:"bytes,2,opt,name=nodeGroup,proto3" json:"nodeGroup,omitempty"`
+	//nodeName kubernetes node name
+	NodeName string `protobuf:"bytes,3,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+}
+
+func (x *NodeReference) Reset() {
+	*x = NodeReference{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2936,13 +3712,13 @@ func (x *AwsCredentials) Reset() {
 	}
 }
 
-func (x *AwsCredentials) String() string {
+func (x *NodeReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AwsCredentials) ProtoMessage() {}
+func (*NodeReference) ProtoMessage() {}
 
-func (x *AwsCredentials) ProtoReflect() protoreflect.Message {
+func (x *NodeReference) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2954,46 +3730,51 @@ func (x *AwsCredentials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AwsCredentials.ProtoReflect.Descriptor instead.
-func (*AwsCredentials) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeReference.ProtoReflect.Descriptor instead.
+func (*NodeReference) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AwsCredentials) GetAccessKeyID() string {
+func (x *NodeReference) GetClusterName() string {
 	if x != nil {
-		return x.AccessKeyID
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *AwsCredentials) GetSecretAccessKey() string {
+func (x *NodeReference) GetNodeGroup() string {
 	if x != nil {
-		return x.SecretAccessKey
+		return x.NodeGroup
 	}
 	return ""
 }
 
-func (x *AwsCredentials) GetToken() string {
+func (x *NodeReference) GetNodeName() string {
 	if x != nil {
-		return x.Token
+		return x.NodeName
 	}
 	return ""
 }
 
-type AzureCredentials struct {
+type AttachVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriptionID string `protobuf:"bytes,1,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
-	TenantID       string `protobuf:"bytes,2,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
-	ClientID       string `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID,omitempty"`
-	ClientSecret   string `protobuf:"bytes,4,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
-	ResourceGroup  string `protobuf:"bytes,5,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	//volumeid EBS volume id on AWS, disk name on Azure
+	Volumeid string `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	//instanceId EC2 instance id on AWS, scale set instance resource id on Azure. node is used when empty
+	InstanceId string         `protobuf:"bytes,5,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
+	Node       *NodeReference `protobuf:"bytes,6,opt,name=node,proto3" json:"node,omitempty"`
+	//resourceGroup Azure resource group of the disk, account resource group when empty
+	ResourceGroup string `protobuf:"bytes,7,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
 }
 
-func (x *AzureCredentials) Reset() {
-	*x = AzureCredentials{}
+func (x *AttachVolumeRequest) Reset() {
+	*x = AttachVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3001,13 +3782,13 @@ func (x *AzureCredentials) Reset() {
 	}
 }
 
-func (x *AzureCredentials) String() string {
+func (x *AttachVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AzureCredentials) ProtoMessage() {}
+func (*AttachVolumeRequest) ProtoMessage() {}
 
-func (x *AzureCredentials) ProtoReflect() protoreflect.Message {
+func (x *AttachVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3019,56 +3800,74 @@ func (x *AzureCredentials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AzureCredentials.ProtoReflect.Descriptor instead.
-func (*AzureCredentials) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachVolumeRequest.ProtoReflect.Descriptor instead.
+func (*AttachVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AzureCredentials) GetSubscriptionID() string {
+func (x *AttachVolumeRequest) GetProvider() string {
 	if x != nil {
-		return x.SubscriptionID
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *AzureCredentials) GetTenantID() string {
+func (x *AttachVolumeRequest) GetRegion() string {
 	if x != nil {
-		return x.TenantID
+		return x.Region
 	}
 	return ""
 }
 
-func (x *AzureCredentials) GetClientID() string {
+func (x *AttachVolumeRequest) GetAccountName() string {
 	if x != nil {
-		return x.ClientID
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *AzureCredentials) GetClientSecret() string {
+func (x *AttachVolumeRequest) GetVolumeid() string {
 	if x != nil {
-		return x.ClientSecret
+		return x.Volumeid
 	}
 	return ""
 }
 
-func (x *AzureCredentials) GetResourceGroup() string {
+func (x *AttachVolumeRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *AttachVolumeRequest) GetNode() *NodeReference {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *AttachVolumeRequest) GetResourceGroup() string {
 	if x != nil {
 		return x.ResourceGroup
 	}
 	return ""
 }
 
-type GithubPersonalAccessToken struct {
+type AttachVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
+	//device name of the volume on AWS, e.g. /dev/sdf. nitro instances expose it as nvme device
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	//lun of the data disk on Azure
+	Lun int32 `protobuf:"varint,3,opt,name=lun,proto3" json:"lun,omitempty"`
 }
 
-func (x *GithubPersonalAccessToken) Reset() {
-	*x = GithubPersonalAccessToken{}
+func (x *AttachVolumeResponse) Reset() {
+	*x = AttachVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3076,13 +3875,13 @@ func (x *GithubPersonalAccessToken) Reset() {
 	}
 }
 
-func (x *GithubPersonalAccessToken) String() string {
+func (x *AttachVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GithubPersonalAccessToken) ProtoMessage() {}
+func (*AttachVolumeResponse) ProtoMessage() {}
 
-func (x *GithubPersonalAccessToken) ProtoReflect() protoreflect.Message {
+func (x *AttachVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3094,36 +3893,53 @@ func (x *GithubPersonalAccessToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GithubPersonalAccessToken.ProtoReflect.Descriptor instead.
-func (*GithubPersonalAccessToken) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachVolumeResponse.ProtoReflect.Descriptor instead.
+func (*AttachVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GithubPersonalAccessToken) GetToken() string {
+func (x *AttachVolumeResponse) GetInstanceId() string {
 	if x != nil {
-		return x.Token
+		return x.InstanceId
 	}
 	return ""
 }
 
-type WriteCredentialRequest struct {
+func (x *AttachVolumeResponse) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *AttachVolumeResponse) GetLun() int32 {
+	if x != nil {
+		return x.Lun
+	}
+	return 0
+}
+
+type DetachVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// Deprecated: Do not use.
-	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	// Types that are assignable to Cred:
-	//	*WriteCredentialRequest_AwsCred
-	//	*WriteCredentialRequest_AzureCred
-	//	*WriteCredentialRequest_GitPat
-	Cred isWriteCredentialRequest_Cred `protobuf_oneof:"cred"`
-}
-
-func (x *WriteCredentialRequest) Reset() {
-	*x = WriteCredentialRequest{}
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	//volumeid EBS volume id on AWS, disk name on Azure
+	Volumeid string `protobuf:"bytes,4,opt,name=volumeid,proto3" json:"volumeid,omitempty"`
+	//instanceId EC2 instance id on AWS, scale set instance resource id on Azure. node is used when empty
+	InstanceId string         `protobuf:"bytes,5,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
+	Node       *NodeReference `protobuf:"bytes,6,opt,name=node,proto3" json:"node,omitempty"`
+	//resourceGroup Azure resource group of the disk, account resource group when empty
+	ResourceGroup string `protobuf:"bytes,7,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
+	//force detach on AWS when a previous detach did not complete, data not flushed may be lost. Not supported on Azure
+	Force bool `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *DetachVolumeRequest) Reset() {
+	*x = DetachVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3131,13 +3947,13 @@ func (x *WriteCredentialRequest) Reset() {
 	}
 }
 
-func (x *WriteCredentialRequest) String() string {
+func (x *DetachVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteCredentialRequest) ProtoMessage() {}
+func (*DetachVolumeRequest) ProtoMessage() {}
 
-func (x *WriteCredentialRequest) ProtoReflect() protoreflect.Message {
+func (x *DetachVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3149,93 +3965,77 @@ func (x *WriteCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteCredentialRequest.ProtoReflect.Descriptor instead.
-func (*WriteCredentialRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DetachVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DetachVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *WriteCredentialRequest) GetAccount() string {
+func (x *DetachVolumeRequest) GetProvider() string {
 	if x != nil {
-		return x.Account
+		return x.Provider
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (x *WriteCredentialRequest) GetProvider() string {
+func (x *DetachVolumeRequest) GetRegion() string {
 	if x != nil {
-		return x.Provider
+		return x.Region
 	}
 	return ""
 }
 
-func (x *WriteCredentialRequest) GetType() string {
+func (x *DetachVolumeRequest) GetAccountName() string {
 	if x != nil {
-		return x.Type
+		return x.AccountName
 	}
 	return ""
 }
 
-func (m *WriteCredentialRequest) GetCred() isWriteCredentialRequest_Cred {
-	if m != nil {
-		return m.Cred
+func (x *DetachVolumeRequest) GetVolumeid() string {
+	if x != nil {
+		return x.Volumeid
 	}
-	return nil
+	return ""
 }
 
-func (x *WriteCredentialRequest) GetAwsCred() *AwsCredentials {
-	if x, ok := x.GetCred().(*WriteCredentialRequest_AwsCred); ok {
-		return x.AwsCred
+func (x *DetachVolumeRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *WriteCredentialRequest) GetAzureCred() *AzureCredentials {
-	if x, ok := x.GetCred().(*WriteCredentialRequest_AzureCred); ok {
-		return x.AzureCred
+func (x *DetachVolumeRequest) GetNode() *NodeReference {
+	if x != nil {
+		return x.Node
 	}
 	return nil
 }
 
-func (x *WriteCredentialRequest) GetGitPat() *GithubPersonalAccessToken {
-	if x, ok := x.GetCred().(*WriteCredentialRequest_GitPat); ok {
-		return x.GitPat
+func (x *DetachVolumeRequest) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
 	}
-	return nil
-}
-
-type isWriteCredentialRequest_Cred interface {
-	isWriteCredentialRequest_Cred()
-}
-
-type WriteCredentialRequest_AwsCred struct {
-	AwsCred *AwsCredentials `protobuf:"bytes,3,opt,name=awsCred,proto3,oneof"`
-}
-
-type WriteCredentialRequest_AzureCred struct {
-	AzureCred *AzureCredentials `protobuf:"bytes,4,opt,name=azureCred,proto3,oneof"`
+	return ""
 }
 
-type WriteCredentialRequest_GitPat struct {
-	GitPat *GithubPersonalAccessToken `protobuf:"bytes,6,opt,name=gitPat,proto3,oneof"`
+func (x *DetachVolumeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
 }
 
-func (*WriteCredentialRequest_AwsCred) isWriteCredentialRequest_Cred() {}
-
-func (*WriteCredentialRequest_AzureCred) isWriteCredentialRequest_Cred() {}
-
-func (*WriteCredentialRequest_GitPat) isWriteCredentialRequest_Cred() {}
-
-type WriteCredentialResponse struct {
+type DetachVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instanceId,proto3" json:"instanceId,omitempty"`
 }
 
-func (x *WriteCredentialResponse) Reset() {
-	*x = WriteCredentialResponse{}
+func (x *DetachVolumeResponse) Reset() {
+	*x = DetachVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3243,13 +4043,13 @@ func (x *WriteCredentialResponse) Reset() {
 	}
 }
 
-func (x *WriteCredentialResponse) String() string {
+func (x *DetachVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteCredentialResponse) ProtoMessage() {}
+func (*DetachVolumeResponse) ProtoMessage() {}
 
-func (x *WriteCredentialResponse) ProtoReflect() protoreflect.Message {
+func (x *DetachVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3261,31 +4061,32 @@ func (x *WriteCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteCredentialResponse.ProtoReflect.Descriptor instead.
-func (*WriteCredentialResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DetachVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DetachVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *WriteCredentialResponse) GetError() string {
+func (x *DetachVolumeResponse) GetInstanceId() string {
 	if x != nil {
-		return x.Error
+		return x.InstanceId
 	}
 	return ""
 }
 
-type ReadCredentialRequest struct {
+type CreateSnapshotAndDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// Deprecated: Do not use.
-	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Snapshotid string `protobuf:"bytes,1,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
+	Deleted    bool   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// must retain tag for backward compatibilty, left in commet for reference
+	// string error = 3;
+	SnapshotUri string `protobuf:"bytes,4,opt,name=snapshotUri,proto3" json:"snapshotUri,omitempty"`
 }
 
-func (x *ReadCredentialRequest) Reset() {
-	*x = ReadCredentialRequest{}
+func (x *CreateSnapshotAndDeleteResponse) Reset() {
+	*x = CreateSnapshotAndDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3293,13 +4094,13 @@ func (x *ReadCredentialRequest) Reset() {
 	}
 }
 
-func (x *ReadCredentialRequest) String() string {
+func (x *CreateSnapshotAndDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadCredentialRequest) ProtoMessage() {}
+func (*CreateSnapshotAndDeleteResponse) ProtoMessage() {}
 
-func (x *ReadCredentialRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSnapshotAndDeleteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3311,51 +4112,42 @@ func (x *ReadCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadCredentialRequest.ProtoReflect.Descriptor instead.
-func (*ReadCredentialRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSnapshotAndDeleteResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotAndDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ReadCredentialRequest) GetAccount() string {
+func (x *CreateSnapshotAndDeleteResponse) GetSnapshotid() string {
 	if x != nil {
-		return x.Account
+		return x.Snapshotid
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (x *ReadCredentialRequest) GetProvider() string {
+func (x *CreateSnapshotAndDeleteResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Provider
+		return x.Deleted
 	}
-	return ""
+	return false
 }
 
-func (x *ReadCredentialRequest) GetType() string {
+func (x *CreateSnapshotAndDeleteResponse) GetSnapshotUri() string {
 	if x != nil {
-		return x.Type
+		return x.SnapshotUri
 	}
 	return ""
 }
 
-type ReadCredentialResponse struct {
+type RancherRegistrationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// Deprecated: Do not use.
-	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	// Types that are assignable to Cred:
-	//	*ReadCredentialResponse_AwsCred
-	//	*ReadCredentialResponse_AzureCred
-	//	*ReadCredentialResponse_GitPat
-	Cred isReadCredentialResponse_Cred `protobuf_oneof:"cred"`
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *ReadCredentialResponse) Reset() {
-	*x = ReadCredentialResponse{}
+func (x *RancherRegistrationRequest) Reset() {
+	*x = RancherRegistrationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3363,13 +4155,13 @@ func (x *ReadCredentialResponse) Reset() {
 	}
 }
 
-func (x *ReadCredentialResponse) String() string {
+func (x *RancherRegistrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadCredentialResponse) ProtoMessage() {}
+func (*RancherRegistrationRequest) ProtoMessage() {}
 
-func (x *ReadCredentialResponse) ProtoReflect() protoreflect.Message {
+func (x *RancherRegistrationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3381,96 +4173,32 @@ func (x *ReadCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadCredentialResponse.ProtoReflect.Descriptor instead.
-func (*ReadCredentialResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RancherRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*RancherRegistrationRequest) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ReadCredentialResponse) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-// Deprecated: Do not use.
-func (x *ReadCredentialResponse) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *ReadCredentialResponse) GetType() string {
+func (x *RancherRegistrationRequest) GetClusterName() string {
 	if x != nil {
-		return x.Type
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (m *ReadCredentialResponse) GetCred() isReadCredentialResponse_Cred {
-	if m != nil {
-		return m.Cred
-	}
-	return nil
-}
-
-func (x *ReadCredentialResponse) GetAwsCred() *AwsCredentials {
-	if x, ok := x.GetCred().(*ReadCredentialResponse_AwsCred); ok {
-		return x.AwsCred
-	}
-	return nil
-}
-
-func (x *ReadCredentialResponse) GetAzureCred() *AzureCredentials {
-	if x, ok := x.GetCred().(*ReadCredentialResponse_AzureCred); ok {
-		return x.AzureCred
-	}
-	return nil
-}
-
-func (x *ReadCredentialResponse) GetGitPat() *GithubPersonalAccessToken {
-	if x, ok := x.GetCred().(*ReadCredentialResponse_GitPat); ok {
-		return x.GitPat
-	}
-	return nil
-}
-
-type isReadCredentialResponse_Cred interface {
-	isReadCredentialResponse_Cred()
-}
-
-type ReadCredentialResponse_AwsCred struct {
-	AwsCred *AwsCredentials `protobuf:"bytes,3,opt,name=awsCred,proto3,oneof"`
-}
-
-type ReadCredentialResponse_AzureCred struct {
-	AzureCred *AzureCredentials `protobuf:"bytes,4,opt,name=azureCred,proto3,oneof"`
-}
-
-type ReadCredentialResponse_GitPat struct {
-	GitPat *GithubPersonalAccessToken `protobuf:"bytes,6,opt,name=gitPat,proto3,oneof"`
-}
-
-func (*ReadCredentialResponse_AwsCred) isReadCredentialResponse_Cred() {}
-
-func (*ReadCredentialResponse_AzureCred) isReadCredentialResponse_Cred() {}
-
-func (*ReadCredentialResponse_GitPat) isReadCredentialResponse_Cred() {}
-
-type GetKubeConfigRequest struct {
+type RancherRegistrationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	// must retain tag for backward compatibilty, left in commet for reference
+	// string error = 1;
+	ClusterName string `protobuf:"bytes,2,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	ClusterID   string `protobuf:"bytes,3,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	ManifestURL string `protobuf:"bytes,4,opt,name=manifestURL,proto3" json:"manifestURL,omitempty"`
 }
 
-func (x *GetKubeConfigRequest) Reset() {
-	*x = GetKubeConfigRequest{}
+func (x *RancherRegistrationResponse) Reset() {
+	*x = RancherRegistrationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3478,13 +4206,13 @@ func (x *GetKubeConfigRequest) Reset() {
 	}
 }
 
-func (x *GetKubeConfigRequest) String() string {
+func (x *RancherRegistrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKubeConfigRequest) ProtoMessage() {}
+func (*RancherRegistrationResponse) ProtoMessage() {}
 
-func (x *GetKubeConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *RancherRegistrationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3496,50 +4224,50 @@ func (x *GetKubeConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKubeConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetKubeConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RancherRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*RancherRegistrationResponse) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetKubeConfigRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *GetKubeConfigRequest) GetRegion() string {
+func (x *RancherRegistrationResponse) GetClusterName() string {
 	if x != nil {
-		return x.Region
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *GetKubeConfigRequest) GetAccountName() string {
+func (x *RancherRegistrationResponse) GetClusterID() string {
 	if x != nil {
-		return x.AccountName
+		return x.ClusterID
 	}
 	return ""
 }
 
-func (x *GetKubeConfigRequest) GetClusterName() string {
+func (x *RancherRegistrationResponse) GetManifestURL() string {
 	if x != nil {
-		return x.ClusterName
+		return x.ManifestURL
 	}
 	return ""
 }
 
-type GetKubeConfigResponse struct {
+// Deprecated: Do not use.
+type GetWorkspacesCostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	Config      []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Provider     string   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	WorkspaceIds []string `protobuf:"bytes,1,rep,name=workspaceIds,proto3" json:"workspaceIds,omitempty"`
+	AccountName  string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	StartDate    string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate      string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Granularity  string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	CostType     string   `protobuf:"bytes,7,opt,name=costType,proto3" json:"costType,omitempty"`
+	GroupBy      *GroupBy `protobuf:"bytes,8,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
 }
 
-func (x *GetKubeConfigResponse) Reset() {
-	*x = GetKubeConfigResponse{}
+func (x *GetWorkspacesCostRequest) Reset() {
+	*x = GetWorkspacesCostRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3547,13 +4275,13 @@ func (x *GetKubeConfigResponse) Reset() {
 	}
 }
 
-func (x *GetKubeConfigResponse) String() string {
+func (x *GetWorkspacesCostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKubeConfigResponse) ProtoMessage() {}
+func (*GetWorkspacesCostRequest) ProtoMessage() {}
 
-func (x *GetKubeConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *GetWorkspacesCostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3565,93 +4293,99 @@ func (x *GetKubeConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKubeConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetKubeConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWorkspacesCostRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspacesCostRequest) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetKubeConfigResponse) GetClusterName() string {
+func (x *GetWorkspacesCostRequest) GetProvider() string {
 	if x != nil {
-		return x.ClusterName
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *GetKubeConfigResponse) GetConfig() []byte {
+func (x *GetWorkspacesCostRequest) GetWorkspaceIds() []string {
 	if x != nil {
-		return x.Config
+		return x.WorkspaceIds
 	}
 	return nil
 }
 
-type TagNodeInstanceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetWorkspacesCostRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
 }
 
-func (x *TagNodeInstanceResponse) Reset() {
-	*x = TagNodeInstanceResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetWorkspacesCostRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
 	}
+	return ""
 }
 
-func (x *TagNodeInstanceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetWorkspacesCostRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (*TagNodeInstanceResponse) ProtoMessage() {}
+func (x *GetWorkspacesCostRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
 
-func (x *TagNodeInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetWorkspacesCostRequest) GetCostType() string {
+	if x != nil {
+		return x.CostType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TagNodeInstanceResponse.ProtoReflect.Descriptor instead.
-func (*TagNodeInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{50}
+func (x *GetWorkspacesCostRequest) GetGroupBy() *GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
 }
 
-type TagNodeInstanceRequest struct {
+type GetApplicationsCostRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	ClusterName string            `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
-	NodeGroup   string            `protobuf:"bytes,5,opt,name=nodeGroup,proto3" json:"nodeGroup,omitempty"`
-	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ApplicationIds []string `protobuf:"bytes,1,rep,name=applicationIds,proto3" json:"applicationIds,omitempty"`
+	Provider       string   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	AccountName    string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	StartDate      string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate        string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Granularity    string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	CostType       string   `protobuf:"bytes,7,opt,name=costType,proto3" json:"costType,omitempty"`
+	GroupBy        *GroupBy `protobuf:"bytes,8,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
 }
 
-func (x *TagNodeInstanceRequest) Reset() {
-	*x = TagNodeInstanceRequest{}
+func (x *GetApplicationsCostRequest) Reset() {
+	*x = GetApplicationsCostRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TagNodeInstanceRequest) String() string {
+func (x *GetApplicationsCostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagNodeInstanceRequest) ProtoMessage() {}
+func (*GetApplicationsCostRequest) ProtoMessage() {}
 
-func (x *TagNodeInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
+func (x *GetApplicationsCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3662,84 +4396,93 @@ func (x *TagNodeInstanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagNodeInstanceRequest.ProtoReflect.Descriptor instead.
-func (*TagNodeInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetApplicationsCostRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationsCostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *TagNodeInstanceRequest) GetProvider() string {
+func (x *GetApplicationsCostRequest) GetApplicationIds() []string {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *GetApplicationsCostRequest) GetProvider() string {
 	if x != nil {
 		return x.Provider
 	}
 	return ""
 }
 
-func (x *TagNodeInstanceRequest) GetRegion() string {
+func (x *GetApplicationsCostRequest) GetAccountName() string {
 	if x != nil {
-		return x.Region
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *TagNodeInstanceRequest) GetAccountName() string {
+func (x *GetApplicationsCostRequest) GetStartDate() string {
 	if x != nil {
-		return x.AccountName
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *TagNodeInstanceRequest) GetClusterName() string {
+func (x *GetApplicationsCostRequest) GetEndDate() string {
 	if x != nil {
-		return x.ClusterName
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *TagNodeInstanceRequest) GetNodeGroup() string {
+func (x *GetApplicationsCostRequest) GetGranularity() string {
 	if x != nil {
-		return x.NodeGroup
+		return x.Granularity
 	}
 	return ""
 }
 
-func (x *TagNodeInstanceRequest) GetLabels() map[string]string {
+func (x *GetApplicationsCostRequest) GetCostType() string {
 	if x != nil {
-		return x.Labels
+		return x.CostType
+	}
+	return ""
+}
+
+func (x *GetApplicationsCostRequest) GetGroupBy() *GroupBy {
+	if x != nil {
+		return x.GroupBy
 	}
 	return nil
 }
 
-type GetCostByTimeRequest struct {
+type GroupBy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Provider    string   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Ids         []string `protobuf:"bytes,1,rep,name=Ids,proto3" json:"Ids,omitempty"`
-	AccountName string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
-	StartDate   string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate     string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Granularity string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
-	GroupBy     *GroupBy `protobuf:"bytes,8,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *GetCostByTimeRequest) Reset() {
-	*x = GetCostByTimeRequest{}
+func (x *GroupBy) Reset() {
+	*x = GroupBy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCostByTimeRequest) String() string {
+func (x *GroupBy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCostByTimeRequest) ProtoMessage() {}
+func (*GroupBy) ProtoMessage() {}
 
-func (x *GetCostByTimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+func (x *GroupBy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3750,70 +4493,91 @@ func (x *GetCostByTimeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCostByTimeRequest.ProtoReflect.Descriptor instead.
-func (*GetCostByTimeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GroupBy.ProtoReflect.Descriptor instead.
+func (*GroupBy) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetCostByTimeRequest) GetProvider() string {
+func (x *GroupBy) GetType() string {
 	if x != nil {
-		return x.Provider
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetCostByTimeRequest) GetIds() []string {
+func (x *GroupBy) GetKey() string {
 	if x != nil {
-		return x.Ids
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *GetCostByTimeRequest) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
+type GetWorkspacesCostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCost   int64            `protobuf:"varint,1,opt,name=totalCost,proto3" json:"totalCost,omitempty"`
+	GroupedCost map[string]int64 `protobuf:"bytes,2,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetCostByTimeRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
+func (x *GetWorkspacesCostResponse) Reset() {
+	*x = GetWorkspacesCostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetCostByTimeRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
+func (x *GetWorkspacesCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspacesCostResponse) ProtoMessage() {}
+
+func (x *GetWorkspacesCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCostByTimeRequest) GetGranularity() string {
+// Deprecated: Use GetWorkspacesCostResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspacesCostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetWorkspacesCostResponse) GetTotalCost() int64 {
 	if x != nil {
-		return x.Granularity
+		return x.TotalCost
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCostByTimeRequest) GetGroupBy() *GroupBy {
+func (x *GetWorkspacesCostResponse) GetGroupedCost() map[string]int64 {
 	if x != nil {
-		return x.GroupBy
+		return x.GroupedCost
 	}
 	return nil
 }
 
-type GetCostByTimeResponse struct {
+type GetApplicationsCostResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupedCost map[string]*CostMap `protobuf:"bytes,1,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TotalCost   int64            `protobuf:"varint,1,opt,name=totalCost,proto3" json:"totalCost,omitempty"`
+	GroupedCost map[string]int64 `protobuf:"bytes,2,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetCostByTimeResponse) Reset() {
-	*x = GetCostByTimeResponse{}
+func (x *GetApplicationsCostResponse) Reset() {
+	*x = GetApplicationsCostResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3821,13 +4585,13 @@ func (x *GetCostByTimeResponse) Reset() {
 	}
 }
 
-func (x *GetCostByTimeResponse) String() string {
+func (x *GetApplicationsCostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCostByTimeResponse) ProtoMessage() {}
+func (*GetApplicationsCostResponse) ProtoMessage() {}
 
-func (x *GetCostByTimeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetApplicationsCostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3839,28 +4603,37 @@ func (x *GetCostByTimeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCostByTimeResponse.ProtoReflect.Descriptor instead.
-func (*GetCostByTimeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetApplicationsCostResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationsCostResponse) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetCostByTimeResponse) GetGroupedCost() map[string]*CostMap {
+func (x *GetApplicationsCostResponse) GetTotalCost() int64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *GetApplicationsCostResponse) GetGroupedCost() map[string]int64 {
 	if x != nil {
 		return x.GroupedCost
 	}
 	return nil
 }
 
-type CostMap struct {
+type AwsCredentials struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cost map[string]int64 `protobuf:"bytes,1,rep,name=cost,proto3" json:"cost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AccessKeyID     string `protobuf:"bytes,1,opt,name=accessKeyID,proto3" json:"accessKeyID,omitempty"`
+	SecretAccessKey string `protobuf:"bytes,2,opt,name=secretAccessKey,proto3" json:"secretAccessKey,omitempty"`
+	Token           string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *CostMap) Reset() {
-	*x = CostMap{}
+func (x *AwsCredentials) Reset() {
+	*x = AwsCredentials{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3868,13 +4641,13 @@ func (x *CostMap) Reset() {
 	}
 }
 
-func (x *CostMap) String() string {
+func (x *AwsCredentials) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CostMap) ProtoMessage() {}
+func (*AwsCredentials) ProtoMessage() {}
 
-func (x *CostMap) ProtoReflect() protoreflect.Message {
+func (x *AwsCredentials) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3886,851 +4659,7179 @@ func (x *CostMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CostMap.ProtoReflect.Descriptor instead.
-func (*CostMap) Descriptor() ([]byte, []int) {
+// Deprecated: Use AwsCredentials.ProtoReflect.Descriptor instead.
+func (*AwsCredentials) Descriptor() ([]byte, []int) {
 	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CostMap) GetCost() map[string]int64 {
+func (x *AwsCredentials) GetAccessKeyID() string {
 	if x != nil {
-		return x.Cost
+		return x.AccessKeyID
 	}
-	return nil
+	return ""
 }
 
-var File_proto_netbookai_spawner_spawner_proto protoreflect.FileDescriptor
+func (x *AwsCredentials) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
 
-var file_proto_netbookai_spawner_spawner_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x74, 0x62, 0x6f, 0x6f, 0x6b, 0x61,
-	0x69, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x0a, 0x0b, 0x45, 0x63, 0x68,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x20, 0x0a, 0x0c, 0x45, 0x63,
-	0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x88, 0x05, 0x0a,
-	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
-	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73,
-	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x75, 0x5f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x70, 0x75, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x33,
-	0x0a, 0x0a, 0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x49, 0x47,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x61, 0x70,
-	0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
-	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x6f,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x39, 0x0a, 0x0b,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f, 0x0a, 0x05, 0x49, 0x73, 0x73, 0x75, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x2e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x0e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
-	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x6a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x0b,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a,
-	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70,
-	0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x47, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x6f, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f,
-	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x67,
-	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x48, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x4e, 0x6f,
-	0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70,
-	0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64,
-	0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x29, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61,
-	0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0xb0, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x22, 0x2d, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0xb2, 0x03, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64,
-	0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72,
-	0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x55, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0x39, 0x0a, 0x0b,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x72,
-	0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x55, 0x72, 0x69, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x22, 0x46,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x5a, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x22, 0x9a, 0x02,
-	0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x69, 0x64, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a,
-	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7d, 0x0a, 0x1f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x22, 0x3e, 0x0a, 0x1a, 0x52, 0x61, 0x6e,
-	0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x1b, 0x52, 0x61, 0x6e,
-	0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d,
-	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x22, 0xa2, 0x02, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
-	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x3a, 0x02, 0x18, 0x01, 0x22,
-	0xa4, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0x2f, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xd0, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73,
-	0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x72, 0x0a, 0x0e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24,
-	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x22, 0x31, 0x0a, 0x19, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65,
-	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9c, 0x02, 0x0a, 0x16, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
-	0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x33, 0x0a, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77,
-	0x73, 0x43, 0x72, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64,
-	0x12, 0x3c, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x42, 0x06,
-	0x0a, 0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x17, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x9c,
-	0x02, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x09,
-	0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61, 0x7a,
-	0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x67,
-	0x69, 0x74, 0x50, 0x61, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0x8e, 0x01,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x0a,
-	0x16, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xec, 0x01,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x03, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0xbc, 0x01, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65,
-	0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x1a, 0x50, 0x0a, 0x10, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x07, 0x63,
-	0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x63,
-	0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a,
-	0x50, 0x0a, 0x0a, 0x4d, 0x49, 0x47, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0b, 0x0a,
-	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49,
-	0x47, 0x31, 0x67, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x32, 0x67, 0x10, 0x02,
-	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x33, 0x67, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d,
-	0x49, 0x47, 0x34, 0x67, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x37, 0x67, 0x10,
-	0x05, 0x2a, 0x36, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x55, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x44, 0x45, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x53, 0x50, 0x4f, 0x54, 0x10, 0x02, 0x32, 0x93, 0x0f, 0x0a, 0x0e, 0x53, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x0b,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a,
-	0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x41, 0x64,
-	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a,
-	0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x59, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41,
-	0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x22, 0x00, 0x12, 0x4a, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73,
-	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x07, 0x41, 0x64, 0x64,
-	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70,
-	0x61, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
-	0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70,
-	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
-	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a,
-	0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41,
-	0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a,
-	0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x6e,
-	0x63, 0x68, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52,
-	0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88,
-	0x02, 0x01, 0x12, 0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61,
-	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
-	0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
-	0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77,
-	0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d,
-	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74,
-	0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42,
-	0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x0a, 0x5a, 0x08, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *AwsCredentials) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-var (
-	file_proto_netbookai_spawner_spawner_proto_rawDescOnce sync.Once
-	file_proto_netbookai_spawner_spawner_proto_rawDescData = file_proto_netbookai_spawner_spawner_proto_rawDesc
-)
+type AzureCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_proto_netbookai_spawner_spawner_proto_rawDescGZIP() []byte {
-	file_proto_netbookai_spawner_spawner_proto_rawDescOnce.Do(func() {
-		file_proto_netbookai_spawner_spawner_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_netbookai_spawner_spawner_proto_rawDescData)
-	})
-	return file_proto_netbookai_spawner_spawner_proto_rawDescData
+	SubscriptionID string `protobuf:"bytes,1,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
+	TenantID       string `protobuf:"bytes,2,opt,name=tenantID,proto3" json:"tenantID,omitempty"`
+	ClientID       string `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	ClientSecret   string `protobuf:"bytes,4,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
+	ResourceGroup  string `protobuf:"bytes,5,opt,name=resourceGroup,proto3" json:"resourceGroup,omitempty"`
 }
 
-var file_proto_netbookai_spawner_spawner_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_netbookai_spawner_spawner_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
-var file_proto_netbookai_spawner_spawner_proto_goTypes = []interface{}{
-	(MIGProfile)(0),                         // 0: spawner.MIGProfile
-	(CapacityType)(0),                       // 1: spawner.CapacityType
-	(*Empty)(nil),                           // 2: spawner.Empty
-	(*EchoRequest)(nil),                     // 3: spawner.EchoRequest
-	(*EchoResponse)(nil),                    // 4: spawner.EchoResponse
-	(*NodeSpec)(nil),                        // 5: spawner.NodeSpec
-	(*Issue)(nil),                           // 6: spawner.Issue
-	(*Health)(nil),                          // 7: spawner.Health
-	(*ClusterRequest)(nil),                  // 8: spawner.ClusterRequest
-	(*GetClusterRequest)(nil),               // 9: spawner.GetClusterRequest
-	(*GetClustersRequest)(nil),              // 10: spawner.GetClustersRequest
-	(*ClusterSpec)(nil),                     // 11: spawner.ClusterSpec
-	(*GetClustersResponse)(nil),             // 12: spawner.GetClustersResponse
-	(*ClusterResponse)(nil),                 // 13: spawner.ClusterResponse
-	(*ClusterStatusRequest)(nil),            // 14: spawner.ClusterStatusRequest
-	(*ClusterStatusResponse)(nil),           // 15: spawner.ClusterStatusResponse
-	(*AddTokenRequest)(nil),                 // 16: spawner.AddTokenRequest
-	(*AddTokenResponse)(nil),                // 17: spawner.AddTokenResponse
-	(*GetTokenRequest)(nil),                 // 18: spawner.GetTokenRequest
-	(*GetTokenResponse)(nil),                // 19: spawner.GetTokenResponse
-	(*AddRoute53RecordRequest)(nil),         // 20: spawner.AddRoute53RecordRequest
-	(*AddRoute53RecordResponse)(nil),        // 21: spawner.AddRoute53RecordResponse
-	(*NodeSpawnRequest)(nil),                // 22: spawner.NodeSpawnRequest
-	(*NodeSpawnResponse)(nil),               // 23: spawner.NodeSpawnResponse
-	(*ClusterDeleteRequest)(nil),            // 24: spawner.ClusterDeleteRequest
-	(*ClusterDeleteResponse)(nil),           // 25: spawner.ClusterDeleteResponse
-	(*NodeDeleteRequest)(nil),               // 26: spawner.NodeDeleteRequest
-	(*NodeDeleteResponse)(nil),              // 27: spawner.NodeDeleteResponse
-	(*CreateVolumeRequest)(nil),             // 28: spawner.CreateVolumeRequest
-	(*CreateVolumeResponse)(nil),            // 29: spawner.CreateVolumeResponse
-	(*DeleteVolumeRequest)(nil),             // 30: spawner.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),            // 31: spawner.DeleteVolumeResponse
-	(*CreateSnapshotRequest)(nil),           // 32: spawner.CreateSnapshotRequest
-	(*CreateSnapshotResponse)(nil),          // 33: spawner.CreateSnapshotResponse
-	(*CreateSnapshotAndDeleteRequest)(nil),  // 34: spawner.CreateSnapshotAndDeleteRequest
-	(*CreateSnapshotAndDeleteResponse)(nil), // 35: spawner.CreateSnapshotAndDeleteResponse
-	(*RancherRegistrationRequest)(nil),      // 36: spawner.RancherRegistrationRequest
-	(*RancherRegistrationResponse)(nil),     // 37: spawner.RancherRegistrationResponse
-	(*GetWorkspacesCostRequest)(nil),        // 38: spawner.GetWorkspacesCostRequest
-	(*GetApplicationsCostRequest)(nil),      // 39: spawner.GetApplicationsCostRequest
-	(*GroupBy)(nil),                         // 40: spawner.GroupBy
-	(*GetWorkspacesCostResponse)(nil),       // 41: spawner.GetWorkspacesCostResponse
-	(*GetApplicationsCostResponse)(nil),     // 42: spawner.GetApplicationsCostResponse
-	(*AwsCredentials)(nil),                  // 43: spawner.AwsCredentials
-	(*AzureCredentials)(nil),                // 44: spawner.AzureCredentials
-	(*GithubPersonalAccessToken)(nil),       // 45: spawner.GithubPersonalAccessToken
-	(*WriteCredentialRequest)(nil),          // 46: spawner.WriteCredentialRequest
-	(*WriteCredentialResponse)(nil),         // 47: spawner.WriteCredentialResponse
-	(*ReadCredentialRequest)(nil),           // 48: spawner.ReadCredentialRequest
-	(*ReadCredentialResponse)(nil),          // 49: spawner.ReadCredentialResponse
-	(*GetKubeConfigRequest)(nil),            // 50: spawner.GetKubeConfigRequest
-	(*GetKubeConfigResponse)(nil),           // 51: spawner.GetKubeConfigResponse
-	(*TagNodeInstanceResponse)(nil),         // 52: spawner.TagNodeInstanceResponse
-	(*TagNodeInstanceRequest)(nil),          // 53: spawner.TagNodeInstanceRequest
-	(*GetCostByTimeRequest)(nil),            // 54: spawner.GetCostByTimeRequest
-	(*GetCostByTimeResponse)(nil),           // 55: spawner.GetCostByTimeResponse
-	(*CostMap)(nil),                         // 56: spawner.costMap
-	nil,                                     // 57: spawner.NodeSpec.LabelsEntry
-	nil,                                     // 58: spawner.ClusterRequest.LabelsEntry
-	nil,                                     // 59: spawner.CreateVolumeRequest.LabelsEntry
-	nil,                                     // 60: spawner.CreateSnapshotRequest.LabelsEntry
-	nil,                                     // 61: spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
-	nil,                                     // 62: spawner.GetWorkspacesCostResponse.GroupedCostEntry
-	nil,                                     // 63: spawner.GetApplicationsCostResponse.GroupedCostEntry
-	nil,                                     // 64: spawner.TagNodeInstanceRequest.LabelsEntry
-	nil,                                     // 65: spawner.GetCostByTimeResponse.GroupedCostEntry
-	nil,                                     // 66: spawner.costMap.CostEntry
+func (x *AzureCredentials) Reset() {
+	*x = AzureCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_proto_netbookai_spawner_spawner_proto_depIdxs = []int32{
-	57, // 0: spawner.NodeSpec.labels:type_name -> spawner.NodeSpec.LabelsEntry
-	7,  // 1: spawner.NodeSpec.health:type_name -> spawner.Health
-	0,  // 2: spawner.NodeSpec.migProfile:type_name -> spawner.MIGProfile
-	1,  // 3: spawner.NodeSpec.capacityType:type_name -> spawner.CapacityType
-	6,  // 4: spawner.Health.issue:type_name -> spawner.Issue
-	5,  // 5: spawner.ClusterRequest.node:type_name -> spawner.NodeSpec
-	58, // 6: spawner.ClusterRequest.labels:type_name -> spawner.ClusterRequest.LabelsEntry
-	5,  // 7: spawner.ClusterSpec.nodeSpec:type_name -> spawner.NodeSpec
-	11, // 8: spawner.GetClustersResponse.clusters:type_name -> spawner.ClusterSpec
-	5,  // 9: spawner.NodeSpawnRequest.nodeSpec:type_name -> spawner.NodeSpec
-	59, // 10: spawner.CreateVolumeRequest.labels:type_name -> spawner.CreateVolumeRequest.LabelsEntry
-	60, // 11: spawner.CreateSnapshotRequest.labels:type_name -> spawner.CreateSnapshotRequest.LabelsEntry
-	61, // 12: spawner.CreateSnapshotAndDeleteRequest.labels:type_name -> spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
-	40, // 13: spawner.GetWorkspacesCostRequest.groupBy:type_name -> spawner.GroupBy
-	40, // 14: spawner.GetApplicationsCostRequest.groupBy:type_name -> spawner.GroupBy
-	62, // 15: spawner.GetWorkspacesCostResponse.groupedCost:type_name -> spawner.GetWorkspacesCostResponse.GroupedCostEntry
-	63, // 16: spawner.GetApplicationsCostResponse.groupedCost:type_name -> spawner.GetApplicationsCostResponse.GroupedCostEntry
-	43, // 17: spawner.WriteCredentialRequest.awsCred:type_name -> spawner.AwsCredentials
-	44, // 18: spawner.WriteCredentialRequest.azureCred:type_name -> spawner.AzureCredentials
-	45, // 19: spawner.WriteCredentialRequest.gitPat:type_name -> spawner.GithubPersonalAccessToken
-	43, // 20: spawner.ReadCredentialResponse.awsCred:type_name -> spawner.AwsCredentials
-	44, // 21: spawner.ReadCredentialResponse.azureCred:type_name -> spawner.AzureCredentials
-	45, // 22: spawner.ReadCredentialResponse.gitPat:type_name -> spawner.GithubPersonalAccessToken
-	64, // 23: spawner.TagNodeInstanceRequest.labels:type_name -> spawner.TagNodeInstanceRequest.LabelsEntry
-	40, // 24: spawner.GetCostByTimeRequest.groupBy:type_name -> spawner.GroupBy
-	65, // 25: spawner.GetCostByTimeResponse.groupedCost:type_name -> spawner.GetCostByTimeResponse.GroupedCostEntry
-	66, // 26: spawner.costMap.cost:type_name -> spawner.costMap.CostEntry
-	56, // 27: spawner.GetCostByTimeResponse.GroupedCostEntry.value:type_name -> spawner.costMap
-	2,  // 28: spawner.SpawnerService.HealthCheck:input_type -> spawner.Empty
-	3,  // 29: spawner.SpawnerService.Echo:input_type -> spawner.EchoRequest
-	8,  // 30: spawner.SpawnerService.CreateCluster:input_type -> spawner.ClusterRequest
-	16, // 31: spawner.SpawnerService.AddToken:input_type -> spawner.AddTokenRequest
-	18, // 32: spawner.SpawnerService.GetToken:input_type -> spawner.GetTokenRequest
-	20, // 33: spawner.SpawnerService.AddRoute53Record:input_type -> spawner.AddRoute53RecordRequest
-	9,  // 34: spawner.SpawnerService.GetCluster:input_type -> spawner.GetClusterRequest
-	10, // 35: spawner.SpawnerService.GetClusters:input_type -> spawner.GetClustersRequest
-	22, // 36: spawner.SpawnerService.AddNode:input_type -> spawner.NodeSpawnRequest
-	14, // 37: spawner.SpawnerService.ClusterStatus:input_type -> spawner.ClusterStatusRequest
-	24, // 38: spawner.SpawnerService.DeleteCluster:input_type -> spawner.ClusterDeleteRequest
-	26, // 39: spawner.SpawnerService.DeleteNode:input_type -> spawner.NodeDeleteRequest
-	28, // 40: spawner.SpawnerService.CreateVolume:input_type -> spawner.CreateVolumeRequest
-	30, // 41: spawner.SpawnerService.DeleteVolume:input_type -> spawner.DeleteVolumeRequest
-	32, // 42: spawner.SpawnerService.CreateSnapshot:input_type -> spawner.CreateSnapshotRequest
-	34, // 43: spawner.SpawnerService.CreateSnapshotAndDelete:input_type -> spawner.CreateSnapshotAndDeleteRequest
-	36, // 44: spawner.SpawnerService.RegisterWithRancher:input_type -> spawner.RancherRegistrationRequest
-	38, // 45: spawner.SpawnerService.GetWorkspacesCost:input_type -> spawner.GetWorkspacesCostRequest
-	39, // 46: spawner.SpawnerService.GetApplicationsCost:input_type -> spawner.GetApplicationsCostRequest
-	46, // 47: spawner.SpawnerService.WriteCredential:input_type -> spawner.WriteCredentialRequest
-	48, // 48: spawner.SpawnerService.ReadCredential:input_type -> spawner.ReadCredentialRequest
-	50, // 49: spawner.SpawnerService.GetKubeConfig:input_type -> spawner.GetKubeConfigRequest
-	53, // 50: spawner.SpawnerService.TagNodeInstance:input_type -> spawner.TagNodeInstanceRequest
-	54, // 51: spawner.SpawnerService.GetCostByTime:input_type -> spawner.GetCostByTimeRequest
-	2,  // 52: spawner.SpawnerService.HealthCheck:output_type -> spawner.Empty
-	4,  // 53: spawner.SpawnerService.Echo:output_type -> spawner.EchoResponse
-	13, // 54: spawner.SpawnerService.CreateCluster:output_type -> spawner.ClusterResponse
-	17, // 55: spawner.SpawnerService.AddToken:output_type -> spawner.AddTokenResponse
-	19, // 56: spawner.SpawnerService.GetToken:output_type -> spawner.GetTokenResponse
-	21, // 57: spawner.SpawnerService.AddRoute53Record:output_type -> spawner.AddRoute53RecordResponse
-	11, // 58: spawner.SpawnerService.GetCluster:output_type -> spawner.ClusterSpec
-	12, // 59: spawner.SpawnerService.GetClusters:output_type -> spawner.GetClustersResponse
-	23, // 60: spawner.SpawnerService.AddNode:output_type -> spawner.NodeSpawnResponse
-	15, // 61: spawner.SpawnerService.ClusterStatus:output_type -> spawner.ClusterStatusResponse
-	25, // 62: spawner.SpawnerService.DeleteCluster:output_type -> spawner.ClusterDeleteResponse
-	27, // 63: spawner.SpawnerService.DeleteNode:output_type -> spawner.NodeDeleteResponse
-	29, // 64: spawner.SpawnerService.CreateVolume:output_type -> spawner.CreateVolumeResponse
-	31, // 65: spawner.SpawnerService.DeleteVolume:output_type -> spawner.DeleteVolumeResponse
-	33, // 66: spawner.SpawnerService.CreateSnapshot:output_type -> spawner.CreateSnapshotResponse
-	35, // 67: spawner.SpawnerService.CreateSnapshotAndDelete:output_type -> spawner.CreateSnapshotAndDeleteResponse
-	37, // 68: spawner.SpawnerService.RegisterWithRancher:output_type -> spawner.RancherRegistrationResponse
-	41, // 69: spawner.SpawnerService.GetWorkspacesCost:output_type -> spawner.GetWorkspacesCostResponse
-	42, // 70: spawner.SpawnerService.GetApplicationsCost:output_type -> spawner.GetApplicationsCostResponse
-	47, // 71: spawner.SpawnerService.WriteCredential:output_type -> spawner.WriteCredentialResponse
-	49, // 72: spawner.SpawnerService.ReadCredential:output_type -> spawner.ReadCredentialResponse
-	51, // 73: spawner.SpawnerService.GetKubeConfig:output_type -> spawner.GetKubeConfigResponse
-	52, // 74: spawner.SpawnerService.TagNodeInstance:output_type -> spawner.TagNodeInstanceResponse
-	55, // 75: spawner.SpawnerService.GetCostByTime:output_type -> spawner.GetCostByTimeResponse
-	52, // [52:76] is the sub-list for method output_type
-	28, // [28:52] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+
+func (x *AzureCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_proto_netbookai_spawner_spawner_proto_init() }
-func file_proto_netbookai_spawner_spawner_proto_init() {
-	if File_proto_netbookai_spawner_spawner_proto != nil {
-		return
+func (*AzureCredentials) ProtoMessage() {}
+
+func (x *AzureCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AzureCredentials.ProtoReflect.Descriptor instead.
+func (*AzureCredentials) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AzureCredentials) GetSubscriptionID() string {
+	if x != nil {
+		return x.SubscriptionID
+	}
+	return ""
+}
+
+func (x *AzureCredentials) GetTenantID() string {
+	if x != nil {
+		return x.TenantID
+	}
+	return ""
+}
+
+func (x *AzureCredentials) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *AzureCredentials) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *AzureCredentials) GetResourceGroup() string {
+	if x != nil {
+		return x.ResourceGroup
+	}
+	return ""
+}
+
+type GithubPersonalAccessToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *GithubPersonalAccessToken) Reset() {
+	*x = GithubPersonalAccessToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GithubPersonalAccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GithubPersonalAccessToken) ProtoMessage() {}
+
+func (x *GithubPersonalAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GithubPersonalAccessToken.ProtoReflect.Descriptor instead.
+func (*GithubPersonalAccessToken) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GithubPersonalAccessToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WriteCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Deprecated: Do not use.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// Types that are assignable to Cred:
+	//	*WriteCredentialRequest_AwsCred
+	//	*WriteCredentialRequest_AzureCred
+	//	*WriteCredentialRequest_GitPat
+	Cred isWriteCredentialRequest_Cred `protobuf_oneof:"cred"`
+}
+
+func (x *WriteCredentialRequest) Reset() {
+	*x = WriteCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteCredentialRequest) ProtoMessage() {}
+
+func (x *WriteCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteCredentialRequest.ProtoReflect.Descriptor instead.
+func (*WriteCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WriteCredentialRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *WriteCredentialRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WriteCredentialRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (m *WriteCredentialRequest) GetCred() isWriteCredentialRequest_Cred {
+	if m != nil {
+		return m.Cred
+	}
+	return nil
+}
+
+func (x *WriteCredentialRequest) GetAwsCred() *AwsCredentials {
+	if x, ok := x.GetCred().(*WriteCredentialRequest_AwsCred); ok {
+		return x.AwsCred
+	}
+	return nil
+}
+
+func (x *WriteCredentialRequest) GetAzureCred() *AzureCredentials {
+	if x, ok := x.GetCred().(*WriteCredentialRequest_AzureCred); ok {
+		return x.AzureCred
+	}
+	return nil
+}
+
+func (x *WriteCredentialRequest) GetGitPat() *GithubPersonalAccessToken {
+	if x, ok := x.GetCred().(*WriteCredentialRequest_GitPat); ok {
+		return x.GitPat
+	}
+	return nil
+}
+
+type isWriteCredentialRequest_Cred interface {
+	isWriteCredentialRequest_Cred()
+}
+
+type WriteCredentialRequest_AwsCred struct {
+	AwsCred *AwsCredentials `protobuf:"bytes,3,opt,name=awsCred,proto3,oneof"`
+}
+
+type WriteCredentialRequest_AzureCred struct {
+	AzureCred *AzureCredentials `protobuf:"bytes,4,opt,name=azureCred,proto3,oneof"`
+}
+
+type WriteCredentialRequest_GitPat struct {
+	GitPat *GithubPersonalAccessToken `protobuf:"bytes,6,opt,name=gitPat,proto3,oneof"`
+}
+
+func (*WriteCredentialRequest_AwsCred) isWriteCredentialRequest_Cred() {}
+
+func (*WriteCredentialRequest_AzureCred) isWriteCredentialRequest_Cred() {}
+
+func (*WriteCredentialRequest_GitPat) isWriteCredentialRequest_Cred() {}
+
+type WriteCredentialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *WriteCredentialResponse) Reset() {
+	*x = WriteCredentialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteCredentialResponse) ProtoMessage() {}
+
+func (x *WriteCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteCredentialResponse.ProtoReflect.Descriptor instead.
+func (*WriteCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WriteCredentialResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReadCredentialRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Deprecated: Do not use.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *ReadCredentialRequest) Reset() {
+	*x = ReadCredentialRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadCredentialRequest) ProtoMessage() {}
+
+func (x *ReadCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadCredentialRequest.ProtoReflect.Descriptor instead.
+func (*ReadCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReadCredentialRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *ReadCredentialRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ReadCredentialRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ReadCredentialResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Deprecated: Do not use.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Type     string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// Types that are assignable to Cred:
+	//	*ReadCredentialResponse_AwsCred
+	//	*ReadCredentialResponse_AzureCred
+	//	*ReadCredentialResponse_GitPat
+	Cred isReadCredentialResponse_Cred `protobuf_oneof:"cred"`
+}
+
+func (x *ReadCredentialResponse) Reset() {
+	*x = ReadCredentialResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadCredentialResponse) ProtoMessage() {}
+
+func (x *ReadCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadCredentialResponse.ProtoReflect.Descriptor instead.
+func (*ReadCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReadCredentialResponse) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *ReadCredentialResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ReadCredentialResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (m *ReadCredentialResponse) GetCred() isReadCredentialResponse_Cred {
+	if m != nil {
+		return m.Cred
+	}
+	return nil
+}
+
+func (x *ReadCredentialResponse) GetAwsCred() *AwsCredentials {
+	if x, ok := x.GetCred().(*ReadCredentialResponse_AwsCred); ok {
+		return x.AwsCred
+	}
+	return nil
+}
+
+func (x *ReadCredentialResponse) GetAzureCred() *AzureCredentials {
+	if x, ok := x.GetCred().(*ReadCredentialResponse_AzureCred); ok {
+		return x.AzureCred
+	}
+	return nil
+}
+
+func (x *ReadCredentialResponse) GetGitPat() *GithubPersonalAccessToken {
+	if x, ok := x.GetCred().(*ReadCredentialResponse_GitPat); ok {
+		return x.GitPat
+	}
+	return nil
+}
+
+type isReadCredentialResponse_Cred interface {
+	isReadCredentialResponse_Cred()
+}
+
+type ReadCredentialResponse_AwsCred struct {
+	AwsCred *AwsCredentials `protobuf:"bytes,3,opt,name=awsCred,proto3,oneof"`
+}
+
+type ReadCredentialResponse_AzureCred struct {
+	AzureCred *AzureCredentials `protobuf:"bytes,4,opt,name=azureCred,proto3,oneof"`
+}
+
+type ReadCredentialResponse_GitPat struct {
+	GitPat *GithubPersonalAccessToken `protobuf:"bytes,6,opt,name=gitPat,proto3,oneof"`
+}
+
+func (*ReadCredentialResponse_AwsCred) isReadCredentialResponse_Cred() {}
+
+func (*ReadCredentialResponse_AzureCred) isReadCredentialResponse_Cred() {}
+
+func (*ReadCredentialResponse_GitPat) isReadCredentialResponse_Cred() {}
+
+type GetKubeConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//spawnerExec kubeconfig gets its token with 'spawner get-token', cloud CLI and credentials are not needed on the client
+	SpawnerExec bool `protobuf:"varint,5,opt,name=spawnerExec,proto3" json:"spawnerExec,omitempty"`
+	//spawnerAddr spawner service address 'ip:port' the credential plugin connects to, used with spawnerExec
+	SpawnerAddr string `protobuf:"bytes,6,opt,name=spawnerAddr,proto3" json:"spawnerAddr,omitempty"`
+}
+
+func (x *GetKubeConfigRequest) Reset() {
+	*x = GetKubeConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKubeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKubeConfigRequest) ProtoMessage() {}
+
+func (x *GetKubeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKubeConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetKubeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetKubeConfigRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetKubeConfigRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetKubeConfigRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GetKubeConfigRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetKubeConfigRequest) GetSpawnerExec() bool {
+	if x != nil {
+		return x.SpawnerExec
+	}
+	return false
+}
+
+func (x *GetKubeConfigRequest) GetSpawnerAddr() string {
+	if x != nil {
+		return x.SpawnerAddr
+	}
+	return ""
+}
+
+type GetKubeConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Config      []byte `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *GetKubeConfigResponse) Reset() {
+	*x = GetKubeConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKubeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKubeConfigResponse) ProtoMessage() {}
+
+func (x *GetKubeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKubeConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetKubeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetKubeConfigResponse) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetKubeConfigResponse) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type TagNodeInstanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TagNodeInstanceResponse) Reset() {
+	*x = TagNodeInstanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagNodeInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagNodeInstanceResponse) ProtoMessage() {}
+
+func (x *TagNodeInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagNodeInstanceResponse.ProtoReflect.Descriptor instead.
+func (*TagNodeInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{63}
+}
+
+type TagNodeInstanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string            `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string            `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string            `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeGroup   string            `protobuf:"bytes,5,opt,name=nodeGroup,proto3" json:"nodeGroup,omitempty"`
+	Labels      map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//rolloutLaunchTemplate add the labels to the launch template of the node group so volumes and network interfaces
+	//of later scale ups are tagged too. EKS rolls out the new template version by replacing every node of the node
+	//group, running pods are evicted. AWS only
+	RolloutLaunchTemplate bool `protobuf:"varint,7,opt,name=rolloutLaunchTemplate,proto3" json:"rolloutLaunchTemplate,omitempty"`
+}
+
+func (x *TagNodeInstanceRequest) Reset() {
+	*x = TagNodeInstanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagNodeInstanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagNodeInstanceRequest) ProtoMessage() {}
+
+func (x *TagNodeInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagNodeInstanceRequest.ProtoReflect.Descriptor instead.
+func (*TagNodeInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TagNodeInstanceRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TagNodeInstanceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TagNodeInstanceRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *TagNodeInstanceRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *TagNodeInstanceRequest) GetNodeGroup() string {
+	if x != nil {
+		return x.NodeGroup
+	}
+	return ""
+}
+
+func (x *TagNodeInstanceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *TagNodeInstanceRequest) GetRolloutLaunchTemplate() bool {
+	if x != nil {
+		return x.RolloutLaunchTemplate
+	}
+	return false
+}
+
+type GetCostByTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Ids         []string `protobuf:"bytes,2,rep,name=Ids,proto3" json:"Ids,omitempty"`
+	AccountName string   `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	StartDate   string   `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate     string   `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Granularity string   `protobuf:"bytes,6,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	GroupBy     *GroupBy `protobuf:"bytes,7,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
+}
+
+func (x *GetCostByTimeRequest) Reset() {
+	*x = GetCostByTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCostByTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostByTimeRequest) ProtoMessage() {}
+
+func (x *GetCostByTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostByTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetCostByTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetCostByTimeRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetCostByTimeRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *GetCostByTimeRequest) GetGroupBy() *GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+type GetCostByTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupedCost map[string]*CostMap `protobuf:"bytes,1,rep,name=groupedCost,proto3" json:"groupedCost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetCostByTimeResponse) Reset() {
+	*x = GetCostByTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCostByTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostByTimeResponse) ProtoMessage() {}
+
+func (x *GetCostByTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostByTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetCostByTimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetCostByTimeResponse) GetGroupedCost() map[string]*CostMap {
+	if x != nil {
+		return x.GroupedCost
+	}
+	return nil
+}
+
+type CostMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cost map[string]int64 `protobuf:"bytes,1,rep,name=cost,proto3" json:"cost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *CostMap) Reset() {
+	*x = CostMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CostMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostMap) ProtoMessage() {}
+
+func (x *CostMap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostMap.ProtoReflect.Descriptor instead.
+func (*CostMap) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CostMap) GetCost() map[string]int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type ListKubernetesVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+}
+
+func (x *ListKubernetesVersionsRequest) Reset() {
+	*x = ListKubernetesVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKubernetesVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKubernetesVersionsRequest) ProtoMessage() {}
+
+func (x *ListKubernetesVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKubernetesVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListKubernetesVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListKubernetesVersionsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListKubernetesVersionsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListKubernetesVersionsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type ListKubernetesVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []string `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// provider default, empty when provider does not advertise one
+	DefaultVersion string `protobuf:"bytes,2,opt,name=defaultVersion,proto3" json:"defaultVersion,omitempty"`
+}
+
+func (x *ListKubernetesVersionsResponse) Reset() {
+	*x = ListKubernetesVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKubernetesVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKubernetesVersionsResponse) ProtoMessage() {}
+
+func (x *ListKubernetesVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKubernetesVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListKubernetesVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListKubernetesVersionsResponse) GetVersions() []string {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListKubernetesVersionsResponse) GetDefaultVersion() string {
+	if x != nil {
+		return x.DefaultVersion
+	}
+	return ""
+}
+
+type UpgradeStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster or node pool name
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// one of 'cluster', 'nodepool'
+	Type           string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	CurrentVersion string `protobuf:"bytes,3,opt,name=currentVersion,proto3" json:"currentVersion,omitempty"`
+	TargetVersion  string `protobuf:"bytes,4,opt,name=targetVersion,proto3" json:"targetVersion,omitempty"`
+	// one of 'pending', 'in-progress', 'successful', 'failed'
+	Status   string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	UpdateId string `protobuf:"bytes,6,opt,name=updateId,proto3" json:"updateId,omitempty"`
+	Error    string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UpgradeStep) Reset() {
+	*x = UpgradeStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeStep) ProtoMessage() {}
+
+func (x *UpgradeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeStep.ProtoReflect.Descriptor instead.
+func (*UpgradeStep) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpgradeStep) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *UpgradeStep) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpgradeStep) GetCurrentVersion() string {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return ""
+}
+
+func (x *UpgradeStep) GetTargetVersion() string {
+	if x != nil {
+		return x.TargetVersion
+	}
+	return ""
+}
+
+func (x *UpgradeStep) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpgradeStep) GetUpdateId() string {
+	if x != nil {
+		return x.UpdateId
+	}
+	return ""
+}
+
+func (x *UpgradeStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpgradeClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider          string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region            string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName       string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName       string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	KubernetesVersion string `protobuf:"bytes,5,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	// upgrade control plane only, node pools can be upgraded later using
+	// UpgradeNodePool
+	SkipNodePools bool `protobuf:"varint,6,opt,name=skipNodePools,proto3" json:"skipNodePools,omitempty"`
+}
+
+func (x *UpgradeClusterRequest) Reset() {
+	*x = UpgradeClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeClusterRequest) ProtoMessage() {}
+
+func (x *UpgradeClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeClusterRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpgradeClusterRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UpgradeClusterRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *UpgradeClusterRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UpgradeClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *UpgradeClusterRequest) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
+	}
+	return ""
+}
+
+func (x *UpgradeClusterRequest) GetSkipNodePools() bool {
+	if x != nil {
+		return x.SkipNodePools
+	}
+	return false
+}
+
+type UpgradeClusterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*UpgradeStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *UpgradeClusterResponse) Reset() {
+	*x = UpgradeClusterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeClusterResponse) ProtoMessage() {}
+
+func (x *UpgradeClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeClusterResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpgradeClusterResponse) GetSteps() []*UpgradeStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type UpgradeNodePoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider      string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region        string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName   string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName   string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeGroupName string `protobuf:"bytes,5,opt,name=nodeGroupName,proto3" json:"nodeGroupName,omitempty"`
+	// defaults to cluster control plane version when empty
+	KubernetesVersion string `protobuf:"bytes,6,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+}
+
+func (x *UpgradeNodePoolRequest) Reset() {
+	*x = UpgradeNodePoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeNodePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeNodePoolRequest) ProtoMessage() {}
+
+func (x *UpgradeNodePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeNodePoolRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeNodePoolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpgradeNodePoolRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UpgradeNodePoolRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *UpgradeNodePoolRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UpgradeNodePoolRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *UpgradeNodePoolRequest) GetNodeGroupName() string {
+	if x != nil {
+		return x.NodeGroupName
+	}
+	return ""
+}
+
+func (x *UpgradeNodePoolRequest) GetKubernetesVersion() string {
+	if x != nil {
+		return x.KubernetesVersion
+	}
+	return ""
+}
+
+type UpgradeNodePoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Step *UpgradeStep `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+}
+
+func (x *UpgradeNodePoolResponse) Reset() {
+	*x = UpgradeNodePoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeNodePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeNodePoolResponse) ProtoMessage() {}
+
+func (x *UpgradeNodePoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeNodePoolResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeNodePoolResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpgradeNodePoolResponse) GetStep() *UpgradeStep {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+type GetUpgradeStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *GetUpgradeStatusRequest) Reset() {
+	*x = GetUpgradeStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUpgradeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradeStatusRequest) ProtoMessage() {}
+
+func (x *GetUpgradeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUpgradeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetUpgradeStatusRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetUpgradeStatusRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetUpgradeStatusRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GetUpgradeStatusRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetUpgradeStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*UpgradeStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *GetUpgradeStatusResponse) Reset() {
+	*x = GetUpgradeStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUpgradeStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradeStatusResponse) ProtoMessage() {}
+
+func (x *GetUpgradeStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetUpgradeStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetUpgradeStatusResponse) GetSteps() []*UpgradeStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// ListMachineTypesRequest provider and region are optional filters
+type ListMachineTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region   string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *ListMachineTypesRequest) Reset() {
+	*x = ListMachineTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMachineTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineTypesRequest) ProtoMessage() {}
+
+func (x *ListMachineTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListMachineTypesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListMachineTypesRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListMachineTypesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type MachineType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Vcpu int32  `protobuf:"varint,2,opt,name=vcpu,proto3" json:"vcpu,omitempty"`
+	//memory in GiB
+	Memory       int32  `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	GpuModel     string `protobuf:"bytes,4,opt,name=gpuModel,proto3" json:"gpuModel,omitempty"`
+	GpuCount     int32  `protobuf:"varint,5,opt,name=gpuCount,proto3" json:"gpuCount,omitempty"`
+	Architecture string `protobuf:"bytes,6,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	//instances provider to instance name
+	Instances map[string]string `protobuf:"bytes,7,rep,name=instances,proto3" json:"instances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MachineType) Reset() {
+	*x = MachineType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MachineType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineType) ProtoMessage() {}
+
+func (x *MachineType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineType.ProtoReflect.Descriptor instead.
+func (*MachineType) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MachineType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MachineType) GetVcpu() int32 {
+	if x != nil {
+		return x.Vcpu
+	}
+	return 0
+}
+
+func (x *MachineType) GetMemory() int32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *MachineType) GetGpuModel() string {
+	if x != nil {
+		return x.GpuModel
+	}
+	return ""
+}
+
+func (x *MachineType) GetGpuCount() int32 {
+	if x != nil {
+		return x.GpuCount
+	}
+	return 0
+}
+
+func (x *MachineType) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *MachineType) GetInstances() map[string]string {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type ListMachineTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CatalogVersion string         `protobuf:"bytes,1,opt,name=catalogVersion,proto3" json:"catalogVersion,omitempty"`
+	MachineTypes   []*MachineType `protobuf:"bytes,2,rep,name=machineTypes,proto3" json:"machineTypes,omitempty"`
+}
+
+func (x *ListMachineTypesResponse) Reset() {
+	*x = ListMachineTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMachineTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineTypesResponse) ProtoMessage() {}
+
+func (x *ListMachineTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListMachineTypesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListMachineTypesResponse) GetCatalogVersion() string {
+	if x != nil {
+		return x.CatalogVersion
+	}
+	return ""
+}
+
+func (x *ListMachineTypesResponse) GetMachineTypes() []*MachineType {
+	if x != nil {
+		return x.MachineTypes
+	}
+	return nil
+}
+
+// CheckCapacityRequest clusterName is optional, when set instance availability is checked against the cluster zones
+type CheckCapacityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string    `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string    `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string    `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string    `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	NodeSpec    *NodeSpec `protobuf:"bytes,5,opt,name=nodeSpec,proto3" json:"nodeSpec,omitempty"`
+}
+
+func (x *CheckCapacityRequest) Reset() {
+	*x = CheckCapacityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCapacityRequest) ProtoMessage() {}
+
+func (x *CheckCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCapacityRequest.ProtoReflect.Descriptor instead.
+func (*CheckCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CheckCapacityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CheckCapacityRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CheckCapacityRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CheckCapacityRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *CheckCapacityRequest) GetNodeSpec() *NodeSpec {
+	if x != nil {
+		return x.NodeSpec
+	}
+	return nil
+}
+
+type CapacityCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//status one of passed, warning, failed
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CapacityCheck) Reset() {
+	*x = CapacityCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapacityCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityCheck) ProtoMessage() {}
+
+func (x *CapacityCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityCheck.ProtoReflect.Descriptor instead.
+func (*CapacityCheck) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CapacityCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CapacityCheck) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CapacityCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CheckCapacityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//ok none of the checks failed, warnings do not block provisioning
+	Ok     bool             `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Checks []*CapacityCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *CheckCapacityResponse) Reset() {
+	*x = CheckCapacityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCapacityResponse) ProtoMessage() {}
+
+func (x *CheckCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCapacityResponse.ProtoReflect.Descriptor instead.
+func (*CheckCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CheckCapacityResponse) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *CheckCapacityResponse) GetChecks() []*CapacityCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type NetworkSubnet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cidr             string `protobuf:"bytes,3,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	AvailabilityZone string `protobuf:"bytes,4,opt,name=availabilityZone,proto3" json:"availabilityZone,omitempty"`
+	//tier public or private
+	Tier string `protobuf:"bytes,5,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (x *NetworkSubnet) Reset() {
+	*x = NetworkSubnet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkSubnet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkSubnet) ProtoMessage() {}
+
+func (x *NetworkSubnet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkSubnet.ProtoReflect.Descriptor instead.
+func (*NetworkSubnet) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *NetworkSubnet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkSubnet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkSubnet) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *NetworkSubnet) GetAvailabilityZone() string {
+	if x != nil {
+		return x.AvailabilityZone
+	}
+	return ""
+}
+
+func (x *NetworkSubnet) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+type NetworkStack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//id vpc id on AWS, virtual network resource id on Azure
+	Id              string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Region          string           `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Cidrs           []string         `protobuf:"bytes,4,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
+	Subnets         []*NetworkSubnet `protobuf:"bytes,5,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	InternetGateway string           `protobuf:"bytes,6,opt,name=internetGateway,proto3" json:"internetGateway,omitempty"`
+	NatGateways     []string         `protobuf:"bytes,7,rep,name=natGateways,proto3" json:"natGateways,omitempty"`
+	VpcEndpoints    []string         `protobuf:"bytes,8,rep,name=vpcEndpoints,proto3" json:"vpcEndpoints,omitempty"`
+	//clusters using the network stack, stack cannot be deleted while it is in use
+	Clusters []string `protobuf:"bytes,9,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	//managed stack is owned by the provider i.e AKS node resource group, it is deleted along with the cluster
+	Managed bool `protobuf:"varint,10,opt,name=managed,proto3" json:"managed,omitempty"`
+}
+
+func (x *NetworkStack) Reset() {
+	*x = NetworkStack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkStack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkStack) ProtoMessage() {}
+
+func (x *NetworkStack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkStack.ProtoReflect.Descriptor instead.
+func (*NetworkStack) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *NetworkStack) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkStack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkStack) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *NetworkStack) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *NetworkStack) GetSubnets() []*NetworkSubnet {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+func (x *NetworkStack) GetInternetGateway() string {
+	if x != nil {
+		return x.InternetGateway
+	}
+	return ""
+}
+
+func (x *NetworkStack) GetNatGateways() []string {
+	if x != nil {
+		return x.NatGateways
+	}
+	return nil
+}
+
+func (x *NetworkStack) GetVpcEndpoints() []string {
+	if x != nil {
+		return x.VpcEndpoints
+	}
+	return nil
+}
+
+func (x *NetworkStack) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *NetworkStack) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
+type GetNetworkStackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	//name virtual network name on Azure, AWS has single stack per region
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetNetworkStackRequest) Reset() {
+	*x = GetNetworkStackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetworkStackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkStackRequest) ProtoMessage() {}
+
+func (x *GetNetworkStackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkStackRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworkStackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetNetworkStackRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetNetworkStackRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetNetworkStackRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GetNetworkStackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetNetworkStackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkStack *NetworkStack `protobuf:"bytes,1,opt,name=networkStack,proto3" json:"networkStack,omitempty"`
+}
+
+func (x *GetNetworkStackResponse) Reset() {
+	*x = GetNetworkStackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetworkStackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworkStackResponse) ProtoMessage() {}
+
+func (x *GetNetworkStackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworkStackResponse.ProtoReflect.Descriptor instead.
+func (*GetNetworkStackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetNetworkStackResponse) GetNetworkStack() *NetworkStack {
+	if x != nil {
+		return x.NetworkStack
+	}
+	return nil
+}
+
+type ListNetworkStacksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	AccountName string `protobuf:"bytes,2,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	//regions to look in, all enabled regions when empty
+	Regions []string `protobuf:"bytes,3,rep,name=regions,proto3" json:"regions,omitempty"`
+}
+
+func (x *ListNetworkStacksRequest) Reset() {
+	*x = ListNetworkStacksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNetworkStacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworkStacksRequest) ProtoMessage() {}
+
+func (x *ListNetworkStacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworkStacksRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworkStacksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListNetworkStacksRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListNetworkStacksRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListNetworkStacksRequest) GetRegions() []string {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type ListNetworkStacksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkStacks []*NetworkStack `protobuf:"bytes,1,rep,name=networkStacks,proto3" json:"networkStacks,omitempty"`
+}
+
+func (x *ListNetworkStacksResponse) Reset() {
+	*x = ListNetworkStacksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNetworkStacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworkStacksResponse) ProtoMessage() {}
+
+func (x *ListNetworkStacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworkStacksResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworkStacksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListNetworkStacksResponse) GetNetworkStacks() []*NetworkStack {
+	if x != nil {
+		return x.NetworkStacks
+	}
+	return nil
+}
+
+type DeleteNetworkStackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	//name virtual network name on Azure, AWS has single stack per region
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteNetworkStackRequest) Reset() {
+	*x = DeleteNetworkStackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNetworkStackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNetworkStackRequest) ProtoMessage() {}
+
+func (x *DeleteNetworkStackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNetworkStackRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNetworkStackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteNetworkStackRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeleteNetworkStackRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DeleteNetworkStackRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DeleteNetworkStackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteNetworkStackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteNetworkStackResponse) Reset() {
+	*x = DeleteNetworkStackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNetworkStackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNetworkStackResponse) ProtoMessage() {}
+
+func (x *DeleteNetworkStackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNetworkStackResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNetworkStackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{90}
+}
+
+type Addon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//name EKS add-on name i.e aws-ebs-csi-driver, AKS add-on profile name i.e azurepolicy
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//version add-on version, latest compatible version when empty, not used on Azure
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Status  string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	//serviceAccountRoleArn IAM role of the add-on service account, spawner sets it up when empty
+	ServiceAccountRoleArn string `protobuf:"bytes,4,opt,name=serviceAccountRoleArn,proto3" json:"serviceAccountRoleArn,omitempty"`
+	//config AKS add-on profile config
+	Config map[string]string `protobuf:"bytes,5,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//availableVersions versions compatible with the cluster kubernetes version
+	AvailableVersions []string `protobuf:"bytes,6,rep,name=availableVersions,proto3" json:"availableVersions,omitempty"`
+}
+
+func (x *Addon) Reset() {
+	*x = Addon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addon) ProtoMessage() {}
+
+func (x *Addon) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addon.ProtoReflect.Descriptor instead.
+func (*Addon) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Addon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Addon) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Addon) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Addon) GetServiceAccountRoleArn() string {
+	if x != nil {
+		return x.ServiceAccountRoleArn
+	}
+	return ""
+}
+
+func (x *Addon) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Addon) GetAvailableVersions() []string {
+	if x != nil {
+		return x.AvailableVersions
+	}
+	return nil
+}
+
+type ListAddonsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *ListAddonsRequest) Reset() {
+	*x = ListAddonsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAddonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddonsRequest) ProtoMessage() {}
+
+func (x *ListAddonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddonsRequest.ProtoReflect.Descriptor instead.
+func (*ListAddonsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListAddonsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListAddonsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListAddonsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListAddonsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type ListAddonsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//addons installed on the cluster
+	Addons []*Addon `protobuf:"bytes,1,rep,name=addons,proto3" json:"addons,omitempty"`
+	//available add-ons which can be installed on the cluster
+	Available []*Addon `protobuf:"bytes,2,rep,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *ListAddonsResponse) Reset() {
+	*x = ListAddonsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAddonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddonsResponse) ProtoMessage() {}
+
+func (x *ListAddonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddonsResponse.ProtoReflect.Descriptor instead.
+func (*ListAddonsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListAddonsResponse) GetAddons() []*Addon {
+	if x != nil {
+		return x.Addons
+	}
+	return nil
+}
+
+func (x *ListAddonsResponse) GetAvailable() []*Addon {
+	if x != nil {
+		return x.Available
+	}
+	return nil
+}
+
+type InstallAddonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Addon       *Addon `protobuf:"bytes,5,opt,name=addon,proto3" json:"addon,omitempty"`
+}
+
+func (x *InstallAddonRequest) Reset() {
+	*x = InstallAddonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallAddonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallAddonRequest) ProtoMessage() {}
+
+func (x *InstallAddonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallAddonRequest.ProtoReflect.Descriptor instead.
+func (*InstallAddonRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *InstallAddonRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *InstallAddonRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *InstallAddonRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *InstallAddonRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *InstallAddonRequest) GetAddon() *Addon {
+	if x != nil {
+		return x.Addon
+	}
+	return nil
+}
+
+type InstallAddonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addon *Addon `protobuf:"bytes,1,opt,name=addon,proto3" json:"addon,omitempty"`
+}
+
+func (x *InstallAddonResponse) Reset() {
+	*x = InstallAddonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallAddonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallAddonResponse) ProtoMessage() {}
+
+func (x *InstallAddonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallAddonResponse.ProtoReflect.Descriptor instead.
+func (*InstallAddonResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *InstallAddonResponse) GetAddon() *Addon {
+	if x != nil {
+		return x.Addon
+	}
+	return nil
+}
+
+type UpdateAddonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Addon       *Addon `protobuf:"bytes,5,opt,name=addon,proto3" json:"addon,omitempty"`
+	//resolveConflicts OVERWRITE add-on configuration changed in the cluster, or NONE to fail the update on conflicts, NONE when empty. EKS only
+	ResolveConflicts string `protobuf:"bytes,6,opt,name=resolveConflicts,proto3" json:"resolveConflicts,omitempty"`
+}
+
+func (x *UpdateAddonRequest) Reset() {
+	*x = UpdateAddonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAddonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddonRequest) ProtoMessage() {}
+
+func (x *UpdateAddonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddonRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateAddonRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UpdateAddonRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *UpdateAddonRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UpdateAddonRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *UpdateAddonRequest) GetAddon() *Addon {
+	if x != nil {
+		return x.Addon
+	}
+	return nil
+}
+
+func (x *UpdateAddonRequest) GetResolveConflicts() string {
+	if x != nil {
+		return x.ResolveConflicts
+	}
+	return ""
+}
+
+type UpdateAddonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addon *Addon `protobuf:"bytes,1,opt,name=addon,proto3" json:"addon,omitempty"`
+}
+
+func (x *UpdateAddonResponse) Reset() {
+	*x = UpdateAddonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAddonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddonResponse) ProtoMessage() {}
+
+func (x *UpdateAddonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddonResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAddonResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateAddonResponse) GetAddon() *Addon {
+	if x != nil {
+		return x.Addon
+	}
+	return nil
+}
+
+type RemoveAddonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Name        string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	//preserve keep the add-on resources running in the cluster, EKS only
+	Preserve bool `protobuf:"varint,6,opt,name=preserve,proto3" json:"preserve,omitempty"`
+}
+
+func (x *RemoveAddonRequest) Reset() {
+	*x = RemoveAddonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAddonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAddonRequest) ProtoMessage() {}
+
+func (x *RemoveAddonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAddonRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAddonRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RemoveAddonRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RemoveAddonRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RemoveAddonRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *RemoveAddonRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *RemoveAddonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveAddonRequest) GetPreserve() bool {
+	if x != nil {
+		return x.Preserve
+	}
+	return false
+}
+
+type RemoveAddonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveAddonResponse) Reset() {
+	*x = RemoveAddonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAddonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAddonResponse) ProtoMessage() {}
+
+func (x *RemoveAddonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAddonResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAddonResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{99}
+}
+
+type BindServiceAccountRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider       string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region         string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName    string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName    string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Namespace      string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ServiceAccount string `protobuf:"bytes,6,opt,name=serviceAccount,proto3" json:"serviceAccount,omitempty"`
+	//policyArns IAM policies attached to the role on AWS, role definition ids assigned on the resource group on Azure
+	PolicyArns []string `protobuf:"bytes,7,rep,name=policyArns,proto3" json:"policyArns,omitempty"`
+	//roleName IAM role or managed identity name, defaults to <cluster>-<namespace>-<serviceAccount>
+	RoleName string `protobuf:"bytes,8,opt,name=roleName,proto3" json:"roleName,omitempty"`
+}
+
+func (x *BindServiceAccountRoleRequest) Reset() {
+	*x = BindServiceAccountRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindServiceAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindServiceAccountRoleRequest) ProtoMessage() {}
+
+func (x *BindServiceAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindServiceAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*BindServiceAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *BindServiceAccountRoleRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleRequest) GetServiceAccount() string {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleRequest) GetPolicyArns() []string {
+	if x != nil {
+		return x.PolicyArns
+	}
+	return nil
+}
+
+func (x *BindServiceAccountRoleRequest) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type BindServiceAccountRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//roleArn IAM role ARN on AWS, managed identity resource id on Azure
+	RoleArn string `protobuf:"bytes,1,opt,name=roleArn,proto3" json:"roleArn,omitempty"`
+	//clientId managed identity client id, Azure only
+	ClientId   string `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	OidcIssuer string `protobuf:"bytes,3,opt,name=oidcIssuer,proto3" json:"oidcIssuer,omitempty"`
+}
+
+func (x *BindServiceAccountRoleResponse) Reset() {
+	*x = BindServiceAccountRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindServiceAccountRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindServiceAccountRoleResponse) ProtoMessage() {}
+
+func (x *BindServiceAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindServiceAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*BindServiceAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BindServiceAccountRoleResponse) GetRoleArn() string {
+	if x != nil {
+		return x.RoleArn
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *BindServiceAccountRoleResponse) GetOidcIssuer() string {
+	if x != nil {
+		return x.OidcIssuer
+	}
+	return ""
+}
+
+type UpdateClusterConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//logging left unchanged when not set
+	Logging *ClusterLogging `protobuf:"bytes,5,opt,name=logging,proto3" json:"logging,omitempty"`
+	//encryption left unchanged when not set, it cannot be turned off on AWS
+	Encryption *SecretsEncryption `protobuf:"bytes,6,opt,name=encryption,proto3" json:"encryption,omitempty"`
+}
+
+func (x *UpdateClusterConfigRequest) Reset() {
+	*x = UpdateClusterConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateClusterConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClusterConfigRequest) ProtoMessage() {}
+
+func (x *UpdateClusterConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClusterConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClusterConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateClusterConfigRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UpdateClusterConfigRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *UpdateClusterConfigRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *UpdateClusterConfigRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *UpdateClusterConfigRequest) GetLogging() *ClusterLogging {
+	if x != nil {
+		return x.Logging
+	}
+	return nil
+}
+
+func (x *UpdateClusterConfigRequest) GetEncryption() *SecretsEncryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+type UpdateClusterConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//updateIds EKS updates which can be tracked in AWS console, empty on Azure
+	UpdateIds []string `protobuf:"bytes,1,rep,name=updateIds,proto3" json:"updateIds,omitempty"`
+}
+
+func (x *UpdateClusterConfigResponse) Reset() {
+	*x = UpdateClusterConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateClusterConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClusterConfigResponse) ProtoMessage() {}
+
+func (x *UpdateClusterConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClusterConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClusterConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateClusterConfigResponse) GetUpdateIds() []string {
+	if x != nil {
+		return x.UpdateIds
+	}
+	return nil
+}
+
+type StopClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *StopClusterRequest) Reset() {
+	*x = StopClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopClusterRequest) ProtoMessage() {}
+
+func (x *StopClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopClusterRequest.ProtoReflect.Descriptor instead.
+func (*StopClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StopClusterRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StopClusterRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StopClusterRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *StopClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type StopClusterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//state of the cluster once stop is requested, STOPPED when it was already stopped
+	State ClusterState `protobuf:"varint,1,opt,name=state,proto3,enum=spawner.ClusterState" json:"state,omitempty"`
+}
+
+func (x *StopClusterResponse) Reset() {
+	*x = StopClusterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopClusterResponse) ProtoMessage() {}
+
+func (x *StopClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopClusterResponse.ProtoReflect.Descriptor instead.
+func (*StopClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *StopClusterResponse) GetState() ClusterState {
+	if x != nil {
+		return x.State
+	}
+	return ClusterState_STATE_UNKNOWN
+}
+
+type StartClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *StartClusterRequest) Reset() {
+	*x = StartClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartClusterRequest) ProtoMessage() {}
+
+func (x *StartClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartClusterRequest.ProtoReflect.Descriptor instead.
+func (*StartClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *StartClusterRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StartClusterRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StartClusterRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *StartClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type StartClusterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//state of the cluster once start is requested, RUNNING when it was already running
+	State ClusterState `protobuf:"varint,1,opt,name=state,proto3,enum=spawner.ClusterState" json:"state,omitempty"`
+}
+
+func (x *StartClusterResponse) Reset() {
+	*x = StartClusterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartClusterResponse) ProtoMessage() {}
+
+func (x *StartClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartClusterResponse.ProtoReflect.Descriptor instead.
+func (*StartClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *StartClusterResponse) GetState() ClusterState {
+	if x != nil {
+		return x.State
+	}
+	return ClusterState_STATE_UNKNOWN
+}
+
+type BootstrapManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//url manifest fetched over http(s), yaml is used when not set
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	//yaml inline manifest, documents separated by '---'
+	Yaml string `protobuf:"bytes,2,opt,name=yaml,proto3" json:"yaml,omitempty"`
+}
+
+func (x *BootstrapManifest) Reset() {
+	*x = BootstrapManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapManifest) ProtoMessage() {}
+
+func (x *BootstrapManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapManifest.ProtoReflect.Descriptor instead.
+func (*BootstrapManifest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *BootstrapManifest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BootstrapManifest) GetYaml() string {
+	if x != nil {
+		return x.Yaml
+	}
+	return ""
+}
+
+type BootstrapChart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Release   string `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	//repo chart repository URL
+	Repo  string `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Chart string `protobuf:"bytes,4,opt,name=chart,proto3" json:"chart,omitempty"`
+	//version latest chart version when empty
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	//set values passed with --set
+	Set map[string]string `protobuf:"bytes,6,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//values content of values.yaml
+	Values string `protobuf:"bytes,7,opt,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *BootstrapChart) Reset() {
+	*x = BootstrapChart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapChart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapChart) ProtoMessage() {}
+
+func (x *BootstrapChart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapChart.ProtoReflect.Descriptor instead.
+func (*BootstrapChart) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *BootstrapChart) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *BootstrapChart) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BootstrapChart) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *BootstrapChart) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *BootstrapChart) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BootstrapChart) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *BootstrapChart) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+// Bootstrap namespaces are created first, then manifests are applied and charts installed, each in the given order
+type Bootstrap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespaces []string             `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Manifests  []*BootstrapManifest `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	Charts     []*BootstrapChart    `protobuf:"bytes,3,rep,name=charts,proto3" json:"charts,omitempty"`
+}
+
+func (x *Bootstrap) Reset() {
+	*x = Bootstrap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bootstrap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bootstrap) ProtoMessage() {}
+
+func (x *Bootstrap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
+func (*Bootstrap) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Bootstrap) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetManifests() []*BootstrapManifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetCharts() []*BootstrapChart {
+	if x != nil {
+		return x.Charts
+	}
+	return nil
+}
+
+type BootstrapStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// namespace name, manifest url or 'inline-<index>', chart release
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// one of 'namespace', 'manifest', 'chart'
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// one of 'pending', 'successful', 'failed'
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BootstrapStep) Reset() {
+	*x = BootstrapStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapStep) ProtoMessage() {}
+
+func (x *BootstrapStep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapStep.ProtoReflect.Descriptor instead.
+func (*BootstrapStep) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *BootstrapStep) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *BootstrapStep) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BootstrapStep) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BootstrapStep) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetBootstrapStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *GetBootstrapStatusRequest) Reset() {
+	*x = GetBootstrapStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBootstrapStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBootstrapStatusRequest) ProtoMessage() {}
+
+func (x *GetBootstrapStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBootstrapStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBootstrapStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetBootstrapStatusRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GetBootstrapStatusRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetBootstrapStatusRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *GetBootstrapStatusRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetBootstrapStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//steps empty when the cluster was created without bootstrap
+	Steps []*BootstrapStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *GetBootstrapStatusResponse) Reset() {
+	*x = GetBootstrapStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBootstrapStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBootstrapStatusResponse) ProtoMessage() {}
+
+func (x *GetBootstrapStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBootstrapStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBootstrapStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetBootstrapStatusResponse) GetSteps() []*BootstrapStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type Tenant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	//quota hard limits of the namespace
+	Quota map[string]string `protobuf:"bytes,3,rep,name=quota,proto3" json:"quota,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//creationTime RFC3339 time the namespace was created
+	CreationTime string `protobuf:"bytes,4,opt,name=creationTime,proto3" json:"creationTime,omitempty"`
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Tenant) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *Tenant) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Tenant) GetQuota() map[string]string {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *Tenant) GetCreationTime() string {
+	if x != nil {
+		return x.CreationTime
+	}
+	return ""
+}
+
+// CreateTenantRequest tenant is updated when it exists, every call returns a kubeconfig with a new token
+type CreateTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	WorkspaceId string `protobuf:"bytes,5,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+	//namespace 'tenant-<workspaceId>' when empty
+	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	//quota hard limits of the namespace, e.g. 'requests.cpu': '4', 'limits.memory': '16Gi', 'requests.nvidia.com/gpu': '1'
+	Quota map[string]string `protobuf:"bytes,7,rep,name=quota,proto3" json:"quota,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//defaultRequest container requests when the pod spec does not set them, e.g. 'cpu': '100m'
+	DefaultRequest map[string]string `protobuf:"bytes,8,rep,name=defaultRequest,proto3" json:"defaultRequest,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//defaultLimit container limits when the pod spec does not set them, e.g. 'memory': '1Gi'
+	DefaultLimit map[string]string `protobuf:"bytes,9,rep,name=defaultLimit,proto3" json:"defaultLimit,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//tokenExpirySeconds validity of the kubeconfig token, one hour when not set
+	TokenExpirySeconds int64             `protobuf:"varint,10,opt,name=tokenExpirySeconds,proto3" json:"tokenExpirySeconds,omitempty"`
+	Labels             map[string]string `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateTenantRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetQuota() map[string]string {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetDefaultRequest() map[string]string {
+	if x != nil {
+		return x.DefaultRequest
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetDefaultLimit() map[string]string {
+	if x != nil {
+		return x.DefaultLimit
+	}
+	return nil
+}
+
+func (x *CreateTenantRequest) GetTokenExpirySeconds() int64 {
+	if x != nil {
+		return x.TokenExpirySeconds
+	}
+	return 0
+}
+
+func (x *CreateTenantRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	//kubeconfig grants access only to the tenant namespace
+	Kubeconfig []byte `protobuf:"bytes,2,opt,name=kubeconfig,proto3" json:"kubeconfig,omitempty"`
+	//tokenExpiry RFC3339 time the kubeconfig token expires
+	TokenExpiry string `protobuf:"bytes,3,opt,name=tokenExpiry,proto3" json:"tokenExpiry,omitempty"`
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+func (x *CreateTenantResponse) GetKubeconfig() []byte {
+	if x != nil {
+		return x.Kubeconfig
+	}
+	return nil
+}
+
+func (x *CreateTenantResponse) GetTokenExpiry() string {
+	if x != nil {
+		return x.TokenExpiry
+	}
+	return ""
+}
+
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	WorkspaceId string `protobuf:"bytes,5,opt,name=workspaceId,proto3" json:"workspaceId,omitempty"`
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *DeleteTenantRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type DeleteTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//namespaces deleted, along with everything in them
+	Namespaces []string `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *DeleteTenantResponse) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type ListTenantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Region      string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AccountName string `protobuf:"bytes,3,opt,name=accountName,proto3" json:"accountName,omitempty"`
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListTenantsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type ListTenantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenants []*Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *ListTenantsResponse) Reset() {
+	*x = ListTenantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse) ProtoMessage() {}
+
+func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_netbookai_spawner_spawner_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_netbookai_spawner_spawner_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+var File_proto_netbookai_spawner_spawner_proto protoreflect.FileDescriptor
+
+var file_proto_netbookai_spawner_spawner_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6e, 0x65, 0x74, 0x62, 0x6f, 0x6f, 0x6b, 0x61,
+	0x69, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x0a, 0x0b, 0x45, 0x63, 0x68,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x20, 0x0a, 0x0c, 0x45, 0x63,
+	0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xdc, 0x09, 0x0a,
+	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x70, 0x75, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x70, 0x75, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x33,
+	0x0a, 0x0a, 0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x49, 0x47,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x70, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x6f,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x70,
+	0x6f, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x73, 0x70, 0x6f, 0x74, 0x46, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6d, 0x69, 0x49, 0x64, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6d, 0x69, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6f, 0x70,
+	0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x6f, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x65, 0x62, 0x73, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x62, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x0a, 0x65, 0x62, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x67, 0x70, 0x75, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x47, 0x50, 0x55, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x09, 0x67, 0x70, 0x75, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f,
+	0x6e, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x1d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70,
+	0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb1, 0x01, 0x0a, 0x09,
+	0x45, 0x62, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x69, 0x6f, 0x70,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22,
+	0x5b, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x0c,
+	0x53, 0x70, 0x6f, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70, 0x6f, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x70,
+	0x6f, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x22, 0x5f, 0x0a, 0x05, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0x2e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65, 0x22, 0xcd, 0x07, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x69, 0x64, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x69,
+	0x64, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a,
+	0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x40, 0x0a, 0x0c, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x30, 0x0a, 0x09, 0x62, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
+	0x70, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x1a, 0x39, 0x0a, 0x0b,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe2, 0x02, 0x0a, 0x13, 0x41, 0x7a, 0x75, 0x72,
+	0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3c, 0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x0d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64,
+	0x43, 0x69, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x43,
+	0x69, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x69,
+	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x69, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6e, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x70, 0x12, 0x39, 0x0a, 0x0c, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x49, 0x70, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x64, 0x49, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a,
+	0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b,
+	0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x22, 0x48, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x67, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x11, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x22,
+	0xc6, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x70, 0x63, 0x43, 0x69,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x70, 0x63, 0x43, 0x69, 0x64,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x0a, 0x6e, 0x61, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x61,
+	0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x70, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x70, 0x63, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x8b, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f,
+	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x8e, 0x01,
+	0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9a,
+	0x01, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x67, 0x0a, 0x0f, 0x41,
+	0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x22, 0xa9, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35,
+	0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x48,
+	0x0a, 0x18, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x22, 0x45, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x14,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x13, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf9, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61,
+	0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x12,
+	0x30, 0x0a, 0x13, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x64, 0x72,
+	0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x2a, 0x0a, 0x12, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd8, 0x03,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x12,
+	0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x39, 0x0a,
+	0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x72, 0x69, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x46, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xae, 0x02,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x22, 0xc0, 0x02, 0x0a, 0x1e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x69, 0x64, 0x12,
+	0x4b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6b, 0x0a,
+	0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x13, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x60, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x75, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x75, 0x6e, 0x22, 0x8f, 0x02, 0x0a, 0x13, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x14, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x22, 0x7d, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72, 0x69, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x55, 0x72,
+	0x69, 0x22, 0x3e, 0x0a, 0x1a, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x7f, 0x0a, 0x1b, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55,
+	0x52, 0x4c, 0x22, 0xa2, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61,
+	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6f, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x79, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xa4, 0x02, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0x2f,
+	0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0xd0, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f,
+	0x73, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74,
+	0x12, 0x57, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x0e, 0x41, 0x77, 0x73,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x28, 0x0a,
+	0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbc, 0x01,
+	0x0a, 0x10, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x31, 0x0a, 0x19,
+	0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x9c, 0x02, 0x0a, 0x16, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x77, 0x73, 0x43,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x12, 0x39, 0x0a,
+	0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61,
+	0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x50,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x06,
+	0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0x2f,
+	0x0a, 0x17, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x65, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x9c, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x33, 0x0a, 0x07, 0x61, 0x77, 0x73, 0x43, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x77, 0x73, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x77, 0x73,
+	0x43, 0x72, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x12,
+	0x3c, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x50, 0x61, 0x74, 0x42, 0x06, 0x0a,
+	0x04, 0x63, 0x72, 0x65, 0x64, 0x22, 0xd2, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x45, 0x78, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x19, 0x0a,
+	0x17, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe4, 0x02, 0x0a, 0x16, 0x54, 0x61, 0x67,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x34,
+	0x0a, 0x15, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xec, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x22, 0xbc,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x1a, 0x50, 0x0a, 0x10, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x73, 0x74, 0x4d,
+	0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a,
+	0x07, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x43, 0x6f, 0x73, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x75, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd5,
+	0x01, 0x0a, 0x0b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe3, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73,
+	0x6b, 0x69, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x44, 0x0a, 0x16,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f,
+	0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x17, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x91,
+	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x46, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x4d, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x02, 0x0a, 0x0b, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x76, 0x63, 0x70, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x76, 0x63, 0x70,
+	0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x70, 0x75,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x70, 0x75,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7c, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x22, 0x53, 0x0a, 0x0d, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x15, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02,
+	0x6f, 0x6b, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x10,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x22, 0xb8, 0x02, 0x0a,
+	0x0c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x64,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73, 0x12,
+	0x30, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6e,
+	0x61, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x61, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x76, 0x70, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x70, 0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x54, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61,
+	0x63, 0x6b, 0x22, 0x72, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x58, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73,
+	0x22, 0x85, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x72, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x41, 0x72, 0x6e, 0x12, 0x32, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x2c, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x64, 0x64, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41,
+	0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
+	0x6f, 0x6e, 0x52, 0x05, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x14, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e,
+	0x52, 0x05, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x05, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x05,
+	0x61, 0x64, 0x64, 0x6f, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64,
+	0x64, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99, 0x02, 0x0a, 0x1d,
+	0x42, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x72, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x72, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x1e, 0x42, 0x69, 0x6e, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
+	0x65, 0x41, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x41, 0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x69, 0x64, 0x63, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22,
+	0x83, 0x02, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x42, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x39, 0x0a, 0x11, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x79, 0x61, 0x6d, 0x6c, 0x22, 0x90, 0x02, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x65, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x68, 0x61, 0x72, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x96, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x2f, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x73, 0x22, 0x6d, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74,
+	0x65, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x93, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0xd8, 0x01, 0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x06,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x0e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x38, 0x0a, 0x0a,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6b, 0x75, 0x62,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0xaf, 0x01, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x73, 0x2a, 0x50, 0x0a, 0x0a, 0x4d, 0x49, 0x47, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x31, 0x67, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49,
+	0x47, 0x32, 0x67, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x33, 0x67, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x47, 0x34, 0x67, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d,
+	0x49, 0x47, 0x37, 0x67, 0x10, 0x05, 0x2a, 0x40, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x50, 0x4c,
+	0x55, 0x47, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x36, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65,
+	0x55, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x4e, 0x44, 0x45,
+	0x4d, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50, 0x4f, 0x54, 0x10, 0x02,
+	0x2a, 0x41, 0x0a, 0x0e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50,
+	0x55, 0x42, 0x4c, 0x49, 0x43, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54,
+	0x45, 0x10, 0x02, 0x2a, 0x2b, 0x0a, 0x0d, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x55, 0x42, 0x45, 0x4e, 0x45, 0x54, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x5a, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4e, 0x49, 0x10, 0x01,
+	0x2a, 0x73, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x45, 0x44, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x44, 0x5f, 0x4e, 0x41, 0x54, 0x5f, 0x47, 0x41, 0x54,
+	0x45, 0x57, 0x41, 0x59, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41,
+	0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x4e, 0x41, 0x54, 0x5f, 0x47, 0x41, 0x54, 0x45,
+	0x57, 0x41, 0x59, 0x10, 0x03, 0x2a, 0x54, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
+	0x50, 0x52, 0x49, 0x4e, 0x43, 0x49, 0x50, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x2e, 0x0a, 0x0a, 0x4e,
+	0x61, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x41, 0x54,
+	0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x41, 0x54,
+	0x5f, 0x50, 0x45, 0x52, 0x5f, 0x5a, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x2a, 0x83, 0x01, 0x0a, 0x0c,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x55, 0x50, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08,
+	0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54,
+	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x07, 0x32, 0xd1, 0x1e, 0x0a, 0x0e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x14, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x63,
+	0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x35,
+	0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x35, 0x33, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x70, 0x65, 0x63, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x41, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x57,
+	0x69, 0x74, 0x68, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x63, 0x68, 0x65,
+	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12,
+	0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x54,
+	0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x20,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x73,
+	0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x64, 0x6f,
+	0x6e, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x64, 0x64, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b,
+	0x0a, 0x16, 0x42, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b,
+	0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x70,
+	0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2f, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x65,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_netbookai_spawner_spawner_proto_rawDescOnce sync.Once
+	file_proto_netbookai_spawner_spawner_proto_rawDescData = file_proto_netbookai_spawner_spawner_proto_rawDesc
+)
+
+func file_proto_netbookai_spawner_spawner_proto_rawDescGZIP() []byte {
+	file_proto_netbookai_spawner_spawner_proto_rawDescOnce.Do(func() {
+		file_proto_netbookai_spawner_spawner_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_netbookai_spawner_spawner_proto_rawDescData)
+	})
+	return file_proto_netbookai_spawner_spawner_proto_rawDescData
+}
+
+var file_proto_netbookai_spawner_spawner_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_proto_netbookai_spawner_spawner_proto_msgTypes = make([]protoimpl.MessageInfo, 139)
+var file_proto_netbookai_spawner_spawner_proto_goTypes = []interface{}{
+	(MIGProfile)(0),                         // 0: spawner.MIGProfile
+	(GPUPlugin)(0),                          // 1: spawner.GPUPlugin
+	(CapacityType)(0),                       // 2: spawner.CapacityType
+	(EndpointAccess)(0),                     // 3: spawner.EndpointAccess
+	(NetworkPlugin)(0),                      // 4: spawner.NetworkPlugin
+	(OutboundType)(0),                       // 5: spawner.OutboundType
+	(ClusterIdentityType)(0),                // 6: spawner.ClusterIdentityType
+	(NatGateway)(0),                         // 7: spawner.NatGateway
+	(ClusterState)(0),                       // 8: spawner.ClusterState
+	(*Empty)(nil),                           // 9: spawner.Empty
+	(*EchoRequest)(nil),                     // 10: spawner.EchoRequest
+	(*EchoResponse)(nil),                    // 11: spawner.EchoResponse
+	(*NodeSpec)(nil),                        // 12: spawner.NodeSpec
+	(*EbsVolume)(nil),                       // 13: spawner.EbsVolume
+	(*InstanceMetadataOptions)(nil),         // 14: spawner.InstanceMetadataOptions
+	(*SpotFallback)(nil),                    // 15: spawner.SpotFallback
+	(*Issue)(nil),                           // 16: spawner.Issue
+	(*Health)(nil),                          // 17: spawner.Health
+	(*ClusterRequest)(nil),                  // 18: spawner.ClusterRequest
+	(*AzureNetworkOptions)(nil),             // 19: spawner.AzureNetworkOptions
+	(*ClusterIdentity)(nil),                 // 20: spawner.ClusterIdentity
+	(*ClusterLogging)(nil),                  // 21: spawner.ClusterLogging
+	(*SecretsEncryption)(nil),               // 22: spawner.SecretsEncryption
+	(*NetworkStackOptions)(nil),             // 23: spawner.NetworkStackOptions
+	(*GetClusterRequest)(nil),               // 24: spawner.GetClusterRequest
+	(*GetClustersRequest)(nil),              // 25: spawner.GetClustersRequest
+	(*ClusterSpec)(nil),                     // 26: spawner.ClusterSpec
+	(*GetClustersResponse)(nil),             // 27: spawner.GetClustersResponse
+	(*ClusterResponse)(nil),                 // 28: spawner.ClusterResponse
+	(*ClusterStatusRequest)(nil),            // 29: spawner.ClusterStatusRequest
+	(*ClusterStatusResponse)(nil),           // 30: spawner.ClusterStatusResponse
+	(*AddTokenRequest)(nil),                 // 31: spawner.AddTokenRequest
+	(*AddTokenResponse)(nil),                // 32: spawner.AddTokenResponse
+	(*GetTokenRequest)(nil),                 // 33: spawner.GetTokenRequest
+	(*GetTokenResponse)(nil),                // 34: spawner.GetTokenResponse
+	(*AddRoute53RecordRequest)(nil),         // 35: spawner.AddRoute53RecordRequest
+	(*AddRoute53RecordResponse)(nil),        // 36: spawner.AddRoute53RecordResponse
+	(*NodeSpawnRequest)(nil),                // 37: spawner.NodeSpawnRequest
+	(*NodeSpawnResponse)(nil),               // 38: spawner.NodeSpawnResponse
+	(*ClusterDeleteRequest)(nil),            // 39: spawner.ClusterDeleteRequest
+	(*ClusterDeleteResponse)(nil),           // 40: spawner.ClusterDeleteResponse
+	(*NodeDeleteRequest)(nil),               // 41: spawner.NodeDeleteRequest
+	(*NodeDeleteResponse)(nil),              // 42: spawner.NodeDeleteResponse
+	(*CreateVolumeRequest)(nil),             // 43: spawner.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),            // 44: spawner.CreateVolumeResponse
+	(*DeleteVolumeRequest)(nil),             // 45: spawner.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),            // 46: spawner.DeleteVolumeResponse
+	(*CreateSnapshotRequest)(nil),           // 47: spawner.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),          // 48: spawner.CreateSnapshotResponse
+	(*CreateSnapshotAndDeleteRequest)(nil),  // 49: spawner.CreateSnapshotAndDeleteRequest
+	(*NodeReference)(nil),                   // 50: spawner.NodeReference
+	(*AttachVolumeRequest)(nil),             // 51: spawner.AttachVolumeRequest
+	(*AttachVolumeResponse)(nil),            // 52: spawner.AttachVolumeResponse
+	(*DetachVolumeRequest)(nil),             // 53: spawner.DetachVolumeRequest
+	(*DetachVolumeResponse)(nil),            // 54: spawner.DetachVolumeResponse
+	(*CreateSnapshotAndDeleteResponse)(nil), // 55: spawner.CreateSnapshotAndDeleteResponse
+	(*RancherRegistrationRequest)(nil),      // 56: spawner.RancherRegistrationRequest
+	(*RancherRegistrationResponse)(nil),     // 57: spawner.RancherRegistrationResponse
+	(*GetWorkspacesCostRequest)(nil),        // 58: spawner.GetWorkspacesCostRequest
+	(*GetApplicationsCostRequest)(nil),      // 59: spawner.GetApplicationsCostRequest
+	(*GroupBy)(nil),                         // 60: spawner.GroupBy
+	(*GetWorkspacesCostResponse)(nil),       // 61: spawner.GetWorkspacesCostResponse
+	(*GetApplicationsCostResponse)(nil),     // 62: spawner.GetApplicationsCostResponse
+	(*AwsCredentials)(nil),                  // 63: spawner.AwsCredentials
+	(*AzureCredentials)(nil),                // 64: spawner.AzureCredentials
+	(*GithubPersonalAccessToken)(nil),       // 65: spawner.GithubPersonalAccessToken
+	(*WriteCredentialRequest)(nil),          // 66: spawner.WriteCredentialRequest
+	(*WriteCredentialResponse)(nil),         // 67: spawner.WriteCredentialResponse
+	(*ReadCredentialRequest)(nil),           // 68: spawner.ReadCredentialRequest
+	(*ReadCredentialResponse)(nil),          // 69: spawner.ReadCredentialResponse
+	(*GetKubeConfigRequest)(nil),            // 70: spawner.GetKubeConfigRequest
+	(*GetKubeConfigResponse)(nil),           // 71: spawner.GetKubeConfigResponse
+	(*TagNodeInstanceResponse)(nil),         // 72: spawner.TagNodeInstanceResponse
+	(*TagNodeInstanceRequest)(nil),          // 73: spawner.TagNodeInstanceRequest
+	(*GetCostByTimeRequest)(nil),            // 74: spawner.GetCostByTimeRequest
+	(*GetCostByTimeResponse)(nil),           // 75: spawner.GetCostByTimeResponse
+	(*CostMap)(nil),                         // 76: spawner.costMap
+	(*ListKubernetesVersionsRequest)(nil),   // 77: spawner.ListKubernetesVersionsRequest
+	(*ListKubernetesVersionsResponse)(nil),  // 78: spawner.ListKubernetesVersionsResponse
+	(*UpgradeStep)(nil),                     // 79: spawner.UpgradeStep
+	(*UpgradeClusterRequest)(nil),           // 80: spawner.UpgradeClusterRequest
+	(*UpgradeClusterResponse)(nil),          // 81: spawner.UpgradeClusterResponse
+	(*UpgradeNodePoolRequest)(nil),          // 82: spawner.UpgradeNodePoolRequest
+	(*UpgradeNodePoolResponse)(nil),         // 83: spawner.UpgradeNodePoolResponse
+	(*GetUpgradeStatusRequest)(nil),         // 84: spawner.GetUpgradeStatusRequest
+	(*GetUpgradeStatusResponse)(nil),        // 85: spawner.GetUpgradeStatusResponse
+	(*ListMachineTypesRequest)(nil),         // 86: spawner.ListMachineTypesRequest
+	(*MachineType)(nil),                     // 87: spawner.MachineType
+	(*ListMachineTypesResponse)(nil),        // 88: spawner.ListMachineTypesResponse
+	(*CheckCapacityRequest)(nil),            // 89: spawner.CheckCapacityRequest
+	(*CapacityCheck)(nil),                   // 90: spawner.CapacityCheck
+	(*CheckCapacityResponse)(nil),           // 91: spawner.CheckCapacityResponse
+	(*NetworkSubnet)(nil),                   // 92: spawner.NetworkSubnet
+	(*NetworkStack)(nil),                    // 93: spawner.NetworkStack
+	(*GetNetworkStackRequest)(nil),          // 94: spawner.GetNetworkStackRequest
+	(*GetNetworkStackResponse)(nil),         // 95: spawner.GetNetworkStackResponse
+	(*ListNetworkStacksRequest)(nil),        // 96: spawner.ListNetworkStacksRequest
+	(*ListNetworkStacksResponse)(nil),       // 97: spawner.ListNetworkStacksResponse
+	(*DeleteNetworkStackRequest)(nil),       // 98: spawner.DeleteNetworkStackRequest
+	(*DeleteNetworkStackResponse)(nil),      // 99: spawner.DeleteNetworkStackResponse
+	(*Addon)(nil),                           // 100: spawner.Addon
+	(*ListAddonsRequest)(nil),               // 101: spawner.ListAddonsRequest
+	(*ListAddonsResponse)(nil),              // 102: spawner.ListAddonsResponse
+	(*InstallAddonRequest)(nil),             // 103: spawner.InstallAddonRequest
+	(*InstallAddonResponse)(nil),            // 104: spawner.InstallAddonResponse
+	(*UpdateAddonRequest)(nil),              // 105: spawner.UpdateAddonRequest
+	(*UpdateAddonResponse)(nil),             // 106: spawner.UpdateAddonResponse
+	(*RemoveAddonRequest)(nil),              // 107: spawner.RemoveAddonRequest
+	(*RemoveAddonResponse)(nil),             // 108: spawner.RemoveAddonResponse
+	(*BindServiceAccountRoleRequest)(nil),   // 109: spawner.BindServiceAccountRoleRequest
+	(*BindServiceAccountRoleResponse)(nil),  // 110: spawner.BindServiceAccountRoleResponse
+	(*UpdateClusterConfigRequest)(nil),      // 111: spawner.UpdateClusterConfigRequest
+	(*UpdateClusterConfigResponse)(nil),     // 112: spawner.UpdateClusterConfigResponse
+	(*StopClusterRequest)(nil),              // 113: spawner.StopClusterRequest
+	(*StopClusterResponse)(nil),             // 114: spawner.StopClusterResponse
+	(*StartClusterRequest)(nil),             // 115: spawner.StartClusterRequest
+	(*StartClusterResponse)(nil),            // 116: spawner.StartClusterResponse
+	(*BootstrapManifest)(nil),               // 117: spawner.BootstrapManifest
+	(*BootstrapChart)(nil),                  // 118: spawner.BootstrapChart
+	(*Bootstrap)(nil),                       // 119: spawner.Bootstrap
+	(*BootstrapStep)(nil),                   // 120: spawner.BootstrapStep
+	(*GetBootstrapStatusRequest)(nil),       // 121: spawner.GetBootstrapStatusRequest
+	(*GetBootstrapStatusResponse)(nil),      // 122: spawner.GetBootstrapStatusResponse
+	(*Tenant)(nil),                          // 123: spawner.Tenant
+	(*CreateTenantRequest)(nil),             // 124: spawner.CreateTenantRequest
+	(*CreateTenantResponse)(nil),            // 125: spawner.CreateTenantResponse
+	(*DeleteTenantRequest)(nil),             // 126: spawner.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),            // 127: spawner.DeleteTenantResponse
+	(*ListTenantsRequest)(nil),              // 128: spawner.ListTenantsRequest
+	(*ListTenantsResponse)(nil),             // 129: spawner.ListTenantsResponse
+	nil,                                     // 130: spawner.NodeSpec.LabelsEntry
+	nil,                                     // 131: spawner.ClusterRequest.LabelsEntry
+	nil,                                     // 132: spawner.CreateVolumeRequest.LabelsEntry
+	nil,                                     // 133: spawner.CreateSnapshotRequest.LabelsEntry
+	nil,                                     // 134: spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
+	nil,                                     // 135: spawner.GetWorkspacesCostResponse.GroupedCostEntry
+	nil,                                     // 136: spawner.GetApplicationsCostResponse.GroupedCostEntry
+	nil,                                     // 137: spawner.TagNodeInstanceRequest.LabelsEntry
+	nil,                                     // 138: spawner.GetCostByTimeResponse.GroupedCostEntry
+	nil,                                     // 139: spawner.costMap.CostEntry
+	nil,                                     // 140: spawner.MachineType.InstancesEntry
+	nil,                                     // 141: spawner.Addon.ConfigEntry
+	nil,                                     // 142: spawner.BootstrapChart.SetEntry
+	nil,                                     // 143: spawner.Tenant.QuotaEntry
+	nil,                                     // 144: spawner.CreateTenantRequest.QuotaEntry
+	nil,                                     // 145: spawner.CreateTenantRequest.DefaultRequestEntry
+	nil,                                     // 146: spawner.CreateTenantRequest.DefaultLimitEntry
+	nil,                                     // 147: spawner.CreateTenantRequest.LabelsEntry
+}
+var file_proto_netbookai_spawner_spawner_proto_depIdxs = []int32{
+	130, // 0: spawner.NodeSpec.labels:type_name -> spawner.NodeSpec.LabelsEntry
+	17,  // 1: spawner.NodeSpec.health:type_name -> spawner.Health
+	0,   // 2: spawner.NodeSpec.migProfile:type_name -> spawner.MIGProfile
+	2,   // 3: spawner.NodeSpec.capacityType:type_name -> spawner.CapacityType
+	15,  // 4: spawner.NodeSpec.spotFallback:type_name -> spawner.SpotFallback
+	13,  // 5: spawner.NodeSpec.ebsVolumes:type_name -> spawner.EbsVolume
+	14,  // 6: spawner.NodeSpec.metadataOptions:type_name -> spawner.InstanceMetadataOptions
+	1,   // 7: spawner.NodeSpec.gpuPlugin:type_name -> spawner.GPUPlugin
+	8,   // 8: spawner.NodeSpec.poolState:type_name -> spawner.ClusterState
+	16,  // 9: spawner.Health.issue:type_name -> spawner.Issue
+	12,  // 10: spawner.ClusterRequest.node:type_name -> spawner.NodeSpec
+	131, // 11: spawner.ClusterRequest.labels:type_name -> spawner.ClusterRequest.LabelsEntry
+	3,   // 12: spawner.ClusterRequest.endpointAccess:type_name -> spawner.EndpointAccess
+	23,  // 13: spawner.ClusterRequest.networkStack:type_name -> spawner.NetworkStackOptions
+	100, // 14: spawner.ClusterRequest.addons:type_name -> spawner.Addon
+	21,  // 15: spawner.ClusterRequest.logging:type_name -> spawner.ClusterLogging
+	22,  // 16: spawner.ClusterRequest.encryption:type_name -> spawner.SecretsEncryption
+	20,  // 17: spawner.ClusterRequest.identity:type_name -> spawner.ClusterIdentity
+	19,  // 18: spawner.ClusterRequest.azureNetwork:type_name -> spawner.AzureNetworkOptions
+	119, // 19: spawner.ClusterRequest.bootstrap:type_name -> spawner.Bootstrap
+	4,   // 20: spawner.AzureNetworkOptions.networkPlugin:type_name -> spawner.NetworkPlugin
+	5,   // 21: spawner.AzureNetworkOptions.outboundType:type_name -> spawner.OutboundType
+	6,   // 22: spawner.ClusterIdentity.type:type_name -> spawner.ClusterIdentityType
+	7,   // 23: spawner.NetworkStackOptions.natGateway:type_name -> spawner.NatGateway
+	12,  // 24: spawner.ClusterSpec.nodeSpec:type_name -> spawner.NodeSpec
+	8,   // 25: spawner.ClusterSpec.state:type_name -> spawner.ClusterState
+	26,  // 26: spawner.GetClustersResponse.clusters:type_name -> spawner.ClusterSpec
+	8,   // 27: spawner.ClusterStatusResponse.state:type_name -> spawner.ClusterState
+	12,  // 28: spawner.NodeSpawnRequest.nodeSpec:type_name -> spawner.NodeSpec
+	132, // 29: spawner.CreateVolumeRequest.labels:type_name -> spawner.CreateVolumeRequest.LabelsEntry
+	133, // 30: spawner.CreateSnapshotRequest.labels:type_name -> spawner.CreateSnapshotRequest.LabelsEntry
+	134, // 31: spawner.CreateSnapshotAndDeleteRequest.labels:type_name -> spawner.CreateSnapshotAndDeleteRequest.LabelsEntry
+	50,  // 32: spawner.AttachVolumeRequest.node:type_name -> spawner.NodeReference
+	50,  // 33: spawner.DetachVolumeRequest.node:type_name -> spawner.NodeReference
+	60,  // 34: spawner.GetWorkspacesCostRequest.groupBy:type_name -> spawner.GroupBy
+	60,  // 35: spawner.GetApplicationsCostRequest.groupBy:type_name -> spawner.GroupBy
+	135, // 36: spawner.GetWorkspacesCostResponse.groupedCost:type_name -> spawner.GetWorkspacesCostResponse.GroupedCostEntry
+	136, // 37: spawner.GetApplicationsCostResponse.groupedCost:type_name -> spawner.GetApplicationsCostResponse.GroupedCostEntry
+	63,  // 38: spawner.WriteCredentialRequest.awsCred:type_name -> spawner.AwsCredentials
+	64,  // 39: spawner.WriteCredentialRequest.azureCred:type_name -> spawner.AzureCredentials
+	65,  // 40: spawner.WriteCredentialRequest.gitPat:type_name -> spawner.GithubPersonalAccessToken
+	63,  // 41: spawner.ReadCredentialResponse.awsCred:type_name -> spawner.AwsCredentials
+	64,  // 42: spawner.ReadCredentialResponse.azureCred:type_name -> spawner.AzureCredentials
+	65,  // 43: spawner.ReadCredentialResponse.gitPat:type_name -> spawner.GithubPersonalAccessToken
+	137, // 44: spawner.TagNodeInstanceRequest.labels:type_name -> spawner.TagNodeInstanceRequest.LabelsEntry
+	60,  // 45: spawner.GetCostByTimeRequest.groupBy:type_name -> spawner.GroupBy
+	138, // 46: spawner.GetCostByTimeResponse.groupedCost:type_name -> spawner.GetCostByTimeResponse.GroupedCostEntry
+	139, // 47: spawner.costMap.cost:type_name -> spawner.costMap.CostEntry
+	79,  // 48: spawner.UpgradeClusterResponse.steps:type_name -> spawner.UpgradeStep
+	79,  // 49: spawner.UpgradeNodePoolResponse.step:type_name -> spawner.UpgradeStep
+	79,  // 50: spawner.GetUpgradeStatusResponse.steps:type_name -> spawner.UpgradeStep
+	140, // 51: spawner.MachineType.instances:type_name -> spawner.MachineType.InstancesEntry
+	87,  // 52: spawner.ListMachineTypesResponse.machineTypes:type_name -> spawner.MachineType
+	12,  // 53: spawner.CheckCapacityRequest.nodeSpec:type_name -> spawner.NodeSpec
+	90,  // 54: spawner.CheckCapacityResponse.checks:type_name -> spawner.CapacityCheck
+	92,  // 55: spawner.NetworkStack.subnets:type_name -> spawner.NetworkSubnet
+	93,  // 56: spawner.GetNetworkStackResponse.networkStack:type_name -> spawner.NetworkStack
+	93,  // 57: spawner.ListNetworkStacksResponse.networkStacks:type_name -> spawner.NetworkStack
+	141, // 58: spawner.Addon.config:type_name -> spawner.Addon.ConfigEntry
+	100, // 59: spawner.ListAddonsResponse.addons:type_name -> spawner.Addon
+	100, // 60: spawner.ListAddonsResponse.available:type_name -> spawner.Addon
+	100, // 61: spawner.InstallAddonRequest.addon:type_name -> spawner.Addon
+	100, // 62: spawner.InstallAddonResponse.addon:type_name -> spawner.Addon
+	100, // 63: spawner.UpdateAddonRequest.addon:type_name -> spawner.Addon
+	100, // 64: spawner.UpdateAddonResponse.addon:type_name -> spawner.Addon
+	21,  // 65: spawner.UpdateClusterConfigRequest.logging:type_name -> spawner.ClusterLogging
+	22,  // 66: spawner.UpdateClusterConfigRequest.encryption:type_name -> spawner.SecretsEncryption
+	8,   // 67: spawner.StopClusterResponse.state:type_name -> spawner.ClusterState
+	8,   // 68: spawner.StartClusterResponse.state:type_name -> spawner.ClusterState
+	142, // 69: spawner.BootstrapChart.set:type_name -> spawner.BootstrapChart.SetEntry
+	117, // 70: spawner.Bootstrap.manifests:type_name -> spawner.BootstrapManifest
+	118, // 71: spawner.Bootstrap.charts:type_name -> spawner.BootstrapChart
+	120, // 72: spawner.GetBootstrapStatusResponse.steps:type_name -> spawner.BootstrapStep
+	143, // 73: spawner.Tenant.quota:type_name -> spawner.Tenant.QuotaEntry
+	144, // 74: spawner.CreateTenantRequest.quota:type_name -> spawner.CreateTenantRequest.QuotaEntry
+	145, // 75: spawner.CreateTenantRequest.defaultRequest:type_name -> spawner.CreateTenantRequest.DefaultRequestEntry
+	146, // 76: spawner.CreateTenantRequest.defaultLimit:type_name -> spawner.CreateTenantRequest.DefaultLimitEntry
+	147, // 77: spawner.CreateTenantRequest.labels:type_name -> spawner.CreateTenantRequest.LabelsEntry
+	123, // 78: spawner.CreateTenantResponse.tenant:type_name -> spawner.Tenant
+	123, // 79: spawner.ListTenantsResponse.tenants:type_name -> spawner.Tenant
+	76,  // 80: spawner.GetCostByTimeResponse.GroupedCostEntry.value:type_name -> spawner.costMap
+	9,   // 81: spawner.SpawnerService.HealthCheck:input_type -> spawner.Empty
+	10,  // 82: spawner.SpawnerService.Echo:input_type -> spawner.EchoRequest
+	18,  // 83: spawner.SpawnerService.CreateCluster:input_type -> spawner.ClusterRequest
+	31,  // 84: spawner.SpawnerService.AddToken:input_type -> spawner.AddTokenRequest
+	33,  // 85: spawner.SpawnerService.GetToken:input_type -> spawner.GetTokenRequest
+	35,  // 86: spawner.SpawnerService.AddRoute53Record:input_type -> spawner.AddRoute53RecordRequest
+	24,  // 87: spawner.SpawnerService.GetCluster:input_type -> spawner.GetClusterRequest
+	25,  // 88: spawner.SpawnerService.GetClusters:input_type -> spawner.GetClustersRequest
+	37,  // 89: spawner.SpawnerService.AddNode:input_type -> spawner.NodeSpawnRequest
+	29,  // 90: spawner.SpawnerService.ClusterStatus:input_type -> spawner.ClusterStatusRequest
+	39,  // 91: spawner.SpawnerService.DeleteCluster:input_type -> spawner.ClusterDeleteRequest
+	41,  // 92: spawner.SpawnerService.DeleteNode:input_type -> spawner.NodeDeleteRequest
+	43,  // 93: spawner.SpawnerService.CreateVolume:input_type -> spawner.CreateVolumeRequest
+	45,  // 94: spawner.SpawnerService.DeleteVolume:input_type -> spawner.DeleteVolumeRequest
+	47,  // 95: spawner.SpawnerService.CreateSnapshot:input_type -> spawner.CreateSnapshotRequest
+	49,  // 96: spawner.SpawnerService.CreateSnapshotAndDelete:input_type -> spawner.CreateSnapshotAndDeleteRequest
+	51,  // 97: spawner.SpawnerService.AttachVolume:input_type -> spawner.AttachVolumeRequest
+	53,  // 98: spawner.SpawnerService.DetachVolume:input_type -> spawner.DetachVolumeRequest
+	56,  // 99: spawner.SpawnerService.RegisterWithRancher:input_type -> spawner.RancherRegistrationRequest
+	58,  // 100: spawner.SpawnerService.GetWorkspacesCost:input_type -> spawner.GetWorkspacesCostRequest
+	59,  // 101: spawner.SpawnerService.GetApplicationsCost:input_type -> spawner.GetApplicationsCostRequest
+	66,  // 102: spawner.SpawnerService.WriteCredential:input_type -> spawner.WriteCredentialRequest
+	68,  // 103: spawner.SpawnerService.ReadCredential:input_type -> spawner.ReadCredentialRequest
+	70,  // 104: spawner.SpawnerService.GetKubeConfig:input_type -> spawner.GetKubeConfigRequest
+	73,  // 105: spawner.SpawnerService.TagNodeInstance:input_type -> spawner.TagNodeInstanceRequest
+	74,  // 106: spawner.SpawnerService.GetCostByTime:input_type -> spawner.GetCostByTimeRequest
+	77,  // 107: spawner.SpawnerService.ListKubernetesVersions:input_type -> spawner.ListKubernetesVersionsRequest
+	80,  // 108: spawner.SpawnerService.UpgradeCluster:input_type -> spawner.UpgradeClusterRequest
+	82,  // 109: spawner.SpawnerService.UpgradeNodePool:input_type -> spawner.UpgradeNodePoolRequest
+	84,  // 110: spawner.SpawnerService.GetUpgradeStatus:input_type -> spawner.GetUpgradeStatusRequest
+	86,  // 111: spawner.SpawnerService.ListMachineTypes:input_type -> spawner.ListMachineTypesRequest
+	89,  // 112: spawner.SpawnerService.CheckCapacity:input_type -> spawner.CheckCapacityRequest
+	94,  // 113: spawner.SpawnerService.GetNetworkStack:input_type -> spawner.GetNetworkStackRequest
+	96,  // 114: spawner.SpawnerService.ListNetworkStacks:input_type -> spawner.ListNetworkStacksRequest
+	98,  // 115: spawner.SpawnerService.DeleteNetworkStack:input_type -> spawner.DeleteNetworkStackRequest
+	101, // 116: spawner.SpawnerService.ListAddons:input_type -> spawner.ListAddonsRequest
+	103, // 117: spawner.SpawnerService.InstallAddon:input_type -> spawner.InstallAddonRequest
+	105, // 118: spawner.SpawnerService.UpdateAddon:input_type -> spawner.UpdateAddonRequest
+	107, // 119: spawner.SpawnerService.RemoveAddon:input_type -> spawner.RemoveAddonRequest
+	109, // 120: spawner.SpawnerService.BindServiceAccountRole:input_type -> spawner.BindServiceAccountRoleRequest
+	111, // 121: spawner.SpawnerService.UpdateClusterConfig:input_type -> spawner.UpdateClusterConfigRequest
+	113, // 122: spawner.SpawnerService.StopCluster:input_type -> spawner.StopClusterRequest
+	115, // 123: spawner.SpawnerService.StartCluster:input_type -> spawner.StartClusterRequest
+	121, // 124: spawner.SpawnerService.GetBootstrapStatus:input_type -> spawner.GetBootstrapStatusRequest
+	124, // 125: spawner.SpawnerService.CreateTenant:input_type -> spawner.CreateTenantRequest
+	126, // 126: spawner.SpawnerService.DeleteTenant:input_type -> spawner.DeleteTenantRequest
+	128, // 127: spawner.SpawnerService.ListTenants:input_type -> spawner.ListTenantsRequest
+	9,   // 128: spawner.SpawnerService.HealthCheck:output_type -> spawner.Empty
+	11,  // 129: spawner.SpawnerService.Echo:output_type -> spawner.EchoResponse
+	28,  // 130: spawner.SpawnerService.CreateCluster:output_type -> spawner.ClusterResponse
+	32,  // 131: spawner.SpawnerService.AddToken:output_type -> spawner.AddTokenResponse
+	34,  // 132: spawner.SpawnerService.GetToken:output_type -> spawner.GetTokenResponse
+	36,  // 133: spawner.SpawnerService.AddRoute53Record:output_type -> spawner.AddRoute53RecordResponse
+	26,  // 134: spawner.SpawnerService.GetCluster:output_type -> spawner.ClusterSpec
+	27,  // 135: spawner.SpawnerService.GetClusters:output_type -> spawner.GetClustersResponse
+	38,  // 136: spawner.SpawnerService.AddNode:output_type -> spawner.NodeSpawnResponse
+	30,  // 137: spawner.SpawnerService.ClusterStatus:output_type -> spawner.ClusterStatusResponse
+	40,  // 138: spawner.SpawnerService.DeleteCluster:output_type -> spawner.ClusterDeleteResponse
+	42,  // 139: spawner.SpawnerService.DeleteNode:output_type -> spawner.NodeDeleteResponse
+	44,  // 140: spawner.SpawnerService.CreateVolume:output_type -> spawner.CreateVolumeResponse
+	46,  // 141: spawner.SpawnerService.DeleteVolume:output_type -> spawner.DeleteVolumeResponse
+	48,  // 142: spawner.SpawnerService.CreateSnapshot:output_type -> spawner.CreateSnapshotResponse
+	55,  // 143: spawner.SpawnerService.CreateSnapshotAndDelete:output_type -> spawner.CreateSnapshotAndDeleteResponse
+	52,  // 144: spawner.SpawnerService.AttachVolume:output_type -> spawner.AttachVolumeResponse
+	54,  // 145: spawner.SpawnerService.DetachVolume:output_type -> spawner.DetachVolumeResponse
+	57,  // 146: spawner.SpawnerService.RegisterWithRancher:output_type -> spawner.RancherRegistrationResponse
+	61,  // 147: spawner.SpawnerService.GetWorkspacesCost:output_type -> spawner.GetWorkspacesCostResponse
+	62,  // 148: spawner.SpawnerService.GetApplicationsCost:output_type -> spawner.GetApplicationsCostResponse
+	67,  // 149: spawner.SpawnerService.WriteCredential:output_type -> spawner.WriteCredentialResponse
+	69,  // 150: spawner.SpawnerService.ReadCredential:output_type -> spawner.ReadCredentialResponse
+	71,  // 151: spawner.SpawnerService.GetKubeConfig:output_type -> spawner.GetKubeConfigResponse
+	72,  // 152: spawner.SpawnerService.TagNodeInstance:output_type -> spawner.TagNodeInstanceResponse
+	75,  // 153: spawner.SpawnerService.GetCostByTime:output_type -> spawner.GetCostByTimeResponse
+	78,  // 154: spawner.SpawnerService.ListKubernetesVersions:output_type -> spawner.ListKubernetesVersionsResponse
+	81,  // 155: spawner.SpawnerService.UpgradeCluster:output_type -> spawner.UpgradeClusterResponse
+	83,  // 156: spawner.SpawnerService.UpgradeNodePool:output_type -> spawner.UpgradeNodePoolResponse
+	85,  // 157: spawner.SpawnerService.GetUpgradeStatus:output_type -> spawner.GetUpgradeStatusResponse
+	88,  // 158: spawner.SpawnerService.ListMachineTypes:output_type -> spawner.ListMachineTypesResponse
+	91,  // 159: spawner.SpawnerService.CheckCapacity:output_type -> spawner.CheckCapacityResponse
+	95,  // 160: spawner.SpawnerService.GetNetworkStack:output_type -> spawner.GetNetworkStackResponse
+	97,  // 161: spawner.SpawnerService.ListNetworkStacks:output_type -> spawner.ListNetworkStacksResponse
+	99,  // 162: spawner.SpawnerService.DeleteNetworkStack:output_type -> spawner.DeleteNetworkStackResponse
+	102, // 163: spawner.SpawnerService.ListAddons:output_type -> spawner.ListAddonsResponse
+	104, // 164: spawner.SpawnerService.InstallAddon:output_type -> spawner.InstallAddonResponse
+	106, // 165: spawner.SpawnerService.UpdateAddon:output_type -> spawner.UpdateAddonResponse
+	108, // 166: spawner.SpawnerService.RemoveAddon:output_type -> spawner.RemoveAddonResponse
+	110, // 167: spawner.SpawnerService.BindServiceAccountRole:output_type -> spawner.BindServiceAccountRoleResponse
+	112, // 168: spawner.SpawnerService.UpdateClusterConfig:output_type -> spawner.UpdateClusterConfigResponse
+	114, // 169: spawner.SpawnerService.StopCluster:output_type -> spawner.StopClusterResponse
+	116, // 170: spawner.SpawnerService.StartCluster:output_type -> spawner.StartClusterResponse
+	122, // 171: spawner.SpawnerService.GetBootstrapStatus:output_type -> spawner.GetBootstrapStatusResponse
+	125, // 172: spawner.SpawnerService.CreateTenant:output_type -> spawner.CreateTenantResponse
+	127, // 173: spawner.SpawnerService.DeleteTenant:output_type -> spawner.DeleteTenantResponse
+	129, // 174: spawner.SpawnerService.ListTenants:output_type -> spawner.ListTenantsResponse
+	128, // [128:175] is the sub-list for method output_type
+	81,  // [81:128] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
+}
+
+func init() { file_proto_netbookai_spawner_spawner_proto_init() }
+func file_proto_netbookai_spawner_spawner_proto_init() {
+	if File_proto_netbookai_spawner_spawner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EbsVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceMetadataOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpotFallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Issue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Health); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AzureNetworkOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterLogging); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretsEncryption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkStackOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClustersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRoute53RecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRoute53RecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSpawnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSpawnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotAndDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetachVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetachVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSnapshotAndDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RancherRegistrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RancherRegistrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspacesCostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationsCostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkspacesCostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationsCostResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4741,8 +11842,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwsCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4753,8 +11854,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AzureCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4765,8 +11866,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeSpec); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GithubPersonalAccessToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4777,8 +11878,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Issue); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4789,8 +11890,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Health); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4801,8 +11902,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4813,8 +11914,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClusterRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4825,8 +11926,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClustersRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKubeConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4837,8 +11938,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterSpec); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKubeConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4849,8 +11950,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClustersResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagNodeInstanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4861,8 +11962,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagNodeInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4873,8 +11974,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterStatusRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCostByTimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4885,8 +11986,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterStatusResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCostByTimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4897,8 +11998,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTokenRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CostMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4909,8 +12010,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTokenResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKubernetesVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4921,8 +12022,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKubernetesVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4933,8 +12034,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4945,8 +12046,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRoute53RecordRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4957,8 +12058,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRoute53RecordResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4969,8 +12070,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeSpawnRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeNodePoolRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4981,8 +12082,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeSpawnResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeNodePoolResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4993,8 +12094,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterDeleteRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpgradeStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5005,8 +12106,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterDeleteResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpgradeStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5017,8 +12118,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeDeleteRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMachineTypesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5029,8 +12130,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeDeleteResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5041,8 +12142,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVolumeRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMachineTypesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5053,8 +12154,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVolumeResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckCapacityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5065,8 +12166,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVolumeRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapacityCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5077,8 +12178,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteVolumeResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckCapacityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5089,8 +12190,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkSubnet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5101,8 +12202,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkStack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5113,8 +12214,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotAndDeleteRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetworkStackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5125,8 +12226,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSnapshotAndDeleteResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetworkStackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5137,8 +12238,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RancherRegistrationRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNetworkStacksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5149,8 +12250,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RancherRegistrationResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNetworkStacksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5161,8 +12262,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkspacesCostRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNetworkStackRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5173,8 +12274,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationsCostRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNetworkStackResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5185,8 +12286,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupBy); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addon); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5197,8 +12298,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkspacesCostResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAddonsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5209,8 +12310,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationsCostResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAddonsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5221,8 +12322,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AwsCredentials); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallAddonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5233,8 +12334,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AzureCredentials); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallAddonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5245,8 +12346,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GithubPersonalAccessToken); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAddonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5257,8 +12358,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteCredentialRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAddonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5269,8 +12370,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteCredentialResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAddonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5281,8 +12382,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadCredentialRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAddonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5293,8 +12394,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadCredentialResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindServiceAccountRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5305,8 +12406,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKubeConfigRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindServiceAccountRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5317,8 +12418,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetKubeConfigResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateClusterConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5329,8 +12430,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagNodeInstanceResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateClusterConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5341,8 +12442,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagNodeInstanceRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5353,8 +12454,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCostByTimeRequest); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5365,8 +12466,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCostByTimeResponse); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5377,8 +12478,164 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 				return nil
 			}
 		}
-		file_proto_netbookai_spawner_spawner_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CostMap); i {
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartClusterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapChart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bootstrap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBootstrapStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBootstrapStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_netbookai_spawner_spawner_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5390,12 +12647,12 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 			}
 		}
 	}
-	file_proto_netbookai_spawner_spawner_proto_msgTypes[44].OneofWrappers = []interface{}{
+	file_proto_netbookai_spawner_spawner_proto_msgTypes[57].OneofWrappers = []interface{}{
 		(*WriteCredentialRequest_AwsCred)(nil),
 		(*WriteCredentialRequest_AzureCred)(nil),
 		(*WriteCredentialRequest_GitPat)(nil),
 	}
-	file_proto_netbookai_spawner_spawner_proto_msgTypes[47].OneofWrappers = []interface{}{
+	file_proto_netbookai_spawner_spawner_proto_msgTypes[60].OneofWrappers = []interface{}{
 		(*ReadCredentialResponse_AwsCred)(nil),
 		(*ReadCredentialResponse_AzureCred)(nil),
 		(*ReadCredentialResponse_GitPat)(nil),
@@ -5405,8 +12662,8 @@ func file_proto_netbookai_spawner_spawner_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_netbookai_spawner_spawner_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   65,
+			NumEnums:      9,
+			NumMessages:   139,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
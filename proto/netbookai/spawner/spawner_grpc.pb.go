@@ -50,6 +50,9 @@ type SpawnerServiceClient interface {
 	DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error)
 	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
 	CreateSnapshotAndDelete(ctx context.Context, in *CreateSnapshotAndDeleteRequest, opts ...grpc.CallOption) (*CreateSnapshotAndDeleteResponse, error)
+	// Attach volume to the instance or cluster node, both have to be in the same availability zone
+	AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error)
+	DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error)
 	RegisterWithRancher(ctx context.Context, in *RancherRegistrationRequest, opts ...grpc.CallOption) (*RancherRegistrationResponse, error)
 	// Deprecated: Do not use.
 	GetWorkspacesCost(ctx context.Context, in *GetWorkspacesCostRequest, opts ...grpc.CallOption) (*GetWorkspacesCostResponse, error)
@@ -59,6 +62,40 @@ type SpawnerServiceClient interface {
 	GetKubeConfig(ctx context.Context, in *GetKubeConfigRequest, opts ...grpc.CallOption) (*GetKubeConfigResponse, error)
 	TagNodeInstance(ctx context.Context, in *TagNodeInstanceRequest, opts ...grpc.CallOption) (*TagNodeInstanceResponse, error)
 	GetCostByTime(ctx context.Context, in *GetCostByTimeRequest, opts ...grpc.CallOption) (*GetCostByTimeResponse, error)
+	// List kubernetes versions supported by the provider in the region
+	ListKubernetesVersions(ctx context.Context, in *ListKubernetesVersionsRequest, opts ...grpc.CallOption) (*ListKubernetesVersionsResponse, error)
+	// Upgrade cluster control plane, followed by all its node pools
+	UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error)
+	// Upgrade a single node pool
+	UpgradeNodePool(ctx context.Context, in *UpgradeNodePoolRequest, opts ...grpc.CallOption) (*UpgradeNodePoolResponse, error)
+	// Progress of the cluster and node pool upgrades
+	GetUpgradeStatus(ctx context.Context, in *GetUpgradeStatusRequest, opts ...grpc.CallOption) (*GetUpgradeStatusResponse, error)
+	// Machine type catalog, optionally filtered by provider and region
+	ListMachineTypes(ctx context.Context, in *ListMachineTypesRequest, opts ...grpc.CallOption) (*ListMachineTypesResponse, error)
+	// Preflight instance availability and quota checks for the node pool
+	CheckCapacity(ctx context.Context, in *CheckCapacityRequest, opts ...grpc.CallOption) (*CheckCapacityResponse, error)
+	// Region network stack (AWS VPC, Azure VNet) shared by the clusters
+	GetNetworkStack(ctx context.Context, in *GetNetworkStackRequest, opts ...grpc.CallOption) (*GetNetworkStackResponse, error)
+	ListNetworkStacks(ctx context.Context, in *ListNetworkStacksRequest, opts ...grpc.CallOption) (*ListNetworkStacksResponse, error)
+	DeleteNetworkStack(ctx context.Context, in *DeleteNetworkStackRequest, opts ...grpc.CallOption) (*DeleteNetworkStackResponse, error)
+	// Cluster add-ons (EKS add-ons, AKS add-on profiles)
+	ListAddons(ctx context.Context, in *ListAddonsRequest, opts ...grpc.CallOption) (*ListAddonsResponse, error)
+	InstallAddon(ctx context.Context, in *InstallAddonRequest, opts ...grpc.CallOption) (*InstallAddonResponse, error)
+	UpdateAddon(ctx context.Context, in *UpdateAddonRequest, opts ...grpc.CallOption) (*UpdateAddonResponse, error)
+	RemoveAddon(ctx context.Context, in *RemoveAddonRequest, opts ...grpc.CallOption) (*RemoveAddonResponse, error)
+	// Cloud access for workloads through the cluster OIDC issuer (AWS IRSA, Azure workload identity)
+	BindServiceAccountRole(ctx context.Context, in *BindServiceAccountRoleRequest, opts ...grpc.CallOption) (*BindServiceAccountRoleResponse, error)
+	// Control plane logging and secrets encryption of existing cluster
+	UpdateClusterConfig(ctx context.Context, in *UpdateClusterConfigRequest, opts ...grpc.CallOption) (*UpdateClusterConfigResponse, error)
+	// Stop the cluster to save cost, nodes are removed and restored on start
+	StopCluster(ctx context.Context, in *StopClusterRequest, opts ...grpc.CallOption) (*StopClusterResponse, error)
+	StartCluster(ctx context.Context, in *StartClusterRequest, opts ...grpc.CallOption) (*StartClusterResponse, error)
+	// Result of each step of the bootstrap requested with the cluster
+	GetBootstrapStatus(ctx context.Context, in *GetBootstrapStatusRequest, opts ...grpc.CallOption) (*GetBootstrapStatusResponse, error)
+	// Workspace namespaces of a shared cluster, each with its own quota and namespace scoped kubeconfig
+	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
 }
 
 type spawnerServiceClient struct {
@@ -213,6 +250,24 @@ func (c *spawnerServiceClient) CreateSnapshotAndDelete(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *spawnerServiceClient) AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error) {
+	out := new(AttachVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/AttachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error) {
+	out := new(DetachVolumeResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DetachVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spawnerServiceClient) RegisterWithRancher(ctx context.Context, in *RancherRegistrationRequest, opts ...grpc.CallOption) (*RancherRegistrationResponse, error) {
 	out := new(RancherRegistrationResponse)
 	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/RegisterWithRancher", in, out, opts...)
@@ -286,6 +341,195 @@ func (c *spawnerServiceClient) GetCostByTime(ctx context.Context, in *GetCostByT
 	return out, nil
 }
 
+func (c *spawnerServiceClient) ListKubernetesVersions(ctx context.Context, in *ListKubernetesVersionsRequest, opts ...grpc.CallOption) (*ListKubernetesVersionsResponse, error) {
+	out := new(ListKubernetesVersionsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListKubernetesVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error) {
+	out := new(UpgradeClusterResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/UpgradeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) UpgradeNodePool(ctx context.Context, in *UpgradeNodePoolRequest, opts ...grpc.CallOption) (*UpgradeNodePoolResponse, error) {
+	out := new(UpgradeNodePoolResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/UpgradeNodePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetUpgradeStatus(ctx context.Context, in *GetUpgradeStatusRequest, opts ...grpc.CallOption) (*GetUpgradeStatusResponse, error) {
+	out := new(GetUpgradeStatusResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetUpgradeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListMachineTypes(ctx context.Context, in *ListMachineTypesRequest, opts ...grpc.CallOption) (*ListMachineTypesResponse, error) {
+	out := new(ListMachineTypesResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListMachineTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) CheckCapacity(ctx context.Context, in *CheckCapacityRequest, opts ...grpc.CallOption) (*CheckCapacityResponse, error) {
+	out := new(CheckCapacityResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/CheckCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetNetworkStack(ctx context.Context, in *GetNetworkStackRequest, opts ...grpc.CallOption) (*GetNetworkStackResponse, error) {
+	out := new(GetNetworkStackResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetNetworkStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListNetworkStacks(ctx context.Context, in *ListNetworkStacksRequest, opts ...grpc.CallOption) (*ListNetworkStacksResponse, error) {
+	out := new(ListNetworkStacksResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListNetworkStacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DeleteNetworkStack(ctx context.Context, in *DeleteNetworkStackRequest, opts ...grpc.CallOption) (*DeleteNetworkStackResponse, error) {
+	out := new(DeleteNetworkStackResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DeleteNetworkStack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListAddons(ctx context.Context, in *ListAddonsRequest, opts ...grpc.CallOption) (*ListAddonsResponse, error) {
+	out := new(ListAddonsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListAddons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) InstallAddon(ctx context.Context, in *InstallAddonRequest, opts ...grpc.CallOption) (*InstallAddonResponse, error) {
+	out := new(InstallAddonResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/InstallAddon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) UpdateAddon(ctx context.Context, in *UpdateAddonRequest, opts ...grpc.CallOption) (*UpdateAddonResponse, error) {
+	out := new(UpdateAddonResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/UpdateAddon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) RemoveAddon(ctx context.Context, in *RemoveAddonRequest, opts ...grpc.CallOption) (*RemoveAddonResponse, error) {
+	out := new(RemoveAddonResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/RemoveAddon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) BindServiceAccountRole(ctx context.Context, in *BindServiceAccountRoleRequest, opts ...grpc.CallOption) (*BindServiceAccountRoleResponse, error) {
+	out := new(BindServiceAccountRoleResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/BindServiceAccountRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) UpdateClusterConfig(ctx context.Context, in *UpdateClusterConfigRequest, opts ...grpc.CallOption) (*UpdateClusterConfigResponse, error) {
+	out := new(UpdateClusterConfigResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/UpdateClusterConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) StopCluster(ctx context.Context, in *StopClusterRequest, opts ...grpc.CallOption) (*StopClusterResponse, error) {
+	out := new(StopClusterResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/StopCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) StartCluster(ctx context.Context, in *StartClusterRequest, opts ...grpc.CallOption) (*StartClusterResponse, error) {
+	out := new(StartClusterResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/StartCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) GetBootstrapStatus(ctx context.Context, in *GetBootstrapStatusRequest, opts ...grpc.CallOption) (*GetBootstrapStatusResponse, error) {
+	out := new(GetBootstrapStatusResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/GetBootstrapStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
+	out := new(CreateTenantResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/CreateTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	out := new(DeleteTenantResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/DeleteTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spawnerServiceClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, "/spawner.SpawnerService/ListTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SpawnerServiceServer is the server API for SpawnerService service.
 // All implementations must embed UnimplementedSpawnerServiceServer
 // for forward compatibility
@@ -318,6 +562,9 @@ type SpawnerServiceServer interface {
 	DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error)
 	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
 	CreateSnapshotAndDelete(context.Context, *CreateSnapshotAndDeleteRequest) (*CreateSnapshotAndDeleteResponse, error)
+	// Attach volume to the instance or cluster node, both have to be in the same availability zone
+	AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error)
+	DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error)
 	RegisterWithRancher(context.Context, *RancherRegistrationRequest) (*RancherRegistrationResponse, error)
 	// Deprecated: Do not use.
 	GetWorkspacesCost(context.Context, *GetWorkspacesCostRequest) (*GetWorkspacesCostResponse, error)
@@ -327,6 +574,40 @@ type SpawnerServiceServer interface {
 	GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error)
 	TagNodeInstance(context.Context, *TagNodeInstanceRequest) (*TagNodeInstanceResponse, error)
 	GetCostByTime(context.Context, *GetCostByTimeRequest) (*GetCostByTimeResponse, error)
+	// List kubernetes versions supported by the provider in the region
+	ListKubernetesVersions(context.Context, *ListKubernetesVersionsRequest) (*ListKubernetesVersionsResponse, error)
+	// Upgrade cluster control plane, followed by all its node pools
+	UpgradeCluster(context.Context, *UpgradeClusterRequest) (*UpgradeClusterResponse, error)
+	// Upgrade a single node pool
+	UpgradeNodePool(context.Context, *UpgradeNodePoolRequest) (*UpgradeNodePoolResponse, error)
+	// Progress of the cluster and node pool upgrades
+	GetUpgradeStatus(context.Context, *GetUpgradeStatusRequest) (*GetUpgradeStatusResponse, error)
+	// Machine type catalog, optionally filtered by provider and region
+	ListMachineTypes(context.Context, *ListMachineTypesRequest) (*ListMachineTypesResponse, error)
+	// Preflight instance availability and quota checks for the node pool
+	CheckCapacity(context.Context, *CheckCapacityRequest) (*CheckCapacityResponse, error)
+	// Region network stack (AWS VPC, Azure VNet) shared by the clusters
+	GetNetworkStack(context.Context, *GetNetworkStackRequest) (*GetNetworkStackResponse, error)
+	ListNetworkStacks(context.Context, *ListNetworkStacksRequest) (*ListNetworkStacksResponse, error)
+	DeleteNetworkStack(context.Context, *DeleteNetworkStackRequest) (*DeleteNetworkStackResponse, error)
+	// Cluster add-ons (EKS add-ons, AKS add-on profiles)
+	ListAddons(context.Context, *ListAddonsRequest) (*ListAddonsResponse, error)
+	InstallAddon(context.Context, *InstallAddonRequest) (*InstallAddonResponse, error)
+	UpdateAddon(context.Context, *UpdateAddonRequest) (*UpdateAddonResponse, error)
+	RemoveAddon(context.Context, *RemoveAddonRequest) (*RemoveAddonResponse, error)
+	// Cloud access for workloads through the cluster OIDC issuer (AWS IRSA, Azure workload identity)
+	BindServiceAccountRole(context.Context, *BindServiceAccountRoleRequest) (*BindServiceAccountRoleResponse, error)
+	// Control plane logging and secrets encryption of existing cluster
+	UpdateClusterConfig(context.Context, *UpdateClusterConfigRequest) (*UpdateClusterConfigResponse, error)
+	// Stop the cluster to save cost, nodes are removed and restored on start
+	StopCluster(context.Context, *StopClusterRequest) (*StopClusterResponse, error)
+	StartCluster(context.Context, *StartClusterRequest) (*StartClusterResponse, error)
+	// Result of each step of the bootstrap requested with the cluster
+	GetBootstrapStatus(context.Context, *GetBootstrapStatusRequest) (*GetBootstrapStatusResponse, error)
+	// Workspace namespaces of a shared cluster, each with its own quota and namespace scoped kubeconfig
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
 	mustEmbedUnimplementedSpawnerServiceServer()
 }
 
@@ -382,6 +663,12 @@ func (UnimplementedSpawnerServiceServer) CreateSnapshot(context.Context, *Create
 func (UnimplementedSpawnerServiceServer) CreateSnapshotAndDelete(context.Context, *CreateSnapshotAndDeleteRequest) (*CreateSnapshotAndDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshotAndDelete not implemented")
 }
+func (UnimplementedSpawnerServiceServer) AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachVolume not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachVolume not implemented")
+}
 func (UnimplementedSpawnerServiceServer) RegisterWithRancher(context.Context, *RancherRegistrationRequest) (*RancherRegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterWithRancher not implemented")
 }
@@ -406,6 +693,69 @@ func (UnimplementedSpawnerServiceServer) TagNodeInstance(context.Context, *TagNo
 func (UnimplementedSpawnerServiceServer) GetCostByTime(context.Context, *GetCostByTimeRequest) (*GetCostByTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCostByTime not implemented")
 }
+func (UnimplementedSpawnerServiceServer) ListKubernetesVersions(context.Context, *ListKubernetesVersionsRequest) (*ListKubernetesVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKubernetesVersions not implemented")
+}
+func (UnimplementedSpawnerServiceServer) UpgradeCluster(context.Context, *UpgradeClusterRequest) (*UpgradeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeCluster not implemented")
+}
+func (UnimplementedSpawnerServiceServer) UpgradeNodePool(context.Context, *UpgradeNodePoolRequest) (*UpgradeNodePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeNodePool not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetUpgradeStatus(context.Context, *GetUpgradeStatusRequest) (*GetUpgradeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpgradeStatus not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListMachineTypes(context.Context, *ListMachineTypesRequest) (*ListMachineTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMachineTypes not implemented")
+}
+func (UnimplementedSpawnerServiceServer) CheckCapacity(context.Context, *CheckCapacityRequest) (*CheckCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCapacity not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetNetworkStack(context.Context, *GetNetworkStackRequest) (*GetNetworkStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkStack not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListNetworkStacks(context.Context, *ListNetworkStacksRequest) (*ListNetworkStacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworkStacks not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DeleteNetworkStack(context.Context, *DeleteNetworkStackRequest) (*DeleteNetworkStackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNetworkStack not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListAddons(context.Context, *ListAddonsRequest) (*ListAddonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddons not implemented")
+}
+func (UnimplementedSpawnerServiceServer) InstallAddon(context.Context, *InstallAddonRequest) (*InstallAddonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallAddon not implemented")
+}
+func (UnimplementedSpawnerServiceServer) UpdateAddon(context.Context, *UpdateAddonRequest) (*UpdateAddonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddon not implemented")
+}
+func (UnimplementedSpawnerServiceServer) RemoveAddon(context.Context, *RemoveAddonRequest) (*RemoveAddonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAddon not implemented")
+}
+func (UnimplementedSpawnerServiceServer) BindServiceAccountRole(context.Context, *BindServiceAccountRoleRequest) (*BindServiceAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindServiceAccountRole not implemented")
+}
+func (UnimplementedSpawnerServiceServer) UpdateClusterConfig(context.Context, *UpdateClusterConfigRequest) (*UpdateClusterConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterConfig not implemented")
+}
+func (UnimplementedSpawnerServiceServer) StopCluster(context.Context, *StopClusterRequest) (*StopClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCluster not implemented")
+}
+func (UnimplementedSpawnerServiceServer) StartCluster(context.Context, *StartClusterRequest) (*StartClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCluster not implemented")
+}
+func (UnimplementedSpawnerServiceServer) GetBootstrapStatus(context.Context, *GetBootstrapStatusRequest) (*GetBootstrapStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBootstrapStatus not implemented")
+}
+func (UnimplementedSpawnerServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedSpawnerServiceServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedSpawnerServiceServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
 func (UnimplementedSpawnerServiceServer) mustEmbedUnimplementedSpawnerServiceServer() {}
 
 // UnsafeSpawnerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -707,6 +1057,42 @@ func _SpawnerService_CreateSnapshotAndDelete_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpawnerService_AttachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).AttachVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/AttachVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).AttachVolume(ctx, req.(*AttachVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DetachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DetachVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DetachVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DetachVolume(ctx, req.(*DetachVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpawnerService_RegisterWithRancher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RancherRegistrationRequest)
 	if err := dec(in); err != nil {
@@ -851,6 +1237,384 @@ func _SpawnerService_GetCostByTime_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpawnerService_ListKubernetesVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKubernetesVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListKubernetesVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListKubernetesVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListKubernetesVersions(ctx, req.(*ListKubernetesVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).UpgradeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/UpgradeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).UpgradeCluster(ctx, req.(*UpgradeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_UpgradeNodePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeNodePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).UpgradeNodePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/UpgradeNodePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).UpgradeNodePool(ctx, req.(*UpgradeNodePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetUpgradeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpgradeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetUpgradeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetUpgradeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetUpgradeStatus(ctx, req.(*GetUpgradeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListMachineTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachineTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListMachineTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListMachineTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListMachineTypes(ctx, req.(*ListMachineTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CheckCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CheckCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CheckCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CheckCapacity(ctx, req.(*CheckCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetNetworkStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetNetworkStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetNetworkStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetNetworkStack(ctx, req.(*GetNetworkStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListNetworkStacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworkStacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListNetworkStacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListNetworkStacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListNetworkStacks(ctx, req.(*ListNetworkStacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteNetworkStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNetworkStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteNetworkStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteNetworkStack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteNetworkStack(ctx, req.(*DeleteNetworkStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListAddons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListAddons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListAddons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListAddons(ctx, req.(*ListAddonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_InstallAddon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallAddonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).InstallAddon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/InstallAddon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).InstallAddon(ctx, req.(*InstallAddonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_UpdateAddon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAddonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).UpdateAddon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/UpdateAddon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).UpdateAddon(ctx, req.(*UpdateAddonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_RemoveAddon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAddonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).RemoveAddon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/RemoveAddon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).RemoveAddon(ctx, req.(*RemoveAddonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_BindServiceAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindServiceAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).BindServiceAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/BindServiceAccountRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).BindServiceAccountRole(ctx, req.(*BindServiceAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_UpdateClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).UpdateClusterConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/UpdateClusterConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).UpdateClusterConfig(ctx, req.(*UpdateClusterConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_StopCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).StopCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/StopCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).StopCluster(ctx, req.(*StopClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_StartCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).StartCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/StartCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).StartCluster(ctx, req.(*StartClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_GetBootstrapStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBootstrapStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).GetBootstrapStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/GetBootstrapStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).GetBootstrapStatus(ctx, req.(*GetBootstrapStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/CreateTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/DeleteTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpawnerService_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpawnerServiceServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spawner.SpawnerService/ListTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpawnerServiceServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SpawnerService_ServiceDesc is the grpc.ServiceDesc for SpawnerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -922,6 +1686,14 @@ var SpawnerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSnapshotAndDelete",
 			Handler:    _SpawnerService_CreateSnapshotAndDelete_Handler,
 		},
+		{
+			MethodName: "AttachVolume",
+			Handler:    _SpawnerService_AttachVolume_Handler,
+		},
+		{
+			MethodName: "DetachVolume",
+			Handler:    _SpawnerService_DetachVolume_Handler,
+		},
 		{
 			MethodName: "RegisterWithRancher",
 			Handler:    _SpawnerService_RegisterWithRancher_Handler,
@@ -954,6 +1726,90 @@ var SpawnerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCostByTime",
 			Handler:    _SpawnerService_GetCostByTime_Handler,
 		},
+		{
+			MethodName: "ListKubernetesVersions",
+			Handler:    _SpawnerService_ListKubernetesVersions_Handler,
+		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _SpawnerService_UpgradeCluster_Handler,
+		},
+		{
+			MethodName: "UpgradeNodePool",
+			Handler:    _SpawnerService_UpgradeNodePool_Handler,
+		},
+		{
+			MethodName: "GetUpgradeStatus",
+			Handler:    _SpawnerService_GetUpgradeStatus_Handler,
+		},
+		{
+			MethodName: "ListMachineTypes",
+			Handler:    _SpawnerService_ListMachineTypes_Handler,
+		},
+		{
+			MethodName: "CheckCapacity",
+			Handler:    _SpawnerService_CheckCapacity_Handler,
+		},
+		{
+			MethodName: "GetNetworkStack",
+			Handler:    _SpawnerService_GetNetworkStack_Handler,
+		},
+		{
+			MethodName: "ListNetworkStacks",
+			Handler:    _SpawnerService_ListNetworkStacks_Handler,
+		},
+		{
+			MethodName: "DeleteNetworkStack",
+			Handler:    _SpawnerService_DeleteNetworkStack_Handler,
+		},
+		{
+			MethodName: "ListAddons",
+			Handler:    _SpawnerService_ListAddons_Handler,
+		},
+		{
+			MethodName: "InstallAddon",
+			Handler:    _SpawnerService_InstallAddon_Handler,
+		},
+		{
+			MethodName: "UpdateAddon",
+			Handler:    _SpawnerService_UpdateAddon_Handler,
+		},
+		{
+			MethodName: "RemoveAddon",
+			Handler:    _SpawnerService_RemoveAddon_Handler,
+		},
+		{
+			MethodName: "BindServiceAccountRole",
+			Handler:    _SpawnerService_BindServiceAccountRole_Handler,
+		},
+		{
+			MethodName: "UpdateClusterConfig",
+			Handler:    _SpawnerService_UpdateClusterConfig_Handler,
+		},
+		{
+			MethodName: "StopCluster",
+			Handler:    _SpawnerService_StopCluster_Handler,
+		},
+		{
+			MethodName: "StartCluster",
+			Handler:    _SpawnerService_StartCluster_Handler,
+		},
+		{
+			MethodName: "GetBootstrapStatus",
+			Handler:    _SpawnerService_GetBootstrapStatus_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _SpawnerService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _SpawnerService_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _SpawnerService_ListTenants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/netbookai/spawner/spawner.proto",